@@ -1,11 +1,15 @@
 package internal
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"sort"
+	"strings"
 	"syscall"
 	"time"
 
@@ -15,39 +19,51 @@ import (
 	"github.com/goverland-labs/goverland-helpers-ens-resolver/protocol/enspb"
 	"github.com/goverland-labs/goverland-platform-events/pkg/natsclient"
 	"github.com/nats-io/nats.go"
+	"github.com/rs/zerolog/log"
 	"github.com/s-larionov/process-manager"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
-	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 
 	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
 
 	"github.com/goverland-labs/goverland-core-storage/internal/config"
 	"github.com/goverland-labs/goverland-core-storage/internal/dao"
 	"github.com/goverland-labs/goverland-core-storage/internal/delegate"
-	"github.com/goverland-labs/goverland-core-storage/internal/discord"
 	"github.com/goverland-labs/goverland-core-storage/internal/ensresolver"
 	"github.com/goverland-labs/goverland-core-storage/internal/events"
+	"github.com/goverland-labs/goverland-core-storage/internal/marketdata"
+	"github.com/goverland-labs/goverland-core-storage/internal/migration"
+	"github.com/goverland-labs/goverland-core-storage/internal/notifier"
+	"github.com/goverland-labs/goverland-core-storage/internal/outbox"
 	"github.com/goverland-labs/goverland-core-storage/internal/proposal"
 	"github.com/goverland-labs/goverland-core-storage/internal/pubsub"
 	"github.com/goverland-labs/goverland-core-storage/internal/stats"
 	"github.com/goverland-labs/goverland-core-storage/internal/vote"
+	"github.com/goverland-labs/goverland-core-storage/pkg/gateway"
 	"github.com/goverland-labs/goverland-core-storage/pkg/grpcsrv"
 	"github.com/goverland-labs/goverland-core-storage/pkg/health"
 	"github.com/goverland-labs/goverland-core-storage/pkg/prometheus"
+	coingeckosdk "github.com/goverland-labs/goverland-core-storage/pkg/sdk/coingecko"
 	zerionsdk "github.com/goverland-labs/goverland-core-storage/pkg/sdk/zerion"
+	"github.com/goverland-labs/goverland-core-storage/resources"
 )
 
+// storagePackage is the prefix of services exposed by the gateway
+const storagePackage = "storagepb."
+
+// notifyTimeout limits the delivery of the worker failure notification including retries of backends
+const notifyTimeout = time.Minute
+
 type Application struct {
 	sigChan <-chan os.Signal
 	manager *process.Manager
 	cfg     config.App
 	db      *gorm.DB
 
-	proposalRepo    *proposal.Repo
-	proposalService *proposal.Service
+	proposalRepo         *proposal.Repo
+	proposalService      *proposal.Service
+	proposalTallyService *proposal.TallyService
 
 	daoIDRepo    *dao.DaoIDRepo
 	daoIDService *dao.DaoIDService
@@ -56,6 +72,11 @@ type Application struct {
 	daoUniqueRepo *dao.UniqueVoterRepo
 	daoService    *dao.Service
 
+	daoTreasuryService       *dao.TreasuryService
+	daoRecommendationService *dao.RecommendationService
+	daoSimilarityService     *dao.SimilarityService
+	daoActivityService       *dao.ActivityService
+
 	voteRepo    *vote.Repo
 	voteService *vote.Service
 
@@ -70,8 +91,11 @@ type Application struct {
 
 	statsService *stats.Service
 
-	zerionClient  *zerionsdk.Client
-	discordSender *discord.Sender
+	outboxRepo *outbox.Repo
+
+	zerionClient *zerionsdk.Client
+	marketData   marketdata.Provider
+	notifier     *notifier.Notifier
 }
 
 func NewApplication(cfg config.App) (*Application, error) {
@@ -119,28 +143,15 @@ func (a *Application) bootstrap() error {
 }
 
 func (a *Application) initDB() error {
-	db, err := gorm.Open(postgres.Open(a.cfg.DB.DSN), &gorm.Config{
-		Logger: logger.New(log.New(os.Stdout, "\r\n", log.LstdFlags), logger.Config{
-			SlowThreshold:             200 * time.Millisecond,
-			LogLevel:                  logger.Warn,
-			IgnoreRecordNotFoundError: true,
-			Colorful:                  false,
-		}),
-	})
+	db, err := openDB(a.cfg.DB)
 	if err != nil {
 		return err
 	}
+	a.db = db
 
-	ps, err := db.DB()
-	if err != nil {
+	if err = a.initMigrations(); err != nil {
 		return err
 	}
-	ps.SetMaxOpenConns(a.cfg.DB.MaxOpenConnections)
-
-	a.db = db
-	if a.cfg.DB.Debug {
-		a.db = db.Debug()
-	}
 
 	a.daoRepo = dao.NewRepo(a.db)
 	a.daoUniqueRepo = dao.NewUniqueVoterRepo(a.db)
@@ -150,11 +161,41 @@ func (a *Application) initDB() error {
 	a.eventsRepo = events.NewRepo(a.db)
 	a.ensRepo = ensresolver.NewRepo(a.db)
 	a.delegateRepo = delegate.NewRepo(a.db)
+	a.outboxRepo = outbox.NewRepo(a.db)
 
-	return err
+	return nil
+}
+
+func (a *Application) initMigrations() error {
+	ms, err := migration.NewService(migration.NewRepo(a.db), resources.Migrations)
+	if err != nil {
+		return fmt.Errorf("migration.NewService: %w", err)
+	}
+
+	ctx := context.Background()
+	if a.cfg.DB.MigrationsBaseline >= 0 {
+		if err = ms.Baseline(ctx, a.cfg.DB.MigrationsBaseline); err != nil {
+			return fmt.Errorf("baseline migrations: %w", err)
+		}
+	}
+
+	if !a.cfg.DB.MigrateOnStart {
+		return ms.Check(ctx)
+	}
+
+	if _, err = ms.Up(ctx); err != nil {
+		return fmt.Errorf("apply migrations: %w", err)
+	}
+
+	return nil
 }
 
 func (a *Application) initServices() error {
+	// the notifier is used by callback workers, so it's initialized first
+	if err := a.initNotifier(); err != nil {
+		return fmt.Errorf("init notifier: %w", err)
+	}
+
 	nc, err := nats.Connect(
 		a.cfg.Nats.URL,
 		nats.RetryOnFailedConnect(true),
@@ -165,13 +206,21 @@ func (a *Application) initServices() error {
 		return err
 	}
 
-	pb, err := natsclient.NewPublisher(nc)
+	np, err := natsclient.NewPublisher(nc)
 	if err != nil {
 		return err
 	}
 
+	// all events are stored in the outbox and sent to the broker by the relay worker
+	pb := outbox.NewPublisher(a.outboxRepo)
+	a.addCallbackWorker("outbox-relay", outbox.NewRelayWorker(a.outboxRepo, np).Start)
+
 	a.initZerionAPI()
-	a.initDiscordSender()
+
+	err = a.initMarketData()
+	if err != nil {
+		return fmt.Errorf("init market data: %w", err)
+	}
 
 	err = a.initEnsResolver(pb)
 	if err != nil {
@@ -208,7 +257,7 @@ func (a *Application) initServices() error {
 	return nil
 }
 
-func (a *Application) initEnsResolver(pb *natsclient.Publisher) error {
+func (a *Application) initEnsResolver(pb *outbox.Publisher) error {
 	conn, err := grpc.NewClient(a.cfg.InternalAPI.EnsResolverAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		return fmt.Errorf("create connection with ens resolver: %v", err)
@@ -219,18 +268,18 @@ func (a *Application) initEnsResolver(pb *natsclient.Publisher) error {
 		return fmt.Errorf("ensresolver.NewService: %w", err)
 	}
 
-	a.manager.AddWorker(process.NewCallbackWorker("ens-resolver", a.ensService.Start))
+	a.addCallbackWorker("ens-resolver", a.ensService.Start)
 
 	return nil
 }
 
-func (a *Application) initDao(nc *nats.Conn, pb *natsclient.Publisher) error {
+func (a *Application) initDao(nc *nats.Conn, pb *outbox.Publisher) error {
 	a.daoIDService = dao.NewDaoIDService(a.daoIDRepo)
 
 	topDAOCache := dao.NewTopDAOCache(a.daoRepo)
 	fungibleChainRepo := dao.NewFungibleChainRepo(a.db)
 
-	service, err := dao.NewService(a.daoRepo, a.daoUniqueRepo, a.daoIDService, pb, a.proposalRepo, topDAOCache, fungibleChainRepo, a.zerionClient, a.discordSender)
+	service, err := dao.NewService(a.daoRepo, a.daoUniqueRepo, a.daoIDService, pb, a.proposalRepo, topDAOCache, fungibleChainRepo, dao.NewTokenPriceRepo(a.db), a.marketData, a.notifier)
 	if err != nil {
 		return fmt.Errorf("dao service: %w", err)
 	}
@@ -239,35 +288,63 @@ func (a *Application) initDao(nc *nats.Conn, pb *natsclient.Publisher) error {
 		return fmt.Errorf("PrefillDaoIDs: %w", err)
 	}
 
-	fungibleChainWorker := dao.NewFungibleChainWorker(a.zerionClient, service, fungibleChainRepo)
+	fungibleChainWorker := dao.NewFungibleChainWorker(a.marketData, service, fungibleChainRepo)
+
+	pc := a.cfg.Popularity
+	popularity, err := dao.NewPopularityService(service, dao.NewPopularityRepo(a.db), dao.PopularityOptions{
+		Mode:        pc.Mode,
+		BlendWeight: pc.BlendWeight,
+		Window:      pc.Window,
+		Weights: dao.PopularityWeights{
+			Voters:    pc.VotersWeight,
+			Votes:     pc.VotesWeight,
+			Proposals: pc.ProposalsWeight,
+			Followers: pc.FollowersWeight,
+			Verified:  pc.VerifiedWeight,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("dao popularity service: %w", err)
+	}
+	pw := dao.NewPopularityWorker(popularity, pc.Interval)
 
-	cs, err := dao.NewConsumer(nc, service)
+	cs, err := dao.NewConsumer(nc, service, popularity)
 	if err != nil {
 		return fmt.Errorf("dao consumer: %w", err)
 	}
-	a.manager.AddWorker(process.NewCallbackWorker("dao-consumer", cs.Start))
+	a.addCallbackWorker("dao-consumer", cs.Start)
 
-	cw := dao.NewNewCategoryWorker(service)
+	crw := dao.NewCategoryRuleWorker(service, dao.NewCategoryRuleRepo(a.db))
 	mc := dao.NewVotersCountWorker(service)
-	pcw := dao.NewPopularCategoryWorker(service)
 	avw := dao.NewCntCalculationWorker(service)
 	rw := dao.NewRecommendationWorker(service)
-	tpw := dao.NewTokenPriceWorker(service, a.zerionClient)
-	a.manager.AddWorker(process.NewCallbackWorker("dao-new-category-process-worker", cw.ProcessNew))
-	a.manager.AddWorker(process.NewCallbackWorker("dao-new-category-outdated-worker", cw.RemoveOutdated))
-	a.manager.AddWorker(process.NewCallbackWorker("dao-new-voters-worker", mc.ProcessNew))
-	a.manager.AddWorker(process.NewCallbackWorker("dao-popular-category-process-worker", pcw.Process))
-	a.manager.AddWorker(process.NewCallbackWorker("dao-active-votes-worker", avw.ProcessVotes))
-	a.manager.AddWorker(process.NewCallbackWorker("dao-proposals-counter-worker", avw.ProcessProposalCounters))
-	a.manager.AddWorker(process.NewCallbackWorker("top-dao-cache-worker", topDAOCache.Start))
-	a.manager.AddWorker(process.NewCallbackWorker("dao-recommendations", rw.Process))
-	a.manager.AddWorker(process.NewCallbackWorker("token-price", tpw.Process))
-	a.manager.AddWorker(process.NewCallbackWorker("fungible-chain-worker", fungibleChainWorker.Start))
+	tpw := dao.NewTokenPriceWorker(service, a.marketData)
+	a.daoTreasuryService = dao.NewTreasuryService(dao.NewTreasuryRepo(a.db), a.daoRepo, a.zerionClient)
+	// own erc20 balances are complemented by wallet positions for tokens which are not indexed
+	holdings := dao.NewHoldings(dao.NewHoldingsRepo(a.db), dao.NewWalletHoldings(a.zerionClient))
+	a.daoRecommendationService = dao.NewRecommendationService(service, holdings, a.voteRepo)
+	tw := dao.NewTreasuryWorker(service, a.daoTreasuryService)
+	a.daoSimilarityService = dao.NewSimilarityService(dao.NewSimilarityRepo(a.db), a.daoRepo)
+	sw := dao.NewSimilarityWorker(a.daoSimilarityService)
+	a.daoActivityService = dao.NewActivityService(dao.NewActivityRepo(a.db))
+	ac := dao.NewActivityConsumer(nc, a.daoActivityService)
+	a.addCallbackWorker("dao-category-rules-worker", crw.Process)
+	a.addCallbackWorker("dao-new-voters-worker", mc.ProcessNew)
+	a.addCallbackWorker("dao-active-votes-worker", avw.ProcessVotes)
+	a.addCallbackWorker("dao-proposals-counter-worker", avw.ProcessProposalCounters)
+	a.addCallbackWorker("top-dao-cache-worker", topDAOCache.Start)
+	a.addCallbackWorker("dao-recommendations", rw.Process)
+	a.addCallbackWorker("token-price", tpw.Process)
+	a.addCallbackWorker("fungible-chain-worker", fungibleChainWorker.Start)
+	a.addCallbackWorker("dao-treasury-worker", tw.Process)
+	a.addCallbackWorker("dao-similarity-worker", sw.Process)
+	a.addCallbackWorker("dao-activity-consumer", ac.Start)
+	a.addCallbackWorker("dao-popularity-worker", pw.Process)
 
 	return nil
 }
 
-func (a *Application) initProposal(nc *nats.Conn, pb *natsclient.Publisher) error {
+func (a *Application) initProposal(nc *nats.Conn, pb *outbox.Publisher) error {
 	erService, err := events.NewService(a.eventsRepo)
 	if err != nil {
 		return fmt.Errorf("new events service: %w", err)
@@ -285,18 +362,22 @@ func (a *Application) initProposal(nc *nats.Conn, pb *natsclient.Publisher) erro
 	if err != nil {
 		return fmt.Errorf("proposal consumer: %w", err)
 	}
-	a.manager.AddWorker(process.NewCallbackWorker("proposal-consumer", cs.Start))
+	a.addCallbackWorker("proposal-consumer", cs.Start)
 
 	vw := proposal.NewVotingWorker(service)
-	a.manager.AddWorker(process.NewCallbackWorker("voting-worker", vw.Start))
+	a.addCallbackWorker("voting-worker", vw.Start)
 
 	tw := proposal.NewTopWorker(service)
-	a.manager.AddWorker(process.NewCallbackWorker("proposal-top-worker", tw.Start))
+	a.addCallbackWorker("proposal-top-worker", tw.Start)
+
+	a.proposalTallyService = proposal.NewTallyService(proposal.NewTallyRepo(a.db))
+	tlw := proposal.NewTallyWorker(a.proposalTallyService)
+	a.addCallbackWorker("proposal-tally-worker", tlw.Start)
 
 	return nil
 }
 
-func (a *Application) initDelegates(nc *nats.Conn, pb *natsclient.Publisher) error {
+func (a *Application) initDelegates(nc *nats.Conn, pb *outbox.Publisher) error {
 	dsConn, err := grpc.NewClient(
 		a.cfg.InternalAPI.DatasourceSnapshotAddress,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -311,23 +392,23 @@ func (a *Application) initDelegates(nc *nats.Conn, pb *natsclient.Publisher) err
 	a.delegateService = service
 
 	rw := delegate.NewRefreshWorker(service)
-	a.manager.AddWorker(process.NewCallbackWorker("delegates-refresh-worker", rw.Start))
+	a.addCallbackWorker("delegates-refresh-worker", rw.Start)
 
 	cs, err := delegate.NewConsumer(nc, service)
 	if err != nil {
 		return fmt.Errorf("delegates consumer: %w", err)
 	}
 
-	a.manager.AddWorker(process.NewCallbackWorker("delegates-allowed-daos", service.UpdateAllowedDaos))
-	a.manager.AddWorker(process.NewCallbackWorker("delegates-consumer", cs.Start))
+	a.addCallbackWorker("delegates-allowed-daos", service.UpdateAllowedDaos)
+	a.addCallbackWorker("delegates-consumer", cs.Start)
 
 	ltw := delegate.NewLifeTimeWorker(service)
-	a.manager.AddWorker(process.NewCallbackWorker("delegates-life-time-worker", ltw.Start))
+	a.addCallbackWorker("delegates-life-time-worker", ltw.Start)
 
 	return nil
 }
 
-func (a *Application) initVote(nc *nats.Conn, pb *natsclient.Publisher) error {
+func (a *Application) initVote(nc *nats.Conn, pb *outbox.Publisher) error {
 	dsConn, err := grpc.NewClient(
 		a.cfg.InternalAPI.DatasourceSnapshotAddress,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -339,7 +420,7 @@ func (a *Application) initVote(nc *nats.Conn, pb *natsclient.Publisher) error {
 	dsClient := votingpb.NewVotingClient(dsConn)
 	votesNotifier := pubsub.NewPubSub[string](1000) // TODO: const
 
-	service, err := vote.NewService(votesNotifier, a.voteRepo, a.daoService, pb, a.ensService, dsClient)
+	service, err := vote.NewService(votesNotifier, a.voteRepo, a.daoService, pb, a.ensService, a.daoActivityService, dsClient)
 	if err != nil {
 		return fmt.Errorf("vote service: %w", err)
 	}
@@ -349,7 +430,7 @@ func (a *Application) initVote(nc *nats.Conn, pb *natsclient.Publisher) error {
 	if err != nil {
 		return fmt.Errorf("vote consumer: %w", err)
 	}
-	a.manager.AddWorker(process.NewCallbackWorker("vote-consumer", cs.Start))
+	a.addCallbackWorker("vote-consumer", cs.Start)
 
 	return nil
 }
@@ -358,11 +439,20 @@ func (a *Application) initStats() {
 	a.statsService = stats.NewService(a.daoRepo, a.proposalRepo)
 	cw := stats.NewCalcTotalsWorker(a.statsService)
 
-	a.manager.AddWorker(process.NewCallbackWorker("calc-totals", cw.Start))
+	a.addCallbackWorker("calc-totals", cw.Start)
 }
 
 func (a *Application) initAPI() error {
-	authInterceptor := grpcsrv.NewAuthInterceptor()
+	authInterceptor, err := grpcsrv.NewAuthInterceptor(grpcsrv.AuthConfig{
+		Enabled:   a.cfg.InternalAPI.AuthEnabled,
+		APIKeys:   a.cfg.InternalAPI.AuthAPIKeys,
+		JWTSecret: a.cfg.InternalAPI.AuthJWTSecret,
+		Scopes:    a.cfg.InternalAPI.AuthScopes,
+	})
+	if err != nil {
+		return fmt.Errorf("grpcsrv.NewAuthInterceptor: %w", err)
+	}
+
 	srv := grpcsrv.NewGrpcServer(
 		[]string{
 			"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo",
@@ -370,8 +460,8 @@ func (a *Application) initAPI() error {
 		authInterceptor.AuthAndIdentifyTickerFunc,
 	)
 
-	storagepb.RegisterDaoServer(srv, dao.NewServer(a.daoService))
-	storagepb.RegisterProposalServer(srv, proposal.NewServer(a.proposalService))
+	storagepb.RegisterDaoServer(srv, dao.NewServer(a.daoService, a.daoTreasuryService, a.daoRecommendationService, a.daoSimilarityService, a.daoActivityService))
+	storagepb.RegisterProposalServer(srv, proposal.NewServer(a.proposalService, a.proposalTallyService))
 	storagepb.RegisterVoteServer(srv, vote.NewServer(a.voteService))
 	storagepb.RegisterEnsServer(srv, ensresolver.NewServer(a.ensService))
 	storagepb.RegisterStatsServer(srv, stats.NewServer(a.statsService))
@@ -379,6 +469,32 @@ func (a *Application) initAPI() error {
 
 	a.manager.AddWorker(grpcsrv.NewGrpcServerWorker("API", srv, a.cfg.InternalAPI.Bind))
 
+	return a.initGateway(srv)
+}
+
+// initGateway exposes storagepb services registered in the gRPC server over HTTP/JSON,
+// requests are sent through the gRPC API to apply the same interceptors
+func (a *Application) initGateway(srv *grpc.Server) error {
+	conn, err := grpc.NewClient(localAddress(a.cfg.InternalAPI.Bind), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("create connection with grpc api: %w", err)
+	}
+
+	services := make([]string, 0)
+	for name := range srv.GetServiceInfo() {
+		if strings.HasPrefix(name, storagePackage) {
+			services = append(services, name)
+		}
+	}
+	sort.Strings(services)
+
+	gw, err := gateway.New(conn, services)
+	if err != nil {
+		return fmt.Errorf("gateway.New: %w", err)
+	}
+
+	a.manager.AddWorker(process.NewServerWorker("gateway", gateway.NewServer(a.cfg.Gateway.Listen, gw, a.cfg.Gateway.Timeout)))
+
 	return nil
 }
 
@@ -407,11 +523,98 @@ func (a *Application) registerShutdown() {
 }
 
 func (a *Application) initZerionAPI() {
-	zc := zerionsdk.NewClient(a.cfg.Zerion.BaseURL, a.cfg.Zerion.Key, http.DefaultClient)
+	zc := zerionsdk.NewClient(
+		a.cfg.Zerion.BaseURL,
+		a.cfg.Zerion.Key,
+		http.DefaultClient,
+		zerionsdk.WithRateLimit(a.cfg.Zerion.RateLimit, a.cfg.Zerion.RateBurst),
+		zerionsdk.WithRetries(a.cfg.Zerion.Retries),
+	)
 	a.zerionClient = zc
 }
 
-func (a *Application) initDiscordSender() {
-	ds := discord.NewSender(a.cfg.Discord.NewDaosURL)
-	a.discordSender = ds
+func (a *Application) initMarketData() error {
+	cg := coingeckosdk.NewClient(a.cfg.CoinGecko.BaseURL, a.cfg.CoinGecko.Key, a.cfg.CoinGecko.KeyHeader, http.DefaultClient)
+
+	providers, err := marketdata.NewProviders(a.cfg.MarketData.Providers, map[string]marketdata.Provider{
+		marketdata.Zerion:    marketdata.NewZerionProvider(a.zerionClient),
+		marketdata.CoinGecko: marketdata.NewCoinGeckoProvider(cg),
+	})
+	if err != nil {
+		return err
+	}
+
+	a.marketData = providers
+
+	return nil
+}
+
+func (a *Application) initNotifier() error {
+	cfg := a.cfg.Notifier
+	opts := []notifier.WebhookOption{
+		notifier.WithRetries(cfg.Retries),
+		notifier.WithInterval(cfg.Interval),
+	}
+
+	backends := make(map[string]notifier.Backend)
+	if cfg.DiscordURL != "" {
+		backends[notifier.BackendDiscord] = notifier.NewDiscord(cfg.DiscordURL, opts...)
+	}
+	if cfg.DiscordVerificationURL != "" {
+		backends[notifier.BackendDiscordVerification] = notifier.NewDiscord(cfg.DiscordVerificationURL, opts...)
+	}
+	if cfg.DiscordWorkerFailureURL != "" {
+		backends[notifier.BackendDiscordWorkerFailure] = notifier.NewDiscord(cfg.DiscordWorkerFailureURL, opts...)
+	}
+	if cfg.SlackURL != "" {
+		backends[notifier.BackendSlack] = notifier.NewSlack(cfg.SlackURL, opts...)
+	}
+	if cfg.WebhookURL != "" {
+		backends[notifier.BackendWebhook] = notifier.NewWebhook(cfg.WebhookURL, opts...)
+	}
+
+	n, err := notifier.New(notifier.Config{
+		Routes:       cfg.Routes,
+		TemplatesDir: cfg.TemplatesDir,
+	}, backends)
+	if err != nil {
+		return err
+	}
+
+	a.notifier = n
+
+	return nil
+}
+
+// addCallbackWorker adds the worker which notifies about its failure, the manager stops the app after it
+func (a *Application) addCallbackWorker(name string, cb process.CallbackFunc) {
+	a.manager.AddWorker(process.NewCallbackWorker(name, func(ctx context.Context) error {
+		err := cb(ctx)
+		if err == nil || errors.Is(err, context.Canceled) {
+			return err
+		}
+
+		nctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		defer cancel()
+
+		nerr := a.notifier.Notify(nctx, notifier.KindWorkerFailure, notifier.WorkerFailure{
+			Worker: name,
+			Error:  err.Error(),
+		})
+		if nerr != nil {
+			log.Warn().Err(nerr).Str("worker", name).Msg("notify worker failure")
+		}
+
+		return err
+	}))
+}
+
+// localAddress converts the bind address like :11000 to the address for local connections
+func localAddress(bind string) string {
+	host, port, err := net.SplitHostPort(bind)
+	if err != nil || host != "" {
+		return bind
+	}
+
+	return net.JoinHostPort("localhost", port)
 }
@@ -0,0 +1,39 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/config"
+	"github.com/goverland-labs/goverland-core-storage/internal/dao"
+)
+
+// BackfillActivity handles the backfill-activity subcommand:
+//
+//	backfill-activity              rebuild the dao activity rollup from the first proposal
+//	backfill-activity <YYYY-MM-DD> rebuild the dao activity rollup from the day
+func BackfillActivity(cfg config.App, args []string) error {
+	db, err := openDB(cfg.DB)
+	if err != nil {
+		return fmt.Errorf("open db: %w", err)
+	}
+
+	var from time.Time
+	if len(args) > 0 {
+		if from, err = time.Parse(time.DateOnly, args[0]); err != nil {
+			return fmt.Errorf("parse from day: %w", err)
+		}
+	}
+
+	days, err := dao.NewActivityService(dao.NewActivityRepo(db)).Backfill(context.Background(), from)
+	if err != nil {
+		return fmt.Errorf("backfill activity: %w", err)
+	}
+
+	log.Info().Int("days", days).Msg("dao activity is backfilled")
+
+	return nil
+}
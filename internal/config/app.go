@@ -7,6 +7,10 @@ type App struct {
 	Nats        Nats
 	DB          DB
 	InternalAPI InternalAPI
+	Gateway     Gateway
 	Zerion      Zerion
-	Discord     Discord
+	CoinGecko   CoinGecko
+	MarketData  MarketData
+	Notifier    Notifier
+	Popularity  Popularity
 }
@@ -4,4 +4,7 @@ type DB struct {
 	DSN                string `env:"POSTGRES_DSN" envDefault:"host=localhost port=5432 user=postgres password=DB_PASSWORD dbname=postgres sslmode=disable"`
 	MaxOpenConnections int    `env:"POSTGRES_MAX_OPEN_CONNECTIONS" envDefault:"30"`
 	Debug              bool   `env:"POSTGRES_DEBUG" envDefault:"false"`
+	MigrateOnStart     bool   `env:"POSTGRES_MIGRATE_ON_START" envDefault:"false"`
+	// MigrationsBaseline marks migrations up to the version as applied for databases migrated by hand, -1 disables it
+	MigrationsBaseline int `env:"POSTGRES_MIGRATIONS_BASELINE" envDefault:"-1"`
 }
@@ -0,0 +1,8 @@
+package config
+
+import "time"
+
+type Gateway struct {
+	Listen  string        `env:"GATEWAY_LISTEN" envDefault:":11080"`
+	Timeout time.Duration `env:"GATEWAY_TIMEOUT" envDefault:"30s"`
+}
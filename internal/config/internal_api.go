@@ -4,4 +4,11 @@ type InternalAPI struct {
 	Bind                      string `env:"INTERNAL_API_GRPC_SERVER_BIND" envDefault:":11000"`
 	DatasourceSnapshotAddress string `env:"INTERNAL_API_DATASOURCE_SNAPSHOT_ADDRESS" envDefault:"localhost:11100"`
 	EnsResolverAddress        string `env:"INTERNAL_API_ENS_RESOLVER_ADDRESS" envDefault:":20200"`
+	AuthEnabled               bool   `env:"INTERNAL_API_AUTH_ENABLED" envDefault:"false"`
+	// AuthAPIKeys is the list of client:key pairs
+	AuthAPIKeys []string `env:"INTERNAL_API_AUTH_KEYS"`
+	// AuthJWTSecret is the secret for HS256 tokens, the client name is taken from the sub claim
+	AuthJWTSecret string `env:"INTERNAL_API_AUTH_JWT_SECRET"`
+	// AuthScopes is the list of client:method_pattern pairs, e.g. web:/storagepb.Dao/* or *:/storagepb.*/Get*
+	AuthScopes []string `env:"INTERNAL_API_AUTH_SCOPES"`
 }
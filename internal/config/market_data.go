@@ -0,0 +1,13 @@
+package config
+
+type MarketData struct {
+	// Providers is the ordered list of market data providers, tokens are searched in the next one if the previous has no listing
+	Providers []string `env:"MARKET_DATA_PROVIDERS" envDefault:"zerion,coingecko"`
+}
+
+type CoinGecko struct {
+	BaseURL string `env:"COINGECKO_API_BASE_URL" envDefault:"https://api.coingecko.com/api/v3"`
+	Key     string `env:"COINGECKO_API_KEY"`
+	// KeyHeader is x-cg-demo-api-key for the demo plan and x-cg-pro-api-key for paid plans
+	KeyHeader string `env:"COINGECKO_API_KEY_HEADER" envDefault:"x-cg-demo-api-key"`
+}
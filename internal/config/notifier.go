@@ -0,0 +1,20 @@
+package config
+
+import "time"
+
+type Notifier struct {
+	// Routes is the list of kind:backend pairs, kinds are token_found, verification_decision and worker_failure,
+	// backends are discord, discord_verification, discord_worker_failure, slack and webhook.
+	// Routes to backends without urls are skipped.
+	Routes []string `env:"NOTIFIER_ROUTES" envDefault:"token_found:discord,verification_decision:discord_verification,worker_failure:discord_worker_failure"`
+	// TemplatesDir contains <kind>.tmpl files which override default templates
+	TemplatesDir string `env:"NOTIFIER_TEMPLATES_DIR"`
+	// DiscordURL is the channel of new daos with found tokens
+	DiscordURL              string        `env:"DISCORD_NEW_DAOS_URL"`
+	DiscordVerificationURL  string        `env:"NOTIFIER_DISCORD_VERIFICATION_URL"`
+	DiscordWorkerFailureURL string        `env:"NOTIFIER_DISCORD_WORKER_FAILURE_URL"`
+	SlackURL                string        `env:"NOTIFIER_SLACK_URL"`
+	WebhookURL              string        `env:"NOTIFIER_WEBHOOK_URL"`
+	Retries                 int           `env:"NOTIFIER_RETRIES" envDefault:"3"`
+	Interval                time.Duration `env:"NOTIFIER_INTERVAL" envDefault:"1s"`
+}
@@ -0,0 +1,18 @@
+package config
+
+import "time"
+
+type Popularity struct {
+	// Mode is external, local or blend, it defines which index is used for ordering daos
+	Mode string `env:"POPULARITY_MODE" envDefault:"external"`
+	// BlendWeight is the share of the local index in the blend mode
+	BlendWeight float64       `env:"POPULARITY_BLEND_WEIGHT" envDefault:"0.5"`
+	Window      time.Duration `env:"POPULARITY_WINDOW" envDefault:"720h"`
+	Interval    time.Duration `env:"POPULARITY_INTERVAL" envDefault:"1h"`
+
+	VotersWeight    float64 `env:"POPULARITY_VOTERS_WEIGHT" envDefault:"1"`
+	VotesWeight     float64 `env:"POPULARITY_VOTES_WEIGHT" envDefault:"0.5"`
+	ProposalsWeight float64 `env:"POPULARITY_PROPOSALS_WEIGHT" envDefault:"0.5"`
+	FollowersWeight float64 `env:"POPULARITY_FOLLOWERS_WEIGHT" envDefault:"0.25"`
+	VerifiedWeight  float64 `env:"POPULARITY_VERIFIED_WEIGHT" envDefault:"1"`
+}
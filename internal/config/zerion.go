@@ -1,6 +1,9 @@
 package config
 
 type Zerion struct {
-	BaseURL string `env:"ZERION_API_BASE_URL" require:"true"`
-	Key     string `env:"ZERION_API_KEY" require:"true"`
+	BaseURL   string  `env:"ZERION_API_BASE_URL" require:"true"`
+	Key       string  `env:"ZERION_API_KEY" require:"true"`
+	RateLimit float64 `env:"ZERION_API_RATE_LIMIT" envDefault:"5"`
+	RateBurst int     `env:"ZERION_API_RATE_BURST" envDefault:"5"`
+	Retries   int     `env:"ZERION_API_RETRIES" envDefault:"3"`
 }
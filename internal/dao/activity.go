@@ -0,0 +1,188 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Granularities of the activity series
+const (
+	GranularityDay   = "day"
+	GranularityWeek  = "week"
+	GranularityMonth = "month"
+)
+
+var ErrInvalidGranularity = errors.New("invalid granularity")
+
+// Activity is the daily rollup of proposals and votes of the dao, days are in UTC
+type Activity struct {
+	DaoID     uuid.UUID `gorm:"primary_key"`
+	Day       time.Time `gorm:"primary_key;type:date"`
+	Proposals int
+	Votes     int
+	// Voters is the number of unique voters of the day
+	Voters int
+	// NewVoters is the number of voters who voted in the dao for the first time
+	NewVoters int
+	// Vp is the total voting power of votes cast on the day
+	Vp        float64
+	UpdatedAt time.Time
+}
+
+func (Activity) TableName() string {
+	return "dao_activity"
+}
+
+// ActivityPoint is the activity of the dao in the period started at Time
+type ActivityPoint struct {
+	Time      time.Time
+	Proposals int
+	Votes     int
+	Voters    int
+	NewVoters int
+	Vp        float64
+}
+
+type ActivityDataProvider interface {
+	// RefreshDao recalculates the activity of the dao on the day from votes and proposals
+	RefreshDao(ctx context.Context, id uuid.UUID, day time.Time) error
+	// RefreshDay recalculates the activity of all daos on the day
+	RefreshDay(ctx context.Context, day time.Time) error
+	GetFirstDay() (time.Time, error)
+	GetDaily(id uuid.UUID, from, to time.Time) ([]Activity, error)
+	// GetVoters returns unique voters by periods of the granularity, daily voters can't be summed up
+	GetVoters(id uuid.UUID, from, to time.Time, granularity string) (map[time.Time]int, error)
+}
+
+type ActivityService struct {
+	repo ActivityDataProvider
+}
+
+func NewActivityService(r ActivityDataProvider) *ActivityService {
+	return &ActivityService{repo: r}
+}
+
+// Refresh recalculates the activity of the dao on days of passed unix timestamps.
+// The rollup is rebuilt from stored votes and proposals, so redelivered events are handled as is.
+func (s *ActivityService) Refresh(ctx context.Context, id uuid.UUID, created ...int) error {
+	days := make(map[time.Time]struct{})
+	for _, ts := range created {
+		days[truncate(time.Unix(int64(ts), 0), GranularityDay)] = struct{}{}
+	}
+
+	for day := range days {
+		if err := s.repo.RefreshDao(ctx, id, day); err != nil {
+			return fmt.Errorf("refresh activity #%s on %s: %w", id, day.Format(time.DateOnly), err)
+		}
+	}
+
+	return nil
+}
+
+// Backfill recalculates the activity of all daos day by day from the passed day or from the first proposal till today
+func (s *ActivityService) Backfill(ctx context.Context, from time.Time) (int, error) {
+	if from.IsZero() {
+		first, err := s.repo.GetFirstDay()
+		if err != nil {
+			return 0, fmt.Errorf("get first day: %w", err)
+		}
+
+		from = first
+	}
+
+	var (
+		cnt   int
+		today = truncate(time.Now(), GranularityDay)
+	)
+	for day := truncate(from, GranularityDay); !day.After(today); day = day.AddDate(0, 0, 1) {
+		if err := ctx.Err(); err != nil {
+			return cnt, err
+		}
+
+		if err := s.repo.RefreshDay(ctx, day); err != nil {
+			return cnt, fmt.Errorf("refresh activity on %s: %w", day.Format(time.DateOnly), err)
+		}
+
+		cnt++
+	}
+
+	return cnt, nil
+}
+
+// GetActivity returns the series of the dao activity from the period containing from till to.
+// Periods without activity are returned with zero values.
+func (s *ActivityService) GetActivity(id uuid.UUID, from, to time.Time, granularity string) ([]ActivityPoint, error) {
+	if granularity != GranularityDay && granularity != GranularityWeek && granularity != GranularityMonth {
+		return nil, ErrInvalidGranularity
+	}
+
+	from = truncate(from, granularity)
+	to = truncate(to, GranularityDay).AddDate(0, 0, 1)
+
+	daily, err := s.repo.GetDaily(id, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("get daily activity: %w", err)
+	}
+
+	var voters map[time.Time]int
+	if granularity != GranularityDay {
+		if voters, err = s.repo.GetVoters(id, from, to, granularity); err != nil {
+			return nil, fmt.Errorf("get voters: %w", err)
+		}
+	}
+
+	var (
+		points []ActivityPoint
+		idx    = make(map[time.Time]int)
+	)
+	for t := from; t.Before(to); t = next(t, granularity) {
+		idx[t] = len(points)
+		points = append(points, ActivityPoint{Time: t, Voters: voters[t]})
+	}
+
+	for _, item := range daily {
+		i, ok := idx[truncate(item.Day, granularity)]
+		if !ok {
+			continue
+		}
+
+		points[i].Proposals += item.Proposals
+		points[i].Votes += item.Votes
+		points[i].NewVoters += item.NewVoters
+		points[i].Vp += item.Vp
+		if granularity == GranularityDay {
+			points[i].Voters = item.Voters
+		}
+	}
+
+	return points, nil
+}
+
+// truncate returns the start of the period in UTC, weeks are started on Monday as in postgres date_trunc
+func truncate(t time.Time, granularity string) time.Time {
+	y, m, d := t.UTC().Date()
+	switch granularity {
+	case GranularityWeek:
+		day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case GranularityMonth:
+		return time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+func next(t time.Time, granularity string) time.Time {
+	switch granularity {
+	case GranularityWeek:
+		return t.AddDate(0, 0, 7)
+	case GranularityMonth:
+		return t.AddDate(0, 1, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
@@ -0,0 +1,111 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/rs/zerolog/log"
+
+	coreevents "github.com/goverland-labs/goverland-platform-events/events/core"
+	client "github.com/goverland-labs/goverland-platform-events/pkg/natsclient"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/config"
+	"github.com/goverland-labs/goverland-core-storage/internal/metrics"
+)
+
+const activityGroupName = "dao_activity"
+
+// ActivityConsumer keeps the daily activity rollup up to date by stored votes and proposals
+type ActivityConsumer struct {
+	conn      *nats.Conn
+	activity  *ActivityService
+	consumers []closable
+}
+
+func NewActivityConsumer(nc *nats.Conn, as *ActivityService) *ActivityConsumer {
+	return &ActivityConsumer{
+		conn:      nc,
+		activity:  as,
+		consumers: make([]closable, 0),
+	}
+}
+
+func (c *ActivityConsumer) handleVotes() coreevents.VotesHandler {
+	return func(payload coreevents.VotesPayload) error {
+		var err error
+		defer func(start time.Time) {
+			metricHandleHistogram.
+				WithLabelValues("handle_activity_votes", metrics.ErrLabelValue(err)).
+				Observe(time.Since(start).Seconds())
+		}(time.Now())
+
+		created := make(map[uuid.UUID][]int)
+		for _, item := range payload {
+			created[item.DaoID] = append(created[item.DaoID], item.Created)
+		}
+
+		for daoID, list := range created {
+			if err = c.activity.Refresh(context.TODO(), daoID, list...); err != nil {
+				log.Error().Err(err).Msg("process dao activity by votes")
+
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func (c *ActivityConsumer) handleProposal() coreevents.ProposalHandler {
+	return func(payload coreevents.ProposalPayload) error {
+		var err error
+		defer func(start time.Time) {
+			metricHandleHistogram.
+				WithLabelValues("handle_activity_proposal", metrics.ErrLabelValue(err)).
+				Observe(time.Since(start).Seconds())
+		}(time.Now())
+
+		if payload.DaoID == uuid.Nil || payload.Created == 0 {
+			return nil
+		}
+
+		// spam and canceled proposals are not counted, deleted proposals are published as canceled updates
+		if err = c.activity.Refresh(context.TODO(), payload.DaoID, payload.Created); err != nil {
+			log.Error().Err(err).Msg("process dao activity by proposal")
+		}
+
+		return err
+	}
+}
+
+func (c *ActivityConsumer) Start(ctx context.Context) error {
+	group := config.GenerateGroupName(activityGroupName)
+	vc, err := client.NewConsumer(ctx, c.conn, group, coreevents.SubjectVoteCreated, c.handleVotes(), client.WithMaxAckPending(maxPendingAckPerConsumer))
+	if err != nil {
+		return fmt.Errorf("consume for %s/%s: %w", group, coreevents.SubjectVoteCreated, err)
+	}
+	pc, err := client.NewConsumer(ctx, c.conn, group, coreevents.SubjectProposalCreated, c.handleProposal(), client.WithMaxAckPending(maxPendingAckPerConsumer))
+	if err != nil {
+		return fmt.Errorf("consume for %s/%s: %w", group, coreevents.SubjectProposalCreated, err)
+	}
+	pu, err := client.NewConsumer(ctx, c.conn, group, coreevents.SubjectProposalUpdated, c.handleProposal(), client.WithMaxAckPending(maxPendingAckPerConsumer))
+	if err != nil {
+		return fmt.Errorf("consume for %s/%s: %w", group, coreevents.SubjectProposalUpdated, err)
+	}
+
+	c.consumers = append(c.consumers, vc, pc, pu)
+
+	log.Info().Msg("dao activity consumers is started")
+
+	<-ctx.Done()
+	for _, cs := range c.consumers {
+		if err := cs.Close(); err != nil {
+			log.Error().Err(err).Msg("cant close dao activity consumer")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// activityRefreshQuery rebuilds the activity of the day, %s is the additional condition by the dao.
+// New voters are found by first votes which are calculated once for voters of the day.
+const activityRefreshQuery = `
+insert into dao_activity (dao_id, day, proposals, votes, voters, new_voters, vp, updated_at)
+select d.dao_id,
+       @day::date,
+       coalesce(p.proposals, 0),
+       coalesce(v.votes, 0),
+       coalesce(v.voters, 0),
+       coalesce(n.new_voters, 0),
+       coalesce(v.vp, 0),
+       now()
+from (select dao_id
+      from proposals
+      where created >= @from and created < @to %[1]s
+      union
+      select dao_id
+      from votes
+      where created >= @from and created < @to %[1]s) d
+         left join (select dao_id, count(*) proposals
+                    from proposals
+                    where created >= @from
+                      and created < @to
+                      and spam is not true
+                      and state != 'canceled' %[1]s
+                    group by dao_id) p on p.dao_id = d.dao_id
+         left join (select dao_id,
+                           count(*)                     votes,
+                           count(distinct lower(voter)) voters,
+                           sum(vp)                      vp
+                    from votes
+                    where created >= @from and created < @to %[1]s
+                    group by dao_id) v on v.dao_id = d.dao_id
+         left join (select f.dao_id, count(*) new_voters
+                    from (select dao_id, lower(voter), min(created) first_vote
+                          from votes
+                          where (dao_id, lower(voter)) in (select dao_id, lower(voter)
+                                                           from votes
+                                                           where created >= @from and created < @to %[1]s)
+                          group by 1, 2) f
+                    where f.first_vote >= @from
+                    group by f.dao_id) n on n.dao_id = d.dao_id
+`
+
+type ActivityRepo struct {
+	db *gorm.DB
+}
+
+func NewActivityRepo(db *gorm.DB) *ActivityRepo {
+	return &ActivityRepo{db: db}
+}
+
+func (r *ActivityRepo) RefreshDao(ctx context.Context, id uuid.UUID, day time.Time) error {
+	return r.refresh(ctx, day, "and dao_id = @dao", sql.Named("dao", id))
+}
+
+func (r *ActivityRepo) RefreshDay(ctx context.Context, day time.Time) error {
+	return r.refresh(ctx, day, "")
+}
+
+// refresh replaces rows of the day, so days without proposals and votes are removed
+func (r *ActivityRepo) refresh(ctx context.Context, day time.Time, cond string, args ...any) error {
+	var (
+		dummy = Activity{}
+		_     = dummy.DaoID
+		_     = dummy.Day
+		_     = dummy.Proposals
+		_     = dummy.Votes
+		_     = dummy.Voters
+		_     = dummy.NewVoters
+		_     = dummy.Vp
+		_     = dummy.UpdatedAt
+	)
+
+	args = append(args,
+		sql.Named("day", day.Format(time.DateOnly)),
+		sql.Named("from", day.Unix()),
+		sql.Named("to", day.AddDate(0, 0, 1).Unix()),
+	)
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(fmt.Sprintf("delete from dao_activity where day = @day::date %s", cond), args...).Error; err != nil {
+			return fmt.Errorf("delete activity: %w", err)
+		}
+
+		if err := tx.Exec(fmt.Sprintf(activityRefreshQuery, cond), args...).Error; err != nil {
+			return fmt.Errorf("insert activity: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetFirstDay returns the day of the first proposal
+func (r *ActivityRepo) GetFirstDay() (time.Time, error) {
+	var created sql.NullInt64
+	err := r.db.
+		Raw(`select min(created) from proposals`).
+		Scan(&created).
+		Error
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get first proposal: %w", err)
+	}
+
+	if !created.Valid {
+		return time.Now(), nil
+	}
+
+	return time.Unix(created.Int64, 0), nil
+}
+
+func (r *ActivityRepo) GetDaily(id uuid.UUID, from, to time.Time) ([]Activity, error) {
+	var list []Activity
+	err := r.db.
+		Where("dao_id = ? and day >= ?::date and day < ?::date", id, from.Format(time.DateOnly), to.Format(time.DateOnly)).
+		Order("day").
+		Find(&list).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("get activity #%s: %w", id, err)
+	}
+
+	return list, nil
+}
+
+func (r *ActivityRepo) GetVoters(id uuid.UUID, from, to time.Time, granularity string) (map[time.Time]int, error) {
+	var rows []struct {
+		Period time.Time
+		Voters int
+	}
+	err := r.db.
+		Raw(`
+select date_trunc(?, to_timestamp(created) at time zone 'utc') period, count(distinct lower(voter)) voters
+from votes
+where dao_id = ?
+  and created >= ?
+  and created < ?
+group by 1`, granularity, id, from.Unix(), to.Unix()).
+		Scan(&rows).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("get voters #%s: %w", id, err)
+	}
+
+	res := make(map[time.Time]int, len(rows))
+	for _, row := range rows {
+		res[truncate(row.Period, granularity)] = row.Voters
+	}
+
+	return res, nil
+}
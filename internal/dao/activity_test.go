@@ -0,0 +1,130 @@
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type activityStub struct {
+	daily     []Activity
+	voters    map[time.Time]int
+	refreshed []time.Time
+}
+
+func (a *activityStub) RefreshDao(_ context.Context, _ uuid.UUID, day time.Time) error {
+	a.refreshed = append(a.refreshed, day)
+	return nil
+}
+
+func (a *activityStub) RefreshDay(_ context.Context, day time.Time) error {
+	a.refreshed = append(a.refreshed, day)
+	return nil
+}
+
+func (a *activityStub) GetFirstDay() (time.Time, error) {
+	return time.Now().AddDate(0, 0, -2), nil
+}
+
+func (a *activityStub) GetDaily(_ uuid.UUID, from, to time.Time) ([]Activity, error) {
+	var res []Activity
+	for _, item := range a.daily {
+		if !item.Day.Before(from) && item.Day.Before(to) {
+			res = append(res, item)
+		}
+	}
+
+	return res, nil
+}
+
+func (a *activityStub) GetVoters(_ uuid.UUID, _, _ time.Time, _ string) (map[time.Time]int, error) {
+	return a.voters, nil
+}
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+func TestUnitGetActivity(t *testing.T) {
+	daily := []Activity{
+		{Day: date("2024-03-03"), Proposals: 1, Votes: 10, Voters: 8, NewVoters: 2, Vp: 100},
+		{Day: date("2024-03-04"), Votes: 5, Voters: 5, NewVoters: 1, Vp: 50},
+		{Day: date("2024-03-06"), Proposals: 2, Votes: 3, Voters: 3, Vp: 1.5},
+	}
+
+	for name, tc := range map[string]struct {
+		from, to    time.Time
+		granularity string
+		voters      map[time.Time]int
+		expected    []ActivityPoint
+		err         error
+	}{
+		"daily with empty days": {
+			from:        date("2024-03-03").Add(15 * time.Hour),
+			to:          date("2024-03-06").Add(time.Hour),
+			granularity: GranularityDay,
+			expected: []ActivityPoint{
+				{Time: date("2024-03-03"), Proposals: 1, Votes: 10, Voters: 8, NewVoters: 2, Vp: 100},
+				{Time: date("2024-03-04"), Votes: 5, Voters: 5, NewVoters: 1, Vp: 50},
+				{Time: date("2024-03-05")},
+				{Time: date("2024-03-06"), Proposals: 2, Votes: 3, Voters: 3, Vp: 1.5},
+			},
+		},
+		"weekly from monday": {
+			from:        date("2024-03-03"),
+			to:          date("2024-03-06"),
+			granularity: GranularityWeek,
+			voters:      map[time.Time]int{date("2024-02-26"): 8, date("2024-03-04"): 7},
+			expected: []ActivityPoint{
+				{Time: date("2024-02-26"), Proposals: 1, Votes: 10, Voters: 8, NewVoters: 2, Vp: 100},
+				{Time: date("2024-03-04"), Proposals: 2, Votes: 8, Voters: 7, NewVoters: 1, Vp: 51.5},
+			},
+		},
+		"monthly": {
+			from:        date("2024-03-05"),
+			to:          date("2024-03-31"),
+			granularity: GranularityMonth,
+			voters:      map[time.Time]int{date("2024-03-01"): 12},
+			expected: []ActivityPoint{
+				{Time: date("2024-03-01"), Proposals: 3, Votes: 18, Voters: 12, NewVoters: 3, Vp: 151.5},
+			},
+		},
+		"invalid granularity": {
+			granularity: "year",
+			err:         ErrInvalidGranularity,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			s := NewActivityService(&activityStub{daily: daily, voters: tc.voters})
+
+			points, err := s.GetActivity(id1, tc.from, tc.to, tc.granularity)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, points)
+		})
+	}
+}
+
+func TestUnitRefreshActivity(t *testing.T) {
+	repo := &activityStub{}
+	s := NewActivityService(repo)
+
+	created := date("2024-03-03").Add(time.Hour)
+	err := s.Refresh(context.Background(), id1, int(created.Unix()), int(created.Add(5*time.Hour).Unix()))
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{date("2024-03-03")}, repo.refreshed)
+
+	repo.refreshed = nil
+	days, err := s.Backfill(context.Background(), time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, 3, days)
+	require.Len(t, repo.refreshed, 3)
+}
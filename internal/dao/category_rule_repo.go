@@ -0,0 +1,25 @@
+package dao
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type CategoryRuleRepo struct {
+	db *gorm.DB
+}
+
+func NewCategoryRuleRepo(db *gorm.DB) *CategoryRuleRepo {
+	return &CategoryRuleRepo{db: db}
+}
+
+// GetAll returns enabled and disabled rules, categories of both are treated as system ones
+func (r *CategoryRuleRepo) GetAll() ([]CategoryRule, error) {
+	var list []CategoryRule
+	if err := r.db.Order("category").Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("get category rules: %w", err)
+	}
+
+	return list, nil
+}
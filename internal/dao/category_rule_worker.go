@@ -0,0 +1,74 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	categoryRulesCheckDelay = time.Hour
+)
+
+type CategoryRuleProvider interface {
+	GetAll() ([]CategoryRule, error)
+}
+
+// CategoryRuleWorker periodically applies system category rules
+type CategoryRuleWorker struct {
+	service *Service
+	rules   CategoryRuleProvider
+}
+
+func NewCategoryRuleWorker(s *Service, rules CategoryRuleProvider) *CategoryRuleWorker {
+	return &CategoryRuleWorker{
+		service: s,
+		rules:   rules,
+	}
+}
+
+func (w *CategoryRuleWorker) Process(ctx context.Context) error {
+	for {
+		w.process(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(categoryRulesCheckDelay):
+		}
+	}
+}
+
+func (w *CategoryRuleWorker) process(ctx context.Context) {
+	rules, err := w.rules.GetAll()
+	if err != nil {
+		log.Error().Err(err).Msg("get category rules")
+		return
+	}
+
+	categories := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		categories = append(categories, rule.Category)
+	}
+	w.service.SetSystemCategories(categories)
+
+	for _, rule := range rules {
+		if !rule.Enabled {
+			continue
+		}
+
+		if err = rule.Validate(); err != nil {
+			log.Error().Err(err).Msg("invalid category rule")
+			continue
+		}
+
+		start := time.Now()
+		if err = w.service.ApplyCategoryRule(ctx, rule); err != nil {
+			log.Error().Err(err).Str("category", rule.Category).Msg("apply category rule")
+			continue
+		}
+
+		log.Info().Str("category", rule.Category).Dur("duration", time.Since(start)).Msg("category rule applied")
+	}
+}
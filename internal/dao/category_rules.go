@@ -0,0 +1,218 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/optimistic"
+	"github.com/goverland-labs/goverland-core-storage/internal/outbox"
+)
+
+// Orders of daos matched by the category rule
+const (
+	RuleOrderPopularityIndex = "popularity_index"
+	RuleOrderVotersCount     = "voters_count"
+	RuleOrderFollowersCount  = "followers_count"
+	RuleOrderProposalsCount  = "proposals_count"
+	// RuleOrderRecentVoters orders daos by unique voters during the rule window
+	RuleOrderRecentVoters = "recent_voters"
+)
+
+// CategoryRule assigns the system category to daos matched by the conditions and removes it from others.
+// If the limit is set only the first daos in the rule order get the category,
+// e.g. trending: top 50 by recent voters with the 30 days window.
+type CategoryRule struct {
+	Category   string `gorm:"primary_key"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Enabled    bool
+	Conditions RuleConditions `gorm:"serializer:json"`
+	OrderBy    string
+	WindowDays int
+	Limit      int
+}
+
+func (CategoryRule) TableName() string {
+	return "dao_category_rules"
+}
+
+// RuleConditions are combined with AND, zero values are skipped
+type RuleConditions struct {
+	// ActivitySinceDays keeps daos with the first proposal created during the last days
+	ActivitySinceDays int `json:"activity_since_days,omitempty"`
+	// KeepActivitySinceDays is the longer window for daos which already have the category, so they don't flap on the edge
+	KeepActivitySinceDays int  `json:"keep_activity_since_days,omitempty"`
+	Verified              bool `json:"verified,omitempty"`
+	HasFungibleID         bool `json:"has_fungible_id,omitempty"`
+	MinVotersCount        int  `json:"min_voters_count,omitempty"`
+	MinProposalsCount     int  `json:"min_proposals_count,omitempty"`
+}
+
+func (r CategoryRule) Validate() error {
+	if r.Category == "" {
+		return fmt.Errorf("empty category")
+	}
+
+	switch r.OrderBy {
+	case "", RuleOrderPopularityIndex, RuleOrderVotersCount, RuleOrderFollowersCount, RuleOrderProposalsCount:
+	case RuleOrderRecentVoters:
+		if r.WindowDays <= 0 {
+			return fmt.Errorf("rule %s: window days are required for %s order", r.Category, r.OrderBy)
+		}
+	default:
+		return fmt.Errorf("rule %s: unknown order %s", r.Category, r.OrderBy)
+	}
+
+	if r.Limit < 0 {
+		return fmt.Errorf("rule %s: negative limit", r.Category)
+	}
+
+	if r.Limit > 0 && r.OrderBy == "" {
+		return fmt.Errorf("rule %s: limit requires the order", r.Category)
+	}
+
+	if c := r.Conditions; c.KeepActivitySinceDays != 0 && c.KeepActivitySinceDays < c.ActivitySinceDays {
+		return fmt.Errorf("rule %s: keep window is shorter than the activity window", r.Category)
+	}
+
+	return nil
+}
+
+// filters converts the rule to dao filters, now is the base for time windows
+func (r CategoryRule) filters(now time.Time) []Filter {
+	var filters []Filter
+
+	c := r.Conditions
+	if c.ActivitySinceDays > 0 {
+		filters = append(filters, ActivitySinceRangeFilter{From: now.AddDate(0, 0, -c.ActivitySinceDays)})
+	}
+	if c.Verified {
+		filters = append(filters, VerifiedFilter{})
+	}
+	if c.HasFungibleID {
+		filters = append(filters, FungibleIdFilter{})
+	}
+	if c.MinVotersCount > 0 {
+		filters = append(filters, MinVotersCountFilter{Count: c.MinVotersCount})
+	}
+	if c.MinProposalsCount > 0 {
+		filters = append(filters, MinProposalsCountFilter{Count: c.MinProposalsCount})
+	}
+
+	switch r.OrderBy {
+	case RuleOrderPopularityIndex:
+		filters = append(filters, OrderByPopularityIndexFilter{})
+	case RuleOrderVotersCount:
+		filters = append(filters, OrderByVotersFilter{})
+	case RuleOrderFollowersCount:
+		filters = append(filters, OrderByFollowersFilter{})
+	case RuleOrderProposalsCount:
+		filters = append(filters, OrderByProposalsFilter{})
+	case RuleOrderRecentVoters:
+		filters = append(filters, OrderByRecentVotersFilter{Since: now.AddDate(0, 0, -r.WindowDays)})
+	}
+
+	if r.Limit > 0 {
+		filters = append(filters, PageFilter{Limit: r.Limit})
+	}
+
+	return filters
+}
+
+// keepFilters converts the rule to dao filters which keep the category, it returns nil if the rule has no keep window
+func (r CategoryRule) keepFilters(now time.Time) []Filter {
+	if r.Conditions.KeepActivitySinceDays == 0 {
+		return nil
+	}
+
+	r.Conditions.ActivitySinceDays = r.Conditions.KeepActivitySinceDays
+
+	return r.filters(now)
+}
+
+// ApplyCategoryRule sets the rule category to matched daos and removes it from others in one transaction.
+// Every changed dao gets the history record and the categories changed event.
+// The transaction is repeated with fresh daos if any of them is changed concurrently.
+func (s *Service) ApplyCategoryRule(ctx context.Context, rule CategoryRule) error {
+	return optimistic.Retry(ctx, "category_rule", func(ctx context.Context) error {
+		return s.applyCategoryRule(ctx, rule)
+	})
+}
+
+func (s *Service) applyCategoryRule(ctx context.Context, rule CategoryRule) error {
+	return s.repo.CallInTx(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		ids, err := s.repo.GetIDsByFilters(ctx, rule.filters(now))
+		if err != nil {
+			return fmt.Errorf("get matched daos: %w", err)
+		}
+
+		var keptIDs []uuid.UUID
+		if filters := rule.keepFilters(now); filters != nil {
+			if keptIDs, err = s.repo.GetIDsByFilters(ctx, filters); err != nil {
+				return fmt.Errorf("get kept daos: %w", err)
+			}
+		}
+		kept := make(map[uuid.UUID]struct{}, len(keptIDs))
+		for _, id := range keptIDs {
+			kept[id] = struct{}{}
+		}
+
+		daos, err := s.repo.GetForCategoryUpdate(ctx, rule.Category, ids)
+		if err != nil {
+			return fmt.Errorf("get daos for update: %w", err)
+		}
+
+		matched := make(map[uuid.UUID]struct{}, len(ids))
+		for _, id := range ids {
+			matched[id] = struct{}{}
+		}
+
+		for _, existed := range daos {
+			dao := existed
+			_, ok := matched[dao.ID]
+			_, keep := kept[dao.ID]
+			has := slices.Contains(dao.Categories, rule.Category)
+
+			switch {
+			case ok && !has:
+				dao.Categories = append(slices.Clone(dao.Categories), rule.Category)
+			case !ok && !keep && has:
+				dao.Categories = remove(dao.Categories, rule.Category)
+			default:
+				continue
+			}
+
+			dctx := outbox.WithKey(ctx, dao.ID.String())
+			changes, err := s.update(dctx, existed, dao, HistorySourceCategoryRule+rule.Category, s.repo.UpdateCategories)
+			if err != nil {
+				return err
+			}
+
+			if err = s.publishSettingsChanges(dctx, dao, changes); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// SetSystemCategories replaces categories which are managed by rules
+func (s *Service) SetSystemCategories(list []string) {
+	s.systemCategoriesMu.Lock()
+	defer s.systemCategoriesMu.Unlock()
+
+	s.systemCategories = list
+}
+
+func (s *Service) getSystemCategories() []string {
+	s.systemCategoriesMu.RLock()
+	defer s.systemCategoriesMu.RUnlock()
+
+	return s.systemCategories
+}
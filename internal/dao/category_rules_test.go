@@ -0,0 +1,201 @@
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/optimistic"
+)
+
+func TestUnitCategoryRuleFilters(t *testing.T) {
+	now := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		rule     CategoryRule
+		expected []Filter
+	}{
+		"new daos": {
+			rule: CategoryRule{Category: "new_daos", Conditions: RuleConditions{ActivitySinceDays: 90}},
+			expected: []Filter{
+				ActivitySinceRangeFilter{From: now.AddDate(0, 0, -90)},
+			},
+		},
+		"trending": {
+			rule: CategoryRule{Category: "trending", OrderBy: RuleOrderRecentVoters, WindowDays: 30, Limit: 50},
+			expected: []Filter{
+				OrderByRecentVotersFilter{Since: now.AddDate(0, 0, -30)},
+				PageFilter{Limit: 50},
+			},
+		},
+		"token governed": {
+			rule: CategoryRule{Category: "token_governed", Conditions: RuleConditions{HasFungibleID: true, MinVotersCount: 10}},
+			expected: []Filter{
+				FungibleIdFilter{},
+				MinVotersCountFilter{Count: 10},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, tc.rule.Validate())
+			require.Equal(t, tc.expected, tc.rule.filters(now))
+		})
+	}
+}
+
+func TestUnitCategoryRuleValidate(t *testing.T) {
+	for name, rule := range map[string]CategoryRule{
+		"empty category":       {},
+		"short keep window":    {Category: "c", Conditions: RuleConditions{ActivitySinceDays: 90, KeepActivitySinceDays: 30}},
+		"unknown order":        {Category: "c", OrderBy: "name"},
+		"window is required":   {Category: "c", OrderBy: RuleOrderRecentVoters},
+		"limit without order":  {Category: "c", Limit: 10},
+		"negative limit value": {Category: "c", OrderBy: RuleOrderVotersCount, Limit: -1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, rule.Validate())
+		})
+	}
+}
+
+func TestUnitApplyCategoryRule(t *testing.T) {
+	var (
+		added   = uuid.New()
+		kept    = uuid.New()
+		removed = uuid.New()
+	)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dp := NewMockDataProvider(ctrl)
+	dp.EXPECT().CallInTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, cb func(context.Context) error) error {
+		return cb(ctx)
+	})
+	dp.EXPECT().GetIDsByFilters(gomock.Any(), gomock.Any()).Return([]uuid.UUID{added, kept}, nil)
+	dp.EXPECT().GetForCategoryUpdate(gomock.Any(), "trending", []uuid.UUID{added, kept}).Return([]Dao{
+		{ID: added, Categories: Categories{"defi"}},
+		{ID: kept, Categories: Categories{"trending"}},
+		{ID: removed, Categories: Categories{"trending", "defi"}},
+	}, nil)
+
+	updated := make(map[uuid.UUID]Categories)
+	dp.EXPECT().UpdateCategories(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(func(_ context.Context, dao Dao) error {
+		updated[dao.ID] = dao.Categories
+		return nil
+	})
+	dp.EXPECT().CreateHistory(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(func(_ context.Context, h History) error {
+		require.Equal(t, "category_rule:trending", h.Source)
+		return nil
+	})
+
+	p := NewMockPublisher(ctrl)
+	p.EXPECT().PublishJSON(gomock.Any(), SubjectDaoCategoriesChanged, gomock.Any()).Times(2).Return(nil)
+
+	s, err := NewService(dp, nil, nil, p, nil, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	err = s.ApplyCategoryRule(context.Background(), CategoryRule{
+		Category: "trending",
+		OrderBy:  RuleOrderRecentVoters,
+		Limit:    2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[uuid.UUID]Categories{
+		added:   {"defi", "trending"},
+		removed: {"defi"},
+	}, updated)
+}
+
+func TestUnitApplyCategoryRuleKeepWindow(t *testing.T) {
+	var (
+		added   = uuid.New()
+		kept    = uuid.New()
+		removed = uuid.New()
+	)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dp := NewMockDataProvider(ctrl)
+	dp.EXPECT().CallInTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, cb func(context.Context) error) error {
+		return cb(ctx)
+	})
+	gomock.InOrder(
+		dp.EXPECT().GetIDsByFilters(gomock.Any(), gomock.Any()).Return([]uuid.UUID{added}, nil),
+		dp.EXPECT().GetIDsByFilters(gomock.Any(), gomock.Any()).Return([]uuid.UUID{added, kept}, nil),
+	)
+	dp.EXPECT().GetForCategoryUpdate(gomock.Any(), "new_daos", []uuid.UUID{added}).Return([]Dao{
+		{ID: added},
+		{ID: kept, Categories: Categories{"new_daos"}},
+		{ID: removed, Categories: Categories{"new_daos"}},
+	}, nil)
+
+	updated := make(map[uuid.UUID]Categories)
+	dp.EXPECT().UpdateCategories(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(func(_ context.Context, dao Dao) error {
+		updated[dao.ID] = dao.Categories
+		return nil
+	})
+	dp.EXPECT().CreateHistory(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+
+	p := NewMockPublisher(ctrl)
+	p.EXPECT().PublishJSON(gomock.Any(), SubjectDaoCategoriesChanged, gomock.Any()).Times(2).Return(nil)
+
+	s, err := NewService(dp, nil, nil, p, nil, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	err = s.ApplyCategoryRule(context.Background(), CategoryRule{
+		Category:   "new_daos",
+		Conditions: RuleConditions{ActivitySinceDays: 90, KeepActivitySinceDays: 91},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[uuid.UUID]Categories{
+		added:   {"new_daos"},
+		removed: {},
+	}, updated)
+}
+
+func TestUnitApplyCategoryRuleConflict(t *testing.T) {
+	added := uuid.New()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dp := NewMockDataProvider(ctrl)
+	dp.EXPECT().CallInTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, cb func(context.Context) error) error {
+		return cb(ctx)
+	})
+	dp.EXPECT().GetIDsByFilters(gomock.Any(), gomock.Any()).Times(2).Return([]uuid.UUID{added}, nil)
+	gomock.InOrder(
+		dp.EXPECT().GetForCategoryUpdate(gomock.Any(), "trending", []uuid.UUID{added}).Return([]Dao{{ID: added}}, nil),
+		dp.EXPECT().GetForCategoryUpdate(gomock.Any(), "trending", []uuid.UUID{added}).Return([]Dao{{ID: added, Categories: Categories{"defi"}}}, nil),
+	)
+
+	var updated Categories
+	gomock.InOrder(
+		dp.EXPECT().UpdateCategories(gomock.Any(), gomock.Any()).Return(optimistic.ErrConflict),
+		dp.EXPECT().UpdateCategories(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, dao Dao) error {
+			updated = dao.Categories
+			return nil
+		}),
+	)
+	dp.EXPECT().CreateHistory(gomock.Any(), gomock.Any()).Return(nil)
+
+	p := NewMockPublisher(ctrl)
+	p.EXPECT().PublishJSON(gomock.Any(), SubjectDaoCategoriesChanged, gomock.Any()).Return(nil)
+
+	s, err := NewService(dp, nil, nil, p, nil, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	err = s.ApplyCategoryRule(context.Background(), CategoryRule{
+		Category: "trending",
+		OrderBy:  RuleOrderRecentVoters,
+		Limit:    1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, Categories{"defi", "trending"}, updated)
+}
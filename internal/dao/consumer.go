@@ -29,22 +29,24 @@ type closable interface {
 }
 
 type Consumer struct {
-	conn      *nats.Conn
-	service   *Service
-	consumers []closable
+	conn       *nats.Conn
+	service    *Service
+	popularity *PopularityService
+	consumers  []closable
 }
 
-func NewConsumer(nc *nats.Conn, s *Service) (*Consumer, error) {
+func NewConsumer(nc *nats.Conn, s *Service, ps *PopularityService) (*Consumer, error) {
 	c := &Consumer{
-		conn:      nc,
-		service:   s,
-		consumers: make([]closable, 0),
+		conn:       nc,
+		service:    s,
+		popularity: ps,
+		consumers:  make([]closable, 0),
 	}
 
 	return c, nil
 }
 
-func (c *Consumer) handler() pevents.DaoHandler {
+func (c *Consumer) handler(subject string) pevents.DaoHandler {
 	return func(payload pevents.DaoPayload) error {
 		var err error
 		defer func(start time.Time) {
@@ -53,7 +55,15 @@ func (c *Consumer) handler() pevents.DaoHandler {
 				Observe(time.Since(start).Seconds())
 		}(time.Now())
 
-		err = c.service.HandleDao(context.TODO(), convertToDao(payload))
+		dao := convertToDao(payload)
+		dao.ParentID, err = c.service.GetParentID(payload.ID, payload.ParentID)
+		if err != nil {
+			log.Error().Err(err).Msg("process dao")
+
+			return err
+		}
+
+		err = c.service.HandleDao(context.TODO(), dao, subject)
 		if err != nil {
 			log.Error().Err(err).Msg("process dao")
 		}
@@ -93,10 +103,6 @@ func (c *Consumer) activitySinceHandler() pevents.DaoHandler {
 			return nil
 		}
 
-		if err = c.service.events.PublishJSON(context.TODO(), core.SubjectDaoUpdated, convertToCoreEvent(*updated)); err != nil {
-			log.Error().Err(err).Msgf("publish dao event #%s", updated.ID)
-		}
-
 		log.Debug().Msgf("dao activity since was processed: %s", payload.ID)
 
 		return err
@@ -203,7 +209,7 @@ func (c *Consumer) popularityIndexHandler() core.DaoHandler {
 				Observe(time.Since(start).Seconds())
 		}(time.Now())
 
-		err = c.service.ProcessPopularityIndexUpdate(context.TODO(), payload.ID, *payload.PopularityIndex)
+		err = c.popularity.ProcessExternal(context.TODO(), payload.ID, *payload.PopularityIndex)
 		if err != nil {
 			log.Error().Err(err).Msg("process popularity index updated")
 
@@ -230,11 +236,11 @@ func convertVoteToInternal(pl coreevents.VotesPayload) []UniqueVoter {
 
 func (c *Consumer) Start(ctx context.Context) error {
 	group := config.GenerateGroupName(groupName)
-	cc, err := client.NewConsumer(ctx, c.conn, group, pevents.SubjectDaoCreated, c.handler(), client.WithMaxAckPending(maxPendingAckPerConsumer))
+	cc, err := client.NewConsumer(ctx, c.conn, group, pevents.SubjectDaoCreated, c.handler(pevents.SubjectDaoCreated), client.WithMaxAckPending(maxPendingAckPerConsumer))
 	if err != nil {
 		return fmt.Errorf("consume for %s/%s: %w", group, pevents.SubjectDaoCreated, err)
 	}
-	cu, err := client.NewConsumer(ctx, c.conn, group, pevents.SubjectDaoUpdated, c.handler(), client.WithMaxAckPending(maxPendingAckPerConsumer))
+	cu, err := client.NewConsumer(ctx, c.conn, group, pevents.SubjectDaoUpdated, c.handler(pevents.SubjectDaoUpdated), client.WithMaxAckPending(maxPendingAckPerConsumer))
 	if err != nil {
 		return fmt.Errorf("consume for %s/%s: %w", group, pevents.SubjectDaoUpdated, err)
 	}
@@ -1,14 +1,22 @@
 package dao
 
 import (
+	"fmt"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
+// DaoID maps the identity of the dao in the source to the internal id.
+// Several identities can be linked to one dao, the dao data is received only from the primary one.
 type DaoID struct {
+	// OriginalID is the source-qualified id, see Identity.String
 	OriginalID string
 	InternalID uuid.UUID
+	Source     string
+	ExternalID string
+	IsPrimary  bool
 }
 
 type DaoIDRepo struct {
@@ -20,9 +28,17 @@ func NewDaoIDRepo(conn *gorm.DB) *DaoIDRepo {
 }
 
 func (r *DaoIDRepo) Upsert(id string) (*DaoID, error) {
+	identity, err := ParseIdentity(id)
+	if err != nil {
+		return nil, err
+	}
+
 	daoID := DaoID{
-		OriginalID: id,
+		OriginalID: identity.String(),
 		InternalID: uuid.New(), // TODO: Check UUID collision
+		Source:     identity.Source,
+		ExternalID: identity.ExternalID,
+		IsPrimary:  true,
 	}
 
 	query := r.conn.
@@ -40,8 +56,8 @@ func (r *DaoIDRepo) Upsert(id string) (*DaoID, error) {
 		return &daoID, nil
 	}
 
-	err := r.conn.
-		Where(DaoID{OriginalID: id}).
+	err = r.conn.
+		Where(DaoID{OriginalID: daoID.OriginalID}).
 		First(&daoID).
 		Error
 
@@ -52,6 +68,60 @@ func (r *DaoIDRepo) Upsert(id string) (*DaoID, error) {
 	return &daoID, err
 }
 
+// Link adds the identity to the dao, linking of the identity which belongs to another dao fails with ErrIdentityLinked
+func (r *DaoIDRepo) Link(identity Identity, internalID uuid.UUID) (*DaoID, error) {
+	daoID := DaoID{
+		OriginalID: identity.String(),
+		InternalID: internalID,
+		Source:     identity.Source,
+		ExternalID: identity.ExternalID,
+	}
+
+	query := r.conn.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "original_id"}},
+			DoNothing: true,
+		}).
+		Create(&daoID)
+	if query.Error != nil {
+		return nil, fmt.Errorf("create dao id %s: %w", daoID.OriginalID, query.Error)
+	}
+
+	if query.RowsAffected > 0 {
+		return &daoID, nil
+	}
+
+	var existed DaoID
+	err := r.conn.
+		Where(DaoID{OriginalID: daoID.OriginalID}).
+		First(&existed).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("get dao id %s: %w", daoID.OriginalID, err)
+	}
+
+	if existed.InternalID != internalID {
+		return nil, ErrIdentityLinked
+	}
+
+	return &existed, nil
+}
+
+// GetByInternalID returns identities of the dao, the primary one is the first
+func (r *DaoIDRepo) GetByInternalID(id uuid.UUID) ([]DaoID, error) {
+	var res []DaoID
+	err := r.conn.
+		Where(DaoID{InternalID: id}).
+		Order("is_primary desc, original_id").
+		Find(&res).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("get dao ids #%s: %w", id, err)
+	}
+
+	return res, nil
+}
+
 func (r *DaoIDRepo) GetAll() ([]DaoID, error) {
 	var res []DaoID
 
@@ -81,6 +151,33 @@ func (s *DaoIDService) GetOrCreate(originID string) (uuid.UUID, error) {
 	return daoID.InternalID, nil
 }
 
+// Link adds the source-qualified identity to the dao
+func (s *DaoIDService) Link(id uuid.UUID, originalID string) ([]DaoID, error) {
+	identity, err := ParseIdentity(originalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.repo.Link(identity, id); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByInternalID(id)
+}
+
+func (s *DaoIDService) GetIdentities(id uuid.UUID) ([]DaoID, error) {
+	list, err := s.repo.GetByInternalID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return list, nil
+}
+
 func (s *DaoIDService) GetAll() ([]DaoID, error) {
 	res, err := s.repo.GetAll()
 	if err != nil {
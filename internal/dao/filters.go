@@ -7,6 +7,8 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/pagination"
 )
 
 type Filter interface {
@@ -22,6 +24,16 @@ func (f PageFilter) Apply(db *gorm.DB) *gorm.DB {
 	return db.Offset(f.Offset).Limit(f.Limit)
 }
 
+// isPageFilter checks if the filter selects the page and should be skipped on counting
+func isPageFilter(f Filter) bool {
+	switch f.(type) {
+	case PageFilter, pagination.Keyset:
+		return true
+	default:
+		return false
+	}
+}
+
 type NameFilter struct {
 	Name string
 }
@@ -85,6 +97,83 @@ func (f OrderByVotersFilter) Apply(db *gorm.DB) *gorm.DB {
 	return db.Order("voters_count desc")
 }
 
+type OrderByProposalsFilter struct {
+}
+
+func (f OrderByProposalsFilter) Apply(db *gorm.DB) *gorm.DB {
+	return db.Order("proposals_count desc")
+}
+
+// OrderByRecentVotersFilter orders daos by unique voters who voted since the time
+type OrderByRecentVotersFilter struct {
+	Since time.Time
+}
+
+func (f OrderByRecentVotersFilter) Apply(db *gorm.DB) *gorm.DB {
+	return db.
+		Joins(`left join (
+	select dao_id, count(distinct lower(voter)) cnt
+	from votes
+	where created >= ?
+	group by dao_id
+) recent_voters on recent_voters.dao_id = daos.id`, f.Since.Unix()).
+		Order("coalesce(recent_voters.cnt, 0) desc")
+}
+
+type MinVotersCountFilter struct {
+	Count int
+}
+
+func (f MinVotersCountFilter) Apply(db *gorm.DB) *gorm.DB {
+	return db.Where("voters_count >= ?", f.Count)
+}
+
+type MinProposalsCountFilter struct {
+	Count int
+}
+
+func (f MinProposalsCountFilter) Apply(db *gorm.DB) *gorm.DB {
+	return db.Where("proposals_count >= ?", f.Count)
+}
+
+type ParentIDFilter struct {
+	ParentID uuid.UUID
+}
+
+func (f ParentIDFilter) Apply(db *gorm.DB) *gorm.DB {
+	var (
+		dummy Dao
+		_     = dummy.ParentID
+	)
+
+	return db.Where("parent_id = ?", f.ParentID)
+}
+
+type VerificationStatusFilter struct {
+	Status string
+}
+
+func (f VerificationStatusFilter) Apply(db *gorm.DB) *gorm.DB {
+	var (
+		dummy Dao
+		_     = dummy.VerificationStatus
+	)
+
+	return db.Where("verification_status = ?", f.Status)
+}
+
+type TreasuriesFilter struct {
+}
+
+func (f TreasuriesFilter) Apply(db *gorm.DB) *gorm.DB {
+	var (
+		dummy Dao
+		_     = dummy.Treasures
+	)
+
+	return db.Where("jsonb_array_length(coalesce(treasures, '[]'::jsonb)) > 0")
+}
+
 type DaoIDsFilter struct {
 	DaoIDs []string
 }
@@ -103,18 +192,56 @@ func (f DaoIDsFilter) Apply(db *gorm.DB) *gorm.DB {
 			continue
 		}
 
-		regular = append(regular, id)
+		regular = append(regular, normalizeOriginalID(id))
 	}
 
 	if len(regular) == 0 {
 		return db.Where("id IN ?", uids)
 	}
 
+	// linked identities are resolved by dao ids
+	linked := "id IN (select internal_id from dao_ids where original_id IN ?)"
 	if len(uids) == 0 {
-		return db.Where("original_id IN ?", regular)
+		return db.Where("original_id IN ? or "+linked, regular, regular)
 	}
 
-	return db.Where("id IN ? or original_id IN ?", uids, regular)
+	return db.Where("id IN ? or original_id IN ? or "+linked, uids, regular, regular)
+}
+
+// TokenFilter selects daos with strategies of the token, the network is optional
+type TokenFilter struct {
+	Network string
+	Address string
+}
+
+func (f TokenFilter) Apply(db *gorm.DB) *gorm.DB {
+	var (
+		dummy DaoStrategy
+		_     = dummy.DaoID
+		_     = dummy.Network
+		_     = dummy.Address
+	)
+
+	if f.Network == "" {
+		return db.Where("id IN (select dao_id from dao_strategies where lower(address) = lower(?))", f.Address)
+	}
+
+	return db.Where("id IN (select dao_id from dao_strategies where network = ? and lower(address) = lower(?))", f.Network, f.Address)
+}
+
+// StrategyFilter selects daos using the strategy, multichain sub-strategies are taken into account
+type StrategyFilter struct {
+	Name string
+}
+
+func (f StrategyFilter) Apply(db *gorm.DB) *gorm.DB {
+	var (
+		dummy DaoStrategy
+		_     = dummy.DaoID
+		_     = dummy.Name
+	)
+
+	return db.Where("id IN (select dao_id from dao_strategies where name = ?)", f.Name)
 }
 
 type ActivitySinceRangeFilter struct {
@@ -134,6 +261,12 @@ func (f ActivitySinceRangeFilter) Apply(db *gorm.DB) *gorm.DB {
 	return db
 }
 
+// daoKeyset orders daos by popularity with the id as the tie-breaker for cursor pagination
+var daoKeyset = pagination.NewKeyset(
+	pagination.Key{Column: "popularity_index", Desc: true},
+	pagination.Key{Column: "id"},
+)
+
 type OrderByPopularityIndexFilter struct {
 }
 
@@ -148,6 +281,14 @@ func (f FungibleIdFilter) Apply(db *gorm.DB) *gorm.DB {
 	return db.Where("fungible_id is not null and fungible_id!=''")
 }
 
+// TokenIDFilter keeps daos with the market data token, see Service.tokenID
+type TokenIDFilter struct {
+}
+
+func (f TokenIDFilter) Apply(db *gorm.DB) *gorm.DB {
+	return db.Where("((fungible_id is not null and fungible_id!='') or (coingecko is not null and coingecko!=''))")
+}
+
 type FungibleIdEmptyFilter struct {
 }
 
@@ -4,12 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/rs/zerolog/log"
 
-	"github.com/goverland-labs/goverland-core-storage/pkg/sdk/zerion"
+	"github.com/goverland-labs/goverland-core-storage/internal/marketdata"
 )
 
 const (
@@ -18,13 +17,13 @@ const (
 
 type FungibleChainWorker struct {
 	service      *Service
-	zerionClient *zerion.Client
+	market       marketdata.Provider
 	fungibleRepo *FungibleChainRepo
 }
 
-func NewFungibleChainWorker(zerionClient *zerion.Client, service *Service, fungibleRepo *FungibleChainRepo) *FungibleChainWorker {
+func NewFungibleChainWorker(market marketdata.Provider, service *Service, fungibleRepo *FungibleChainRepo) *FungibleChainWorker {
 	return &FungibleChainWorker{
-		zerionClient: zerionClient,
+		market:       market,
 		service:      service,
 		fungibleRepo: fungibleRepo,
 	}
@@ -32,7 +31,7 @@ func NewFungibleChainWorker(zerionClient *zerion.Client, service *Service, fungi
 
 func (c *FungibleChainWorker) Start(ctx context.Context) error {
 	for {
-		if err := c.process(); err != nil {
+		if err := c.process(ctx); err != nil {
 			log.Error().Err(err).Msg("chains cache update error")
 		}
 
@@ -45,19 +44,9 @@ func (c *FungibleChainWorker) Start(ctx context.Context) error {
 	}
 }
 
-func (c *FungibleChainWorker) process() error {
-	chains, err := c.zerionClient.GetChains()
-	if err != nil {
-		return fmt.Errorf("get chains: %w", err)
-	}
-
-	chainsMap := make(map[string]zerion.ChainData)
-	for _, chain := range chains {
-		chainsMap[chain.ID] = chain
-	}
-
+func (c *FungibleChainWorker) process(ctx context.Context) error {
 	filters := []Filter{FungibleIdFilter{}}
-	daoList, err := c.service.GetByFilters(filters)
+	daoList, err := c.service.GetByFilters(filters, false)
 	if err != nil {
 		return fmt.Errorf("get daos: %w", err)
 	}
@@ -68,7 +57,7 @@ func (c *FungibleChainWorker) process() error {
 			continue
 		}
 
-		fData, err := c.zerionClient.GetFungibleData(dao.FungibleId)
+		token, err := c.market.GetToken(ctx, dao.FungibleId)
 		if err != nil {
 			log.Error().Err(err).Msg("get fungible data")
 			continue
@@ -79,32 +68,24 @@ func (c *FungibleChainWorker) process() error {
 			allDaoChains[strategy.Network] = struct{}{}
 		}
 
-		for _, chainItem := range fData.Attributes.Implementations {
-			chainExInfo, ok := chainsMap[chainItem.ChainID]
-			if !ok {
-				log.Warn().Msgf("chain %s not found in chains map", chainItem.ChainID)
+		for _, chainItem := range token.Chains {
+			if chainItem.Network == "" {
+				log.Warn().Msgf("chain %s has no evm network", chainItem.ID)
 				continue
 			}
 
-			cutHex, _ := strings.CutPrefix(strings.ToLower(chainExInfo.Attributes.ExternalID), "0x")
-			decVal, err := strconv.ParseInt(cutHex, 16, 64)
-			if err != nil {
-				log.Warn().Err(err).Msgf("parse chain external id %s to decimal", chainExInfo.Attributes.ExternalID)
-				continue
-			}
-			decValStr := strconv.FormatInt(decVal, 10)
-
-			if _, ok := allDaoChains[decValStr]; !ok {
-				log.Warn().Msgf("dao %s has no strategy for chain %s", dao.Name, chainItem.ChainID)
+			if _, ok := allDaoChains[chainItem.Network]; !ok {
+				log.Warn().Msgf("dao %s has no strategy for chain %s", dao.Name, chainItem.ID)
 				continue
 			}
 
+			network, _ := strconv.ParseInt(chainItem.Network, 10, 64)
 			err = c.fungibleRepo.Save(FungibleChain{
 				FungibleID: dao.FungibleId,
-				ChainID:    chainItem.ChainID,
-				ExternalID: chainExInfo.Attributes.ExternalID,
-				ChainName:  chainExInfo.Attributes.Name,
-				IconURL:    chainExInfo.Attributes.Icon.URL,
+				ChainID:    chainItem.ID,
+				ExternalID: fmt.Sprintf("0x%x", network),
+				ChainName:  chainItem.Name,
+				IconURL:    chainItem.IconURL,
 				Address:    chainItem.Address,
 				Decimals:   chainItem.Decimals,
 			})
@@ -112,8 +93,6 @@ func (c *FungibleChainWorker) process() error {
 				log.Error().Err(err).Msg("save fungible chain")
 			}
 		}
-
-		time.Sleep(10 * time.Second) // TODO: add rate limiter
 	}
 
 	return nil
@@ -0,0 +1,68 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ChildrenRollup contains aggregates of child daos of the parent
+type ChildrenRollup struct {
+	ParentID       uuid.UUID
+	ProposalsCount int
+	ActiveVotes    int
+	// VotersCount the number of unique voters of the parent and all its children
+	VotersCount int
+}
+
+// GetParentID returns the internal identifier of the parent dao or nil if the dao has no parent.
+// The identifier is reserved on the first request, so children which come before the parent are linked as well.
+// Ids are compared and resolved in the stored form, so the parent governor with the checksum address is the same dao.
+func (s *Service) GetParentID(originalID, parentOriginalID string) (*uuid.UUID, error) {
+	parentOriginalID = normalizeOriginalID(parentOriginalID)
+	if parentOriginalID == "" || parentOriginalID == normalizeOriginalID(originalID) {
+		return nil, nil
+	}
+
+	id, err := s.GetIDByOriginalID(parentOriginalID)
+	if err != nil {
+		return nil, fmt.Errorf("get parent id: %w", err)
+	}
+
+	return &id, nil
+}
+
+// RollupChildren adds proposals and active votes of child daos to their parents from the list.
+// Voters count of the parent is replaced by the number of unique voters of the whole family.
+func (s *Service) RollupChildren(daos []Dao) ([]Dao, error) {
+	if len(daos) == 0 {
+		return daos, nil
+	}
+
+	ids := make([]uuid.UUID, len(daos))
+	for i := range daos {
+		ids[i] = daos[i].ID
+	}
+
+	list, err := s.repo.GetChildrenRollup(ids)
+	if err != nil {
+		return nil, fmt.Errorf("get children rollup: %w", err)
+	}
+
+	rollups := make(map[uuid.UUID]ChildrenRollup, len(list))
+	for _, r := range list {
+		rollups[r.ParentID] = r
+	}
+
+	res := make([]Dao, len(daos))
+	for i, dao := range daos {
+		if r, ok := rollups[dao.ID]; ok {
+			dao.ProposalsCount += r.ProposalsCount
+			dao.ActiveVotes += r.ActiveVotes
+			dao.VotersCount = max(dao.VotersCount, r.VotersCount)
+		}
+		res[i] = dao
+	}
+
+	return res, nil
+}
@@ -0,0 +1,91 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitGetParentID(t *testing.T) {
+	parentID := uuid.New()
+
+	for name, tc := range map[string]struct {
+		id       string
+		parent   string
+		expected *uuid.UUID
+	}{
+		"without parent": {
+			id:     "child.eth",
+			parent: "",
+		},
+		"self reference": {
+			id:     "child.eth",
+			parent: "child.eth",
+		},
+		"self reference with the checksum address": {
+			id:     "governor:1:0xabc",
+			parent: "governor:1:0xABC",
+		},
+		"parent": {
+			id:       "child.eth",
+			parent:   "parent.eth",
+			expected: &parentID,
+		},
+		"parent with the checksum address": {
+			id:       "child.eth",
+			parent:   "governor:1:0xDEF",
+			expected: &parentID,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ip := NewMockDaoIDProvider(ctrl)
+			ip.EXPECT().GetOrCreate(gomock.Any()).MaxTimes(1).DoAndReturn(func(id string) (uuid.UUID, error) {
+				require.Contains(t, []string{"parent.eth", "governor:1:0xdef"}, id)
+				return parentID, nil
+			})
+
+			service, err := NewService(nil, nil, ip, nil, nil, nil, nil, nil, nil, nil)
+			require.NoError(t, err)
+
+			id, err := service.GetParentID(tc.id, tc.parent)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, id)
+		})
+	}
+}
+
+func TestUnitRollupChildren(t *testing.T) {
+	var (
+		parent = uuid.New()
+		single = uuid.New()
+	)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dp := NewMockDataProvider(ctrl)
+	dp.EXPECT().GetChildrenRollup([]uuid.UUID{parent, single}).Return([]ChildrenRollup{
+		{ParentID: parent, ProposalsCount: 7, ActiveVotes: 2, VotersCount: 120},
+	}, nil)
+
+	service, err := NewService(dp, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	daos := []Dao{
+		{ID: parent, ProposalsCount: 3, ActiveVotes: 1, VotersCount: 100},
+		{ID: single, ProposalsCount: 5, ActiveVotes: 0, VotersCount: 10},
+	}
+
+	list, err := service.RollupChildren(daos)
+	require.NoError(t, err)
+	require.Equal(t, []Dao{
+		{ID: parent, ProposalsCount: 10, ActiveVotes: 3, VotersCount: 120},
+		{ID: single, ProposalsCount: 5, ActiveVotes: 0, VotersCount: 10},
+	}, list)
+	require.Equal(t, 3, daos[0].ProposalsCount)
+}
@@ -0,0 +1,128 @@
+package dao
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/schema"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/pagination"
+)
+
+// Sources of changes which are made by the service itself, changes from events are marked by the event subject
+const (
+	HistorySourceActivitySince   = "activity_since"
+	HistorySourceFungibleID      = "fungible_id"
+	HistorySourcePopularityIndex = "popularity_index"
+	// HistorySourceCategoryRule is followed by the rule category
+	HistorySourceCategoryRule = "category_rule:"
+	// HistorySourceModerator is followed by the moderator of the verification decision
+	HistorySourceModerator = "moderator:"
+)
+
+// historyIgnoredFields are calculated by the service and changed too often to keep their history
+var historyIgnoredFields = map[string]struct{}{
+	"CreatedAt":               {},
+	"UpdatedAt":               {},
+	"FollowersCount":          {},
+	"ProposalsCount":          {},
+	"VotersCount":             {},
+	"PopularityIndex":         {},
+	"ExternalPopularityIndex": {},
+	"LocalPopularityIndex":    {},
+	"ActiveVotes":             {},
+	"ActiveProposalsIDs":      {},
+	"Version":                 {},
+}
+
+// historyKeyset returns the latest changes first
+var historyKeyset = pagination.NewKeyset(
+	pagination.Key{Column: "id", Desc: true},
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	columnNaming = schema.NamingStrategy{}
+)
+
+// Change describes the changed field, the field is named as the column, nested fields are separated by dots
+type Change struct {
+	Field string          `json:"field"`
+	Old   json.RawMessage `json:"old"`
+	New   json.RawMessage `json:"new"`
+}
+
+type Changes []Change
+
+// History is the record of changed dao fields
+type History struct {
+	ID        uint64 `gorm:"primary_key"`
+	CreatedAt time.Time
+	DaoID     uuid.UUID
+	Source    string
+	Changes   Changes `gorm:"serializer:json"`
+}
+
+func (History) TableName() string {
+	return "dao_history"
+}
+
+// diff returns changes between two versions of the dao, the ignored fields are skipped
+func diff(old, new Dao) Changes {
+	return diffStruct("", reflect.ValueOf(old), reflect.ValueOf(new), historyIgnoredFields)
+}
+
+func diffStruct(prefix string, old, new reflect.Value, ignored map[string]struct{}) Changes {
+	var changes Changes
+	for i := 0; i < old.NumField(); i++ {
+		field := old.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if _, ok := ignored[field.Name]; ok {
+			continue
+		}
+
+		ov, nv := old.Field(i), new.Field(i)
+		if equalValues(ov, nv) {
+			continue
+		}
+
+		name := prefix + columnNaming.ColumnName("", field.Name)
+		if field.Type.Kind() == reflect.Struct && field.Type != timeType {
+			changes = append(changes, diffStruct(name+".", ov, nv, nil)...)
+			continue
+		}
+
+		changes = append(changes, Change{
+			Field: name,
+			Old:   marshalValue(ov),
+			New:   marshalValue(nv),
+		})
+	}
+
+	return changes
+}
+
+// equalValues treats nil and empty collections as equal, they are stored the same way
+func equalValues(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Slice, reflect.Map:
+		if a.Len() == 0 && b.Len() == 0 {
+			return true
+		}
+	}
+
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+func marshalValue(v reflect.Value) json.RawMessage {
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return json.RawMessage("null")
+	}
+
+	return data
+}
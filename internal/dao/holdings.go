@@ -0,0 +1,99 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// TokenKey identifies the token contract, the address is lowercased.
+// The empty network matches the address on any network.
+type TokenKey struct {
+	NetworkID string
+	Address   string
+}
+
+func newTokenKey(networkID, address string) TokenKey {
+	return TokenKey{
+		NetworkID: networkID,
+		Address:   strings.ToLower(address),
+	}
+}
+
+// HoldingsProvider returns tokens with positive balances of the address
+type HoldingsProvider interface {
+	GetHeldTokens(ctx context.Context, address string) ([]TokenKey, error)
+}
+
+// Holdings combines holdings of all providers, failed providers are skipped while any of them succeeds
+type Holdings struct {
+	providers []HoldingsProvider
+}
+
+func NewHoldings(providers ...HoldingsProvider) *Holdings {
+	return &Holdings{
+		providers: providers,
+	}
+}
+
+func (h *Holdings) GetHeldTokens(ctx context.Context, address string) ([]TokenKey, error) {
+	var (
+		res  []TokenKey
+		errs []error
+	)
+	for _, p := range h.providers {
+		list, err := p.GetHeldTokens(ctx, address)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		res = append(res, list...)
+	}
+
+	if len(errs) != 0 && len(errs) == len(h.providers) {
+		return nil, errors.Join(errs...)
+	}
+	if len(errs) != 0 {
+		log.Warn().Err(errors.Join(errs...)).Str("address", address).Msg("get held tokens")
+	}
+
+	return res, nil
+}
+
+// WalletHoldings adapts wallet positions to holdings.
+// Positions contain zerion chains instead of networks, so tokens are matched by addresses only.
+type WalletHoldings struct {
+	wallets WalletProvider
+}
+
+func NewWalletHoldings(wallets WalletProvider) *WalletHoldings {
+	return &WalletHoldings{
+		wallets: wallets,
+	}
+}
+
+func (h *WalletHoldings) GetHeldTokens(ctx context.Context, address string) ([]TokenKey, error) {
+	positions, err := h.wallets.GetWalletPositions(ctx, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get wallet positions: %w", err)
+	}
+
+	var res []TokenKey
+	for _, p := range positions {
+		if p.Attributes.Quantity.Float <= 0 {
+			continue
+		}
+
+		for _, impl := range p.Attributes.FungibleInfo.Implementations {
+			if impl.ChainID == p.Relationships.Chain.Data.ID && impl.Address != "" {
+				res = append(res, newTokenKey("", impl.Address))
+			}
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,42 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// HoldingsRepo returns holdings by erc20 balances which are collected from token transfers, addresses are stored lowercase
+type HoldingsRepo struct {
+	db *gorm.DB
+}
+
+func NewHoldingsRepo(db *gorm.DB) *HoldingsRepo {
+	return &HoldingsRepo{db: db}
+}
+
+func (r *HoldingsRepo) GetHeldTokens(_ context.Context, address string) ([]TokenKey, error) {
+	var rows []struct {
+		ChainID string
+		Token   string
+	}
+
+	err := r.db.
+		Table("erc20_balances").
+		Select("chain_id, token").
+		Where("address = ? and value > 0", strings.ToLower(address)).
+		Scan(&rows).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("get erc20 balances of %s: %w", address, err)
+	}
+
+	res := make([]TokenKey, len(rows))
+	for i, row := range rows {
+		res[i] = newTokenKey(row.ChainID, row.Token)
+	}
+
+	return res, nil
+}
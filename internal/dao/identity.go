@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Sources of daos, snapshot spaces are identified by the space name without the source prefix
+const (
+	SourceSnapshot = "snapshot"
+	// SourceGovernor is the on-chain Governor/OZ governor, the external id is <chain id>:<governor address>
+	SourceGovernor = "governor"
+)
+
+const identitySeparator = ":"
+
+var (
+	ErrInvalidIdentity = errors.New("invalid dao identity")
+	// ErrIdentityLinked is returned if the identity already belongs to another dao
+	ErrIdentityLinked = errors.New("identity is linked to another dao")
+)
+
+var knownSources = map[string]struct{}{
+	SourceSnapshot: {},
+	SourceGovernor: {},
+}
+
+// Identity is the dao identifier in the source
+type Identity struct {
+	Source     string
+	ExternalID string
+}
+
+// ParseIdentity parses the source-qualified id like governor:1:0xabc.
+// Ids without the known source are snapshot spaces to keep original ids of existing daos as is.
+func ParseIdentity(id string) (Identity, error) {
+	source, external, found := strings.Cut(id, identitySeparator)
+	if _, ok := knownSources[source]; !found || !ok {
+		source, external = SourceSnapshot, id
+	}
+
+	if external == "" {
+		return Identity{}, fmt.Errorf("%w: %s", ErrInvalidIdentity, id)
+	}
+
+	if source == SourceGovernor {
+		chain, address, ok := strings.Cut(external, identitySeparator)
+		if !ok || chain == "" || address == "" {
+			return Identity{}, fmt.Errorf("%w: %s", ErrInvalidIdentity, id)
+		}
+
+		external = chain + identitySeparator + strings.ToLower(address)
+	}
+
+	return Identity{Source: source, ExternalID: external}, nil
+}
+
+// String returns the original id of the identity, it's stored as the original id of the dao
+func (i Identity) String() string {
+	if i.Source == SourceSnapshot {
+		return i.ExternalID
+	}
+
+	return i.Source + identitySeparator + i.ExternalID
+}
+
+// normalizeOriginalID returns the stored form of the source-qualified id, invalid ids are returned as is
+func normalizeOriginalID(id string) string {
+	identity, err := ParseIdentity(id)
+	if err != nil {
+		return id
+	}
+
+	return identity.String()
+}
@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitParseIdentity(t *testing.T) {
+	for name, tc := range map[string]struct {
+		id       string
+		expected Identity
+		original string
+		err      bool
+	}{
+		"snapshot space":                {id: "uniswap.eth", expected: Identity{Source: SourceSnapshot, ExternalID: "uniswap.eth"}, original: "uniswap.eth"},
+		"qualified snapshot space":      {id: "snapshot:uniswap.eth", expected: Identity{Source: SourceSnapshot, ExternalID: "uniswap.eth"}, original: "uniswap.eth"},
+		"governor":                      {id: "governor:1:0xABC", expected: Identity{Source: SourceGovernor, ExternalID: "1:0xabc"}, original: "governor:1:0xabc"},
+		"unknown source is the space":   {id: "foo:bar", expected: Identity{Source: SourceSnapshot, ExternalID: "foo:bar"}, original: "foo:bar"},
+		"governor without chain":        {id: "governor:0xabc", err: true},
+		"empty":                         {id: "", err: true},
+		"snapshot without the space id": {id: "snapshot:", err: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			identity, err := ParseIdentity(tc.id)
+			if tc.err {
+				require.ErrorIs(t, err, ErrInvalidIdentity)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, identity)
+			require.Equal(t, tc.original, identity.String())
+		})
+	}
+}
+
+func TestUnitHandleDaoOfLinkedIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dp := NewMockDataProvider(ctrl)
+	dp.EXPECT().GetByID(id1).Return(&Dao{ID: id1, OriginalID: "uniswap.eth", Source: SourceSnapshot}, nil)
+
+	ip := NewMockDaoIDProvider(ctrl)
+	ip.EXPECT().GetOrCreate("governor:1:0xabc").Return(id1, nil)
+
+	s, err := NewService(dp, nil, ip, nil, nil, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	// data of linked sources is not applied to the dao
+	require.NoError(t, s.HandleDao(context.Background(), Dao{OriginalID: "governor:1:0xABC", Name: "Uniswap Governor"}, "core.dao.updated"))
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/goverland-labs/goverland-core-storage/internal/dao (interfaces: DataProvider,Publisher,DaoIDProvider)
+// Source: github.com/goverland-labs/goverland-core-storage/internal/dao (interfaces: DataProvider,Publisher,DaoIDProvider,TreasuryDataProvider,TokenPriceProvider,Notifier)
 
 // Package dao is a generated GoMock package.
 package dao
@@ -7,9 +7,11 @@ package dao
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
+	pagination "github.com/goverland-labs/goverland-core-storage/internal/pagination"
 )
 
 // MockDataProvider is a mock of DataProvider interface.
@@ -35,18 +37,60 @@ func (m *MockDataProvider) EXPECT() *MockDataProviderMockRecorder {
 	return m.recorder
 }
 
+// CallInTx mocks base method.
+func (m *MockDataProvider) CallInTx(arg0 context.Context, arg1 func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallInTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CallInTx indicates an expected call of CallInTx.
+func (mr *MockDataProviderMockRecorder) CallInTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallInTx", reflect.TypeOf((*MockDataProvider)(nil).CallInTx), arg0, arg1)
+}
+
 // Create mocks base method.
-func (m *MockDataProvider) Create(arg0 Dao) error {
+func (m *MockDataProvider) Create(arg0 context.Context, arg1 Dao) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockDataProviderMockRecorder) Create(arg0 interface{}) *gomock.Call {
+func (mr *MockDataProviderMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataProvider)(nil).Create), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataProvider)(nil).Create), arg0, arg1)
+}
+
+// CreateHistory mocks base method.
+func (m *MockDataProvider) CreateHistory(arg0 context.Context, arg1 History) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHistory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateHistory indicates an expected call of CreateHistory.
+func (mr *MockDataProviderMockRecorder) CreateHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHistory", reflect.TypeOf((*MockDataProvider)(nil).CreateHistory), arg0, arg1)
+}
+
+// CreateVerificationDecision mocks base method.
+func (m *MockDataProvider) CreateVerificationDecision(arg0 context.Context, arg1 VerificationDecision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVerificationDecision", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVerificationDecision indicates an expected call of CreateVerificationDecision.
+func (mr *MockDataProviderMockRecorder) CreateVerificationDecision(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerificationDecision", reflect.TypeOf((*MockDataProvider)(nil).CreateVerificationDecision), arg0, arg1)
 }
 
 // GetByFilters mocks base method.
@@ -109,6 +153,66 @@ func (mr *MockDataProviderMockRecorder) GetCategories() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockDataProvider)(nil).GetCategories))
 }
 
+// GetChildrenRollup mocks base method.
+func (m *MockDataProvider) GetChildrenRollup(arg0 []uuid.UUID) ([]ChildrenRollup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChildrenRollup", arg0)
+	ret0, _ := ret[0].([]ChildrenRollup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChildrenRollup indicates an expected call of GetChildrenRollup.
+func (mr *MockDataProviderMockRecorder) GetChildrenRollup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChildrenRollup", reflect.TypeOf((*MockDataProvider)(nil).GetChildrenRollup), arg0)
+}
+
+// GetForCategoryUpdate mocks base method.
+func (m *MockDataProvider) GetForCategoryUpdate(arg0 context.Context, arg1 string, arg2 []uuid.UUID) ([]Dao, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForCategoryUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]Dao)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForCategoryUpdate indicates an expected call of GetForCategoryUpdate.
+func (mr *MockDataProviderMockRecorder) GetForCategoryUpdate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForCategoryUpdate", reflect.TypeOf((*MockDataProvider)(nil).GetForCategoryUpdate), arg0, arg1, arg2)
+}
+
+// GetHistory mocks base method.
+func (m *MockDataProvider) GetHistory(arg0 uuid.UUID, arg1 []string, arg2 pagination.Keyset, arg3 int) ([]History, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]History)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockDataProviderMockRecorder) GetHistory(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockDataProvider)(nil).GetHistory), arg0, arg1, arg2, arg3)
+}
+
+// GetIDsByFilters mocks base method.
+func (m *MockDataProvider) GetIDsByFilters(arg0 context.Context, arg1 []Filter) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIDsByFilters", arg0, arg1)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIDsByFilters indicates an expected call of GetIDsByFilters.
+func (mr *MockDataProviderMockRecorder) GetIDsByFilters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIDsByFilters", reflect.TypeOf((*MockDataProvider)(nil).GetIDsByFilters), arg0, arg1)
+}
+
 // GetRecommended mocks base method.
 func (m *MockDataProvider) GetRecommended() ([]Recommendation, error) {
 	m.ctrl.T.Helper()
@@ -124,18 +228,33 @@ func (mr *MockDataProviderMockRecorder) GetRecommended() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecommended", reflect.TypeOf((*MockDataProvider)(nil).GetRecommended))
 }
 
+// GetVerificationDecisions mocks base method.
+func (m *MockDataProvider) GetVerificationDecisions(arg0 uuid.UUID, arg1 pagination.Keyset, arg2 int) ([]VerificationDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerificationDecisions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]VerificationDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerificationDecisions indicates an expected call of GetVerificationDecisions.
+func (mr *MockDataProviderMockRecorder) GetVerificationDecisions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerificationDecisions", reflect.TypeOf((*MockDataProvider)(nil).GetVerificationDecisions), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
-func (m *MockDataProvider) Update(arg0 Dao) error {
+func (m *MockDataProvider) Update(arg0 context.Context, arg1 Dao) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockDataProviderMockRecorder) Update(arg0 interface{}) *gomock.Call {
+func (mr *MockDataProviderMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataProvider)(nil).Update), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataProvider)(nil).Update), arg0, arg1)
 }
 
 // UpdateActiveVotes mocks base method.
@@ -166,6 +285,62 @@ func (mr *MockDataProviderMockRecorder) UpdateActiveVotesAll() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActiveVotesAll", reflect.TypeOf((*MockDataProvider)(nil).UpdateActiveVotesAll))
 }
 
+// UpdateActivitySince mocks base method.
+func (m *MockDataProvider) UpdateActivitySince(arg0 context.Context, arg1 Dao) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivitySince", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateActivitySince indicates an expected call of UpdateActivitySince.
+func (mr *MockDataProviderMockRecorder) UpdateActivitySince(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivitySince", reflect.TypeOf((*MockDataProvider)(nil).UpdateActivitySince), arg0, arg1)
+}
+
+// UpdateCategories mocks base method.
+func (m *MockDataProvider) UpdateCategories(arg0 context.Context, arg1 Dao) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategories", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCategories indicates an expected call of UpdateCategories.
+func (mr *MockDataProviderMockRecorder) UpdateCategories(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategories", reflect.TypeOf((*MockDataProvider)(nil).UpdateCategories), arg0, arg1)
+}
+
+// UpdateFungibleInfo mocks base method.
+func (m *MockDataProvider) UpdateFungibleInfo(arg0 context.Context, arg1 Dao) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFungibleInfo", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFungibleInfo indicates an expected call of UpdateFungibleInfo.
+func (mr *MockDataProviderMockRecorder) UpdateFungibleInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFungibleInfo", reflect.TypeOf((*MockDataProvider)(nil).UpdateFungibleInfo), arg0, arg1)
+}
+
+// UpdatePopularityIndex mocks base method.
+func (m *MockDataProvider) UpdatePopularityIndex(arg0 context.Context, arg1 Dao) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePopularityIndex", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePopularityIndex indicates an expected call of UpdatePopularityIndex.
+func (mr *MockDataProviderMockRecorder) UpdatePopularityIndex(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePopularityIndex", reflect.TypeOf((*MockDataProvider)(nil).UpdatePopularityIndex), arg0, arg1)
+}
+
 // UpdateProposalCnt mocks base method.
 func (m *MockDataProvider) UpdateProposalCnt(arg0 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -194,6 +369,20 @@ func (mr *MockDataProviderMockRecorder) UpdateProposalCntAll() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProposalCntAll", reflect.TypeOf((*MockDataProvider)(nil).UpdateProposalCntAll))
 }
 
+// UpdateVerification mocks base method.
+func (m *MockDataProvider) UpdateVerification(arg0 context.Context, arg1 Dao) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVerification", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVerification indicates an expected call of UpdateVerification.
+func (mr *MockDataProviderMockRecorder) UpdateVerification(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVerification", reflect.TypeOf((*MockDataProvider)(nil).UpdateVerification), arg0, arg1)
+}
+
 // MockPublisher is a mock of Publisher interface.
 type MockPublisher struct {
 	ctrl     *gomock.Controller
@@ -269,6 +458,21 @@ func (mr *MockDaoIDProviderMockRecorder) GetAll() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockDaoIDProvider)(nil).GetAll))
 }
 
+// GetIdentities mocks base method.
+func (m *MockDaoIDProvider) GetIdentities(arg0 uuid.UUID) ([]DaoID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdentities", arg0)
+	ret0, _ := ret[0].([]DaoID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdentities indicates an expected call of GetIdentities.
+func (mr *MockDaoIDProviderMockRecorder) GetIdentities(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdentities", reflect.TypeOf((*MockDaoIDProvider)(nil).GetIdentities), arg0)
+}
+
 // GetOrCreate mocks base method.
 func (m *MockDaoIDProvider) GetOrCreate(arg0 string) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -283,3 +487,218 @@ func (mr *MockDaoIDProviderMockRecorder) GetOrCreate(arg0 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreate", reflect.TypeOf((*MockDaoIDProvider)(nil).GetOrCreate), arg0)
 }
+
+// Link mocks base method.
+func (m *MockDaoIDProvider) Link(arg0 uuid.UUID, arg1 string) ([]DaoID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Link", arg0, arg1)
+	ret0, _ := ret[0].([]DaoID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Link indicates an expected call of Link.
+func (mr *MockDaoIDProviderMockRecorder) Link(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Link", reflect.TypeOf((*MockDaoIDProvider)(nil).Link), arg0, arg1)
+}
+
+// MockTreasuryDataProvider is a mock of TreasuryDataProvider interface.
+type MockTreasuryDataProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockTreasuryDataProviderMockRecorder
+}
+
+// MockTreasuryDataProviderMockRecorder is the mock recorder for MockTreasuryDataProvider.
+type MockTreasuryDataProviderMockRecorder struct {
+	mock *MockTreasuryDataProvider
+}
+
+// NewMockTreasuryDataProvider creates a new mock instance.
+func NewMockTreasuryDataProvider(ctrl *gomock.Controller) *MockTreasuryDataProvider {
+	mock := &MockTreasuryDataProvider{ctrl: ctrl}
+	mock.recorder = &MockTreasuryDataProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTreasuryDataProvider) EXPECT() *MockTreasuryDataProviderMockRecorder {
+	return m.recorder
+}
+
+// CreateSnapshot mocks base method.
+func (m *MockTreasuryDataProvider) CreateSnapshot(arg0 TreasurySnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshot", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockTreasuryDataProviderMockRecorder) CreateSnapshot(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockTreasuryDataProvider)(nil).CreateSnapshot), arg0)
+}
+
+// GetLatestSnapshots mocks base method.
+func (m *MockTreasuryDataProvider) GetLatestSnapshots(arg0 uuid.UUID, arg1 []string) ([]TreasurySnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestSnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]TreasurySnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestSnapshots indicates an expected call of GetLatestSnapshots.
+func (mr *MockTreasuryDataProviderMockRecorder) GetLatestSnapshots(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestSnapshots", reflect.TypeOf((*MockTreasuryDataProvider)(nil).GetLatestSnapshots), arg0, arg1)
+}
+
+// GetValueSeries mocks base method.
+func (m *MockTreasuryDataProvider) GetValueSeries(arg0 uuid.UUID, arg1 []string, arg2 time.Time) ([]TreasuryValuePoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValueSeries", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]TreasuryValuePoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValueSeries indicates an expected call of GetValueSeries.
+func (mr *MockTreasuryDataProviderMockRecorder) GetValueSeries(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValueSeries", reflect.TypeOf((*MockTreasuryDataProvider)(nil).GetValueSeries), arg0, arg1, arg2)
+}
+
+// MockTokenPriceProvider is a mock of TokenPriceProvider interface.
+type MockTokenPriceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenPriceProviderMockRecorder
+}
+
+// MockTokenPriceProviderMockRecorder is the mock recorder for MockTokenPriceProvider.
+type MockTokenPriceProviderMockRecorder struct {
+	mock *MockTokenPriceProvider
+}
+
+// NewMockTokenPriceProvider creates a new mock instance.
+func NewMockTokenPriceProvider(ctrl *gomock.Controller) *MockTokenPriceProvider {
+	mock := &MockTokenPriceProvider{ctrl: ctrl}
+	mock.recorder = &MockTokenPriceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenPriceProvider) EXPECT() *MockTokenPriceProviderMockRecorder {
+	return m.recorder
+}
+
+// GetLatest mocks base method.
+func (m *MockTokenPriceProvider) GetLatest(arg0 string, arg1 time.Time) (*TokenPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatest", arg0, arg1)
+	ret0, _ := ret[0].(*TokenPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatest indicates an expected call of GetLatest.
+func (mr *MockTokenPriceProviderMockRecorder) GetLatest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatest", reflect.TypeOf((*MockTokenPriceProvider)(nil).GetLatest), arg0, arg1)
+}
+
+// GetRange mocks base method.
+func (m *MockTokenPriceProvider) GetRange(arg0 string, arg1, arg2 time.Time) ([]TokenPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRange", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]TokenPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRange indicates an expected call of GetRange.
+func (mr *MockTokenPriceProviderMockRecorder) GetRange(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRange", reflect.TypeOf((*MockTokenPriceProvider)(nil).GetRange), arg0, arg1, arg2)
+}
+
+// IsBackfilled mocks base method.
+func (m *MockTokenPriceProvider) IsBackfilled(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBackfilled", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBackfilled indicates an expected call of IsBackfilled.
+func (mr *MockTokenPriceProviderMockRecorder) IsBackfilled(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBackfilled", reflect.TypeOf((*MockTokenPriceProvider)(nil).IsBackfilled), arg0)
+}
+
+// MarkBackfilled mocks base method.
+func (m *MockTokenPriceProvider) MarkBackfilled(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkBackfilled", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkBackfilled indicates an expected call of MarkBackfilled.
+func (mr *MockTokenPriceProviderMockRecorder) MarkBackfilled(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkBackfilled", reflect.TypeOf((*MockTokenPriceProvider)(nil).MarkBackfilled), arg0)
+}
+
+// Save mocks base method.
+func (m *MockTokenPriceProvider) Save(arg0 []TokenPrice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockTokenPriceProviderMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTokenPriceProvider)(nil).Save), arg0)
+}
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifier) Notify(arg0 context.Context, arg1 string, arg2 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierMockRecorder) Notify(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), arg0, arg1, arg2)
+}
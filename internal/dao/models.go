@@ -66,6 +66,8 @@ type Voting struct {
 	HideAbstain bool
 	Privacy     string
 	Aliased     bool
+	// QuorumType is default or rejection, the rejection quorum is reached by against votes
+	QuorumType string
 }
 
 func convertToVoting(v Voting) events.VotingPayload {
@@ -86,6 +88,7 @@ type Dao struct {
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	OriginalID      string
+	Source          string
 	Name            string
 	Private         bool
 	About           string
@@ -113,6 +116,10 @@ type Dao struct {
 	ActivitySince   int
 	VotersCount     int
 	PopularityIndex float64
+	// ExternalPopularityIndex is received by events, LocalPopularityIndex is calculated by the popularity worker.
+	// PopularityIndex is one of them or the blend depending on the popularity mode.
+	ExternalPopularityIndex float64
+	LocalPopularityIndex    float64
 	// ActiveVotes the number of active proposals
 	ActiveVotes int
 	// ActiveProposalsIDs the list of active proposals identifiers
@@ -122,6 +129,8 @@ type Dao struct {
 	TokenSymbol         string
 	VerificationStatus  string
 	VerificationComment string
+	// Version is incremented on every update, updates are applied only to the version they were based on
+	Version int
 }
 
 func (d *Dao) GetStrategyByName(name string) *Strategy {
@@ -197,8 +206,6 @@ func convertToDao(e aggevents.DaoPayload) Dao {
 		Guidelines:     e.Guidelines,
 		Template:       e.Template,
 		Verified:       e.Verified,
-
-		// TODO: parentID
 	}
 }
 
@@ -225,6 +232,7 @@ func convertToInternalVoting(v aggevents.VotingPayload) Voting {
 		HideAbstain: v.HideAbstain,
 		Privacy:     v.Privacy,
 		Aliased:     v.Aliased,
+		QuorumType:  v.QuorumType,
 	}
 }
 
@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	aggevents "github.com/goverland-labs/goverland-platform-events/events/aggregator"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/proposal"
+)
+
+func TestUnitOutcomeByStoredQuorumType(t *testing.T) {
+	for name, tc := range map[string]struct {
+		quorumType string
+		expected   proposal.Outcome
+	}{
+		"default quorum": {
+			quorumType: proposal.QuorumTypeDefault,
+			expected:   proposal.Outcome{State: proposal.StateSucceeded, Winners: []int{0}},
+		},
+		"rejection quorum": {
+			quorumType: proposal.QuorumTypeRejection,
+			expected:   proposal.Outcome{State: proposal.StateDefeated, Winners: []int{1}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			dao := convertToDao(aggevents.DaoPayload{Voting: aggevents.VotingPayload{QuorumType: tc.quorumType}})
+
+			// voting settings are stored by the json serializer
+			voting, err := json.Marshal(dao.Voting)
+			require.NoError(t, err)
+
+			settings, err := proposal.NewOutcomeSettings(voting, nil)
+			require.NoError(t, err)
+
+			p := proposal.Proposal{
+				Type:        proposal.VotingBasic,
+				Start:       int(time.Now().Add(-48 * time.Hour).Unix()),
+				End:         int(time.Now().Add(-24 * time.Hour).Unix()),
+				Votes:       10,
+				Scores:      proposal.Scores{50, 25, 0},
+				ScoresTotal: 75,
+				Quorum:      20,
+				Settings:    settings,
+			}
+			require.Equal(t, tc.expected, p.CalculateOutcome())
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/optimistic"
+)
+
+// Popularity modes define which index is used for ordering
+const (
+	PopularityModeExternal = "external"
+	PopularityModeLocal    = "local"
+	PopularityModeBlend    = "blend"
+)
+
+var ErrInvalidPopularityMode = errors.New("invalid popularity mode")
+
+// PopularityWeights are multipliers of signals of the local index
+type PopularityWeights struct {
+	Voters    float64
+	Votes     float64
+	Proposals float64
+	Followers float64
+	Verified  float64
+}
+
+type PopularityOptions struct {
+	Mode string
+	// BlendWeight is the share of the local index in the blend mode
+	BlendWeight float64
+	// Window is the period of voters, votes and proposals which are taken into account
+	Window  time.Duration
+	Weights PopularityWeights
+}
+
+// PopularitySignals are inputs of the local index of the dao
+type PopularitySignals struct {
+	DaoID     uuid.UUID
+	Voters    int
+	Votes     int
+	Proposals int
+	Followers int
+	Verified  bool
+	External  float64
+}
+
+// PopularityIndex is the calculated index of the dao, Effective is used for ordering
+type PopularityIndex struct {
+	DaoID     uuid.UUID
+	Local     float64
+	Effective float64
+}
+
+type PopularityDataProvider interface {
+	GetPopularitySignals(since time.Time) ([]PopularitySignals, error)
+	// UpdatePopularityIndexes saves local indexes, the effective ones are saved only if apply is set
+	UpdatePopularityIndexes(ctx context.Context, list []PopularityIndex, apply bool) error
+}
+
+// PopularityService calculates the local popularity index and keeps the effective one by the mode
+type PopularityService struct {
+	service *Service
+	repo    PopularityDataProvider
+	opts    PopularityOptions
+}
+
+func NewPopularityService(s *Service, r PopularityDataProvider, opts PopularityOptions) (*PopularityService, error) {
+	switch opts.Mode {
+	case PopularityModeExternal, PopularityModeLocal, PopularityModeBlend:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPopularityMode, opts.Mode)
+	}
+
+	if opts.BlendWeight < 0 || opts.BlendWeight > 1 {
+		return nil, fmt.Errorf("blend weight must be in [0, 1]: %v", opts.BlendWeight)
+	}
+
+	return &PopularityService{
+		service: s,
+		repo:    r,
+		opts:    opts,
+	}, nil
+}
+
+// ProcessExternal stores the index received by the event, it's used for ordering only in the external mode.
+// In other modes the effective index is updated by the next calculation.
+func (s *PopularityService) ProcessExternal(ctx context.Context, id uuid.UUID, index float64) error {
+	return optimistic.Retry(ctx, "popularity_index", func(ctx context.Context) error {
+		existed, err := s.service.repo.GetByID(id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("handle: %w", err)
+		}
+
+		dao := *existed
+		dao.ExternalPopularityIndex = index
+		if s.opts.Mode == PopularityModeExternal {
+			dao.PopularityIndex = index
+		}
+		_, err = s.service.update(ctx, *existed, dao, HistorySourcePopularityIndex, s.service.repo.UpdatePopularityIndex)
+
+		return err
+	})
+}
+
+// Recalculate calculates local indexes of all daos and returns the number of them
+func (s *PopularityService) Recalculate(ctx context.Context) (int, error) {
+	signals, err := s.repo.GetPopularitySignals(time.Now().Add(-s.opts.Window))
+	if err != nil {
+		return 0, fmt.Errorf("get popularity signals: %w", err)
+	}
+
+	list := s.calculate(signals)
+	if err = s.repo.UpdatePopularityIndexes(ctx, list, s.opts.Mode != PopularityModeExternal); err != nil {
+		return 0, fmt.Errorf("update popularity indexes: %w", err)
+	}
+
+	return len(list), nil
+}
+
+func (s *PopularityService) calculate(signals []PopularitySignals) []PopularityIndex {
+	var (
+		w           = s.opts.Weights
+		list        = make([]PopularityIndex, len(signals))
+		maxLocal    float64
+		maxExternal float64
+	)
+	for i, item := range signals {
+		local := w.Voters*math.Log1p(float64(item.Voters)) +
+			w.Votes*math.Log1p(float64(item.Votes)) +
+			w.Proposals*math.Log1p(float64(item.Proposals)) +
+			w.Followers*math.Log1p(float64(item.Followers))
+		if item.Verified {
+			local += w.Verified
+		}
+
+		list[i] = PopularityIndex{DaoID: item.DaoID, Local: local}
+		maxLocal = max(maxLocal, local)
+		maxExternal = max(maxExternal, item.External)
+	}
+
+	// local indexes are scaled to the range of external ones to keep them comparable in the blend
+	scale := 1.0
+	if maxLocal > 0 && maxExternal > 0 {
+		scale = maxExternal / maxLocal
+	}
+
+	for i, item := range signals {
+		switch s.opts.Mode {
+		case PopularityModeLocal:
+			list[i].Effective = list[i].Local
+		case PopularityModeBlend:
+			list[i].Effective = (1-s.opts.BlendWeight)*item.External + s.opts.BlendWeight*list[i].Local*scale
+		default:
+			list[i].Effective = item.External
+		}
+	}
+
+	return list
+}
@@ -0,0 +1,98 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+const popularityUpdateBatchSize = 1000
+
+type PopularityRepo struct {
+	db *gorm.DB
+}
+
+func NewPopularityRepo(db *gorm.DB) *PopularityRepo {
+	return &PopularityRepo{db: db}
+}
+
+func (r *PopularityRepo) GetPopularitySignals(since time.Time) ([]PopularitySignals, error) {
+	var (
+		dummy = Dao{}
+		_     = dummy.FollowersCount
+		_     = dummy.Verified
+		_     = dummy.ExternalPopularityIndex
+	)
+
+	var list []PopularitySignals
+	err := r.db.Raw(`
+select d.id                           dao_id,
+       coalesce(v.voters, 0)          voters,
+       coalesce(v.votes, 0)           votes,
+       coalesce(p.proposals, 0)       proposals,
+       coalesce(d.followers_count, 0) followers,
+       coalesce(d.verified, false)    verified,
+       d.external_popularity_index    external
+from daos d
+         left join (select dao_id, count(distinct lower(voter)) voters, count(*) votes
+                    from votes
+                    where created >= @since
+                    group by dao_id) v on v.dao_id = d.id
+         left join (select dao_id, count(*) proposals
+                    from proposals
+                    where created >= @since
+                      and spam is not true
+                      and state != 'canceled'
+                    group by dao_id) p on p.dao_id = d.id
+`, map[string]any{"since": since.Unix()}).
+		Scan(&list).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("get popularity signals: %w", err)
+	}
+
+	return list, nil
+}
+
+// UpdatePopularityIndexes updates indexes in batches without version bumps like other calculated counters
+func (r *PopularityRepo) UpdatePopularityIndexes(ctx context.Context, list []PopularityIndex, apply bool) error {
+	var (
+		dummy = Dao{}
+		_     = dummy.PopularityIndex
+		_     = dummy.LocalPopularityIndex
+	)
+
+	set := "local_popularity_index = data.local"
+	if apply {
+		set += ", popularity_index = data.effective"
+	}
+
+	for start := 0; start < len(list); start += popularityUpdateBatchSize {
+		batch := list[start:min(start+popularityUpdateBatchSize, len(list))]
+
+		var (
+			ids       = make([]string, len(batch))
+			local     = make([]float64, len(batch))
+			effective = make([]float64, len(batch))
+		)
+		for i, item := range batch {
+			ids[i] = item.DaoID.String()
+			local[i] = item.Local
+			effective[i] = item.Effective
+		}
+
+		err := r.db.WithContext(ctx).Exec(fmt.Sprintf(`
+update daos
+set %s
+from unnest(?::uuid[], ?::double precision[], ?::double precision[]) as data(id, local, effective)
+where daos.id = data.id`, set), pq.StringArray(ids), pq.Float64Array(local), pq.Float64Array(effective)).Error
+		if err != nil {
+			return fmt.Errorf("update batch: %w", err)
+		}
+	}
+
+	return nil
+}
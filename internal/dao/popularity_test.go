@@ -0,0 +1,122 @@
+package dao
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type popularityStub struct {
+	signals []PopularitySignals
+	updated []PopularityIndex
+	applied bool
+}
+
+func (p *popularityStub) GetPopularitySignals(_ time.Time) ([]PopularitySignals, error) {
+	return p.signals, nil
+}
+
+func (p *popularityStub) UpdatePopularityIndexes(_ context.Context, list []PopularityIndex, apply bool) error {
+	p.updated = list
+	p.applied = apply
+
+	return nil
+}
+
+func TestUnitRecalculatePopularity(t *testing.T) {
+	signals := []PopularitySignals{
+		{DaoID: id1, Voters: 6, Followers: 2, Verified: true, External: 40},
+		{DaoID: id2, External: 100},
+	}
+	weights := PopularityWeights{Voters: 1, Votes: 0.5, Proposals: 0.5, Followers: 2, Verified: 1}
+
+	for name, tc := range map[string]struct {
+		mode     string
+		applied  bool
+		expected []PopularityIndex
+	}{
+		"external": {
+			mode: PopularityModeExternal,
+			expected: []PopularityIndex{
+				{DaoID: id1, Effective: 40},
+				{DaoID: id2, Effective: 100},
+			},
+		},
+		"local": {
+			mode:    PopularityModeLocal,
+			applied: true,
+			expected: []PopularityIndex{
+				{DaoID: id1, Effective: math.Log(7) + 2*math.Log(3) + 1},
+				{DaoID: id2},
+			},
+		},
+		"blend": {
+			mode:    PopularityModeBlend,
+			applied: true,
+			// local indexes are scaled to the max external index
+			expected: []PopularityIndex{
+				{DaoID: id1, Effective: 0.75*40 + 0.25*100},
+				{DaoID: id2, Effective: 0.75 * 100},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			repo := &popularityStub{signals: signals}
+			s, err := NewPopularityService(nil, repo, PopularityOptions{Mode: tc.mode, BlendWeight: 0.25, Weights: weights})
+			require.NoError(t, err)
+
+			cnt, err := s.Recalculate(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, 2, cnt)
+			require.Equal(t, tc.applied, repo.applied)
+
+			require.Len(t, repo.updated, len(tc.expected))
+			for i, expected := range tc.expected {
+				require.Equal(t, expected.DaoID, repo.updated[i].DaoID)
+				require.InDelta(t, expected.Effective, repo.updated[i].Effective, 1e-9)
+			}
+		})
+	}
+
+	_, err := NewPopularityService(nil, nil, PopularityOptions{Mode: "unknown"})
+	require.ErrorIs(t, err, ErrInvalidPopularityMode)
+}
+
+func TestUnitProcessExternalPopularity(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mode     string
+		expected Dao
+	}{
+		"external mode": {
+			mode:     PopularityModeExternal,
+			expected: Dao{ID: id1, PopularityIndex: 50, ExternalPopularityIndex: 50, LocalPopularityIndex: 3},
+		},
+		"local mode": {
+			mode:     PopularityModeLocal,
+			expected: Dao{ID: id1, PopularityIndex: 3, ExternalPopularityIndex: 50, LocalPopularityIndex: 3},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dp := NewMockDataProvider(ctrl)
+			dp.EXPECT().CallInTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(context.Context) error) error {
+				return cb(ctx)
+			})
+			dp.EXPECT().GetByID(id1).Return(&Dao{ID: id1, PopularityIndex: 3, ExternalPopularityIndex: 10, LocalPopularityIndex: 3}, nil)
+			dp.EXPECT().UpdatePopularityIndex(gomock.Any(), tc.expected).Return(nil)
+
+			service, err := NewService(dp, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			require.NoError(t, err)
+
+			s, err := NewPopularityService(service, nil, PopularityOptions{Mode: tc.mode})
+			require.NoError(t, err)
+			require.NoError(t, s.ProcessExternal(context.Background(), id1, 50))
+		})
+	}
+}
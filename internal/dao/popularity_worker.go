@@ -0,0 +1,39 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// PopularityWorker recalculates local popularity indexes
+type PopularityWorker struct {
+	popularity *PopularityService
+	delay      time.Duration
+}
+
+func NewPopularityWorker(ps *PopularityService, delay time.Duration) *PopularityWorker {
+	return &PopularityWorker{
+		popularity: ps,
+		delay:      delay,
+	}
+}
+
+func (w *PopularityWorker) Process(ctx context.Context) error {
+	for {
+		start := time.Now()
+		cnt, err := w.popularity.Recalculate(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("recalculate popularity indexes")
+		} else {
+			log.Info().Int("daos", cnt).Dur("duration", time.Since(start)).Msg("popularity indexes recalculated")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(w.delay):
+		}
+	}
+}
@@ -2,6 +2,8 @@ package dao
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -20,6 +22,111 @@ type Recommendation struct {
 	Address    string
 }
 
+// AddressRecommendation is the dao which tokens are held by the address
+type AddressRecommendation struct {
+	Dao Dao
+	// Tokens are recommendation tokens of the dao which are held by the address
+	Tokens []Recommendation
+}
+
+// VoterDaosProvider returns identifiers of daos where the voter has votes
+type VoterDaosProvider interface {
+	GetByVoter(voter string) ([]string, error)
+}
+
+type RecommendationService struct {
+	service  *Service
+	holdings HoldingsProvider
+	votes    VoterDaosProvider
+}
+
+func NewRecommendationService(s *Service, holdings HoldingsProvider, votes VoterDaosProvider) *RecommendationService {
+	return &RecommendationService{
+		service:  s,
+		holdings: holdings,
+		votes:    votes,
+	}
+}
+
+// GetForAddress matches holdings of the address with recommendation tokens.
+// Daos where the address already votes are skipped, the rest are ordered by the popularity index.
+func (s *RecommendationService) GetForAddress(ctx context.Context, address string, limit int) ([]AddressRecommendation, error) {
+	address = strings.ToLower(address)
+	held, err := s.holdings.GetHeldTokens(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("get held tokens: %w", err)
+	}
+
+	if len(held) == 0 {
+		return nil, nil
+	}
+
+	heldKeys := make(map[TokenKey]struct{}, len(held))
+	for _, key := range held {
+		heldKeys[key] = struct{}{}
+	}
+
+	voted, err := s.votes.GetByVoter(address)
+	if err != nil {
+		return nil, fmt.Errorf("get daos by voter: %w", err)
+	}
+
+	skipped := make(map[string]struct{}, len(voted))
+	for _, id := range voted {
+		skipped[id] = struct{}{}
+	}
+
+	tokens := make(map[string][]Recommendation)
+	var ids []string
+	for _, rec := range s.service.getRecommendations() {
+		if _, ok := skipped[rec.InternalId]; ok {
+			continue
+		}
+
+		if !isHeld(heldKeys, rec) {
+			continue
+		}
+
+		if _, ok := tokens[rec.InternalId]; !ok {
+			ids = append(ids, rec.InternalId)
+		}
+		tokens[rec.InternalId] = append(tokens[rec.InternalId], rec)
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	list, err := s.service.GetByFilters([]Filter{
+		DaoIDsFilter{DaoIDs: ids},
+		OrderByPopularityIndexFilter{},
+		PageFilter{Limit: limit},
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]AddressRecommendation, len(list.Daos))
+	for i, dao := range list.Daos {
+		res[i] = AddressRecommendation{
+			Dao:    dao,
+			Tokens: tokens[dao.ID.String()],
+		}
+	}
+
+	return res, nil
+}
+
+func isHeld(held map[TokenKey]struct{}, rec Recommendation) bool {
+	if _, ok := held[newTokenKey(rec.NetworkId, rec.Address)]; ok {
+		return true
+	}
+
+	_, ok := held[newTokenKey("", rec.Address)]
+
+	return ok
+}
+
 type RecommendationWorker struct {
 	service *Service
 }
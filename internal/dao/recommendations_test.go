@@ -0,0 +1,123 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goverland-labs/goverland-core-storage/pkg/sdk/zerion"
+)
+
+type holdingsStub struct {
+	tokens []TokenKey
+	err    error
+}
+
+func (h holdingsStub) GetHeldTokens(_ context.Context, _ string) ([]TokenKey, error) {
+	return h.tokens, h.err
+}
+
+type votesStub []string
+
+func (v votesStub) GetByVoter(_ string) ([]string, error) {
+	return v, nil
+}
+
+func TestUnitGetRecommendationsForAddress(t *testing.T) {
+	var (
+		uni   = Recommendation{InternalId: id1.String(), Symbol: "UNI", NetworkId: "1", Address: "0xUNI"}
+		stkd  = Recommendation{InternalId: id1.String(), Symbol: "stkUNI", NetworkId: "1", Address: "0xSTK"}
+		safe  = Recommendation{InternalId: id2.String(), Symbol: "SAFE", NetworkId: "100", Address: "0xSAFE"}
+		other = Recommendation{InternalId: uuid.NewString(), Symbol: "OTHER", NetworkId: "1", Address: "0xOTHER"}
+	)
+
+	for name, tc := range map[string]struct {
+		held     []TokenKey
+		voted    []string
+		ids      []string
+		expected map[uuid.UUID][]Recommendation
+	}{
+		"no holdings": {},
+		"matched by network and address": {
+			held:     []TokenKey{{NetworkID: "1", Address: "0xuni"}, {NetworkID: "1", Address: "0xstk"}, {NetworkID: "1", Address: "0xsafe"}},
+			ids:      []string{id1.String()},
+			expected: map[uuid.UUID][]Recommendation{id1: {uni, stkd}},
+		},
+		"address without network matches any network": {
+			held:     []TokenKey{{Address: "0xsafe"}, {NetworkID: "1", Address: "0xuni"}},
+			ids:      []string{id1.String(), id2.String()},
+			expected: map[uuid.UUID][]Recommendation{id1: {uni}, id2: {safe}},
+		},
+		"skip daos with votes": {
+			held:     []TokenKey{{Address: "0xsafe"}, {NetworkID: "1", Address: "0xuni"}},
+			voted:    []string{id1.String()},
+			ids:      []string{id2.String()},
+			expected: map[uuid.UUID][]Recommendation{id2: {safe}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dp := NewMockDataProvider(ctrl)
+			var daos []Dao
+			for id := range tc.expected {
+				daos = append(daos, Dao{ID: id})
+			}
+			if len(tc.ids) != 0 {
+				dp.EXPECT().GetByFilters(gomock.Any(), false).DoAndReturn(func(filters []Filter, _ bool) (DaoList, error) {
+					require.ElementsMatch(t, tc.ids, filters[0].(DaoIDsFilter).DaoIDs)
+					require.Equal(t, PageFilter{Limit: 10}, filters[2])
+
+					return DaoList{Daos: daos}, nil
+				})
+			}
+
+			s, err := NewService(dp, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			require.NoError(t, err)
+			s.recommendations = []Recommendation{uni, stkd, safe, other}
+
+			rs := NewRecommendationService(s, holdingsStub{tokens: tc.held}, votesStub(tc.voted))
+			list, err := rs.GetForAddress(context.Background(), "0xholder", 10)
+			require.NoError(t, err)
+
+			actual := make(map[uuid.UUID][]Recommendation)
+			for _, item := range list {
+				actual[item.Dao.ID] = item.Tokens
+			}
+			if len(tc.expected) == 0 {
+				require.Empty(t, actual)
+				return
+			}
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestUnitHoldings(t *testing.T) {
+	impl := position("uni", "polygon", 5, 1)
+	impl.Attributes.FungibleInfo.Implementations = []zerion.Implementations{
+		{ChainID: "ethereum", Address: "0xEth"},
+		{ChainID: "polygon", Address: "0xPolygon"},
+	}
+	empty := position("dust", "ethereum", 0, 1)
+	empty.Attributes.FungibleInfo.Implementations = []zerion.Implementations{{ChainID: "ethereum", Address: "0xDust"}}
+
+	wallets := NewWalletHoldings(walletsStub{"0xholder": {impl, empty}})
+
+	held, err := NewHoldings(holdingsStub{tokens: []TokenKey{{NetworkID: "1", Address: "0xown"}}}, wallets).GetHeldTokens(context.Background(), "0xholder")
+	require.NoError(t, err)
+	require.Equal(t, []TokenKey{{NetworkID: "1", Address: "0xown"}, {Address: "0xpolygon"}}, held)
+
+	// failed providers are skipped while any of them succeeds
+	held, err = NewHoldings(holdingsStub{err: errors.New("db")}, wallets).GetHeldTokens(context.Background(), "0xholder")
+	require.NoError(t, err)
+	require.Equal(t, []TokenKey{{Address: "0xpolygon"}}, held)
+
+	_, err = NewHoldings(holdingsStub{err: errors.New("db")}, wallets).GetHeldTokens(context.Background(), "0xunknown")
+	require.Error(t, err)
+}
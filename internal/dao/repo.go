@@ -1,10 +1,18 @@
 package dao
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/optimistic"
+	"github.com/goverland-labs/goverland-core-storage/internal/outbox"
+	"github.com/goverland-labs/goverland-core-storage/internal/pagination"
 )
 
 type Repo struct {
@@ -15,16 +23,153 @@ func NewRepo(db *gorm.DB) *Repo {
 	return &Repo{db: db}
 }
 
-// Create creates one dao object
+// CallInTx runs cb in the transaction, Create and Update calls with the passed context join it
+func (r *Repo) CallInTx(ctx context.Context, cb func(ctx context.Context) error) error {
+	return outbox.CallInTx(ctx, r.db, cb)
+}
+
+// Create creates one dao object with the strategy index
 // todo: check creating error/unique and others
-func (r *Repo) Create(dao Dao) error {
-	return r.db.Create(&dao).Error
+func (r *Repo) Create(ctx context.Context, dao Dao) error {
+	if err := outbox.Conn(ctx, r.db).Create(&dao).Error; err != nil {
+		return err
+	}
+
+	return r.replaceStrategies(ctx, dao)
+}
+
+// Update saves the dao received from the source, columns maintained by the service are kept as is.
+// It returns optimistic.ErrConflict if the dao was changed after reading.
+func (r *Repo) Update(ctx context.Context, dao Dao) error {
+	db := outbox.Conn(ctx, r.db).Select("*").Omit(
+		"id",
+		"created_at",
+		"original_id",
+		"proposals_count",
+		"activity_since",
+		"voters_count",
+		"popularity_index",
+		"external_popularity_index",
+		"local_popularity_index",
+		"active_votes",
+		"active_proposals_ids",
+		"fungible_id",
+		"token_symbol",
+		"verification_status",
+		"verification_comment",
+	)
+
+	if err := optimistic.Update(db, &dao, &dao.Version); err != nil {
+		return err
+	}
+
+	return r.replaceStrategies(ctx, dao)
+}
+
+// replaceStrategies rebuilds the strategy index of the dao, it has to be called in the transaction of the dao saving
+func (r *Repo) replaceStrategies(ctx context.Context, dao Dao) error {
+	db := outbox.Conn(ctx, r.db)
+	if err := db.Where("dao_id = ?", dao.ID).Delete(&DaoStrategy{}).Error; err != nil {
+		return fmt.Errorf("delete strategies #%s: %w", dao.ID, err)
+	}
+
+	list := flattenStrategies(dao)
+	if len(list) == 0 {
+		return nil
+	}
+
+	if err := db.CreateInBatches(list, defaultBatchSize).Error; err != nil {
+		return fmt.Errorf("create strategies #%s: %w", dao.ID, err)
+	}
+
+	return nil
+}
+
+func (r *Repo) UpdatePopularityIndex(ctx context.Context, dao Dao) error {
+	return r.updateColumns(ctx, dao, "popularity_index", "external_popularity_index")
+}
+
+func (r *Repo) UpdateCategories(ctx context.Context, dao Dao) error {
+	return r.updateColumns(ctx, dao, "categories")
+}
+
+func (r *Repo) UpdateActivitySince(ctx context.Context, dao Dao) error {
+	return r.updateColumns(ctx, dao, "activity_since")
 }
 
-// Update single dao object in database
-// todo: think about updating fields to default value(boolean, string etc)
-func (r *Repo) Update(dao Dao) error {
-	return r.db.Omit("original_id").Save(&dao).Error
+func (r *Repo) UpdateFungibleInfo(ctx context.Context, dao Dao) error {
+	return r.updateColumns(ctx, dao, "fungible_id", "token_symbol", "verification_status")
+}
+
+func (r *Repo) UpdateVerification(ctx context.Context, dao Dao) error {
+	return r.updateColumns(ctx, dao, "fungible_id", "token_symbol", "verification_status", "verification_comment")
+}
+
+// updateColumns saves only the passed columns if the dao was not changed after reading
+func (r *Repo) updateColumns(ctx context.Context, dao Dao, columns ...string) error {
+	db := outbox.Conn(ctx, r.db).Select(append(columns, "version", "updated_at"))
+
+	return optimistic.Update(db, &dao, &dao.Version)
+}
+
+// CreateHistory stores the record of changed fields
+func (r *Repo) CreateHistory(ctx context.Context, h History) error {
+	return outbox.Conn(ctx, r.db).Create(&h).Error
+}
+
+// CreateVerificationDecision stores the moderator decision
+func (r *Repo) CreateVerificationDecision(ctx context.Context, d VerificationDecision) error {
+	return outbox.Conn(ctx, r.db).Create(&d).Error
+}
+
+// GetVerificationDecisions returns moderator decisions of the dao ordered by the keyset
+func (r *Repo) GetVerificationDecisions(daoID uuid.UUID, keyset pagination.Keyset, limit int) ([]VerificationDecision, error) {
+	var (
+		dummy = VerificationDecision{}
+		_     = dummy.DaoID
+	)
+
+	var list []VerificationDecision
+	err := keyset.Apply(r.db.Where("dao_id = ?", daoID)).Limit(limit).Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("get verification decisions #%s: %w", daoID, err)
+	}
+
+	return list, nil
+}
+
+// GetHistory returns changes of the dao ordered by the keyset.
+// If fields are passed only records which changed any of them are returned.
+func (r *Repo) GetHistory(daoID uuid.UUID, fields []string, keyset pagination.Keyset, limit int) ([]History, error) {
+	var (
+		dummy = History{}
+		_     = dummy.DaoID
+		_     = dummy.Changes
+	)
+
+	db := r.db.Where("dao_id = ?", daoID)
+	if len(fields) != 0 {
+		conds := make([]string, 0, len(fields))
+		args := make([]any, 0, len(fields))
+		for _, field := range fields {
+			filter, err := json.Marshal([]map[string]string{{"field": field}})
+			if err != nil {
+				return nil, fmt.Errorf("marshal field filter: %w", err)
+			}
+
+			conds = append(conds, "changes @> ?::jsonb")
+			args = append(args, string(filter))
+		}
+		db = db.Where(strings.Join(conds, " or "), args...)
+	}
+
+	var list []History
+	err := keyset.Apply(db).Limit(limit).Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("get dao history #%s: %w", daoID, err)
+	}
+
+	return list, nil
 }
 
 func (r *Repo) GetByID(id uuid.UUID) (*Dao, error) {
@@ -47,9 +192,17 @@ func (r *Repo) GetByName(name string) (*Dao, error) {
 	return &dao, nil
 }
 
+// GetByOriginalID returns the dao by the primary or the linked identity
 func (r *Repo) GetByOriginalID(id string) (*Dao, error) {
-	var dao Dao
-	request := r.db.Where(&Dao{OriginalID: id}).First(&dao)
+	var (
+		dao   Dao
+		dummy = DaoID{}
+		_     = dummy.OriginalID
+		_     = dummy.InternalID
+	)
+	request := r.db.
+		Where("original_id = ? or id = (select internal_id from dao_ids where original_id = ?)", id, id).
+		First(&dao)
 	if err := request.Error; err != nil {
 		return nil, fmt.Errorf("get dao by original id #%s: %w", id, err)
 	}
@@ -62,29 +215,32 @@ type DaoList struct {
 	TotalCount int64
 }
 
+// GetByFilters returns daos with the total count if count is true.
+// Page filters are applied after counting, so the total count doesn't depend on the page.
 func (r *Repo) GetByFilters(filters []Filter, count bool) (DaoList, error) {
 	db := r.db.Model(&Dao{})
 	for _, f := range filters {
-		if _, ok := f.(PageFilter); ok {
+		if isPageFilter(f) {
 			continue
 		}
 		db = f.Apply(db)
 	}
 
 	var cnt int64
-	err := db.Count(&cnt).Error
-	if err != nil {
-		return DaoList{}, err
+	if count {
+		if err := db.Count(&cnt).Error; err != nil {
+			return DaoList{}, err
+		}
 	}
 
 	for _, f := range filters {
-		if _, ok := f.(PageFilter); ok {
+		if isPageFilter(f) {
 			db = f.Apply(db)
 		}
 	}
 
 	var daos []Dao
-	err = db.Find(&daos).Error
+	err := db.Find(&daos).Error
 	if err != nil {
 		return DaoList{}, err
 	}
@@ -95,10 +251,76 @@ func (r *Repo) GetByFilters(filters []Filter, count bool) (DaoList, error) {
 	}, nil
 }
 
+// GetIDsByFilters returns identifiers of matched daos
+func (r *Repo) GetIDsByFilters(ctx context.Context, filters []Filter) ([]uuid.UUID, error) {
+	db := outbox.Conn(ctx, r.db).Model(&Dao{})
+	for _, f := range filters {
+		db = f.Apply(db)
+	}
+
+	var ids []uuid.UUID
+	if err := db.Pluck("daos.id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("get dao ids: %w", err)
+	}
+
+	return ids, nil
+}
+
+// GetForCategoryUpdate locks and returns daos which have the category or are listed in ids
+func (r *Repo) GetForCategoryUpdate(ctx context.Context, category string, ids []uuid.UUID) ([]Dao, error) {
+	contains, err := json.Marshal([]string{category})
+	if err != nil {
+		return nil, fmt.Errorf("marshal category %s: %w", category, err)
+	}
+
+	db := outbox.Conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("categories @> ?", string(contains))
+	if len(ids) != 0 {
+		db = db.Or("id in ?", ids)
+	}
+
+	var daos []Dao
+	if err := db.Order("id").Find(&daos).Error; err != nil {
+		return nil, fmt.Errorf("get daos by category %s: %w", category, err)
+	}
+
+	return daos, nil
+}
+
+// GetChildrenRollup returns aggregates of child daos grouped by parents, parents without children are skipped
+func (r *Repo) GetChildrenRollup(parentIDs []uuid.UUID) ([]ChildrenRollup, error) {
+	var (
+		dummy = Dao{}
+		_     = dummy.ParentID
+		_     = dummy.ProposalsCount
+		_     = dummy.ActiveVotes
+	)
+
+	var list []ChildrenRollup
+	err := r.db.Raw(`
+select d.parent_id,
+       sum(d.proposals_count) as proposals_count,
+       sum(d.active_votes)    as active_votes,
+       (select count(distinct v.voter)
+        from dao_voter v
+        where v.dao_id = d.parent_id
+           or v.dao_id in (select c.id from daos c where c.parent_id = d.parent_id)) as voters_count
+from daos d
+where d.parent_id in ?
+group by d.parent_id
+`, parentIDs).Scan(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("get children rollup: %w", err)
+	}
+
+	return list, nil
+}
+
 func (r *Repo) GetCountByFilters(filters []Filter) (int64, error) {
 	db := r.db.Model(&Dao{})
 	for _, f := range filters {
-		if _, ok := f.(PageFilter); ok {
+		if isPageFilter(f) {
 			continue
 		}
 
@@ -206,6 +428,17 @@ WHERE daos.id = cnt.dao_id
 
 // GetRecommended returns the list of available dao strategies in our system
 func (r *Repo) GetRecommended() ([]Recommendation, error) {
+	var (
+		dummy = DaoStrategy{}
+		_     = dummy.DaoID
+		_     = dummy.Name
+		_     = dummy.Network
+		_     = dummy.Address
+		_     = dummy.Symbol
+		_     = dummy.Multichain
+	)
+
+	// top level strategies are filtered by token based names, all multichain sub-strategies are token based
 	query := `
 with active_daos as (select distinct dao_id
                      from proposals pr
@@ -215,51 +448,22 @@ with active_daos as (select distinct dao_id
                          )
                        and spam is not true)
 
-select original_id,
-       internal_id,
-       name                 strategy_name,
-       params ->> 'symbol'  symbol,
-       network              network_id,
-       params ->> 'address' address
-from (select daos.original_id,
-             daos.id        internal_id,
-             daos.network,
-             st."Name"   as name,
-             st."Params" as params
-      from daos,
-           jsonb_to_recordset(daos.strategies) AS st("Name" text, "Params" jsonb)
-      where st."Name" <> 'multichain'
-        and verified is true) data
-         inner join active_daos ad on ad.dao_id = data.internal_id
-where name in
-      ('erc20-votes', 'erc20-balance-of', 'uni',
-       'eth-balance', 'erc721', 'eth-with-balance',
-       'contract-call', 'erc1155-balance-of', 'ens-domains-owned')
-  and params ->> 'symbol' is not null
-  and params ->> 'address' is not null
-
-union all
-
-select data.original_id,
-       data.internal_id,
-       st.name as              strategy_name,
-       st.params ->> 'symbol'  symbol,
-       data.network            network_id,
-       st.params ->> 'address' address
-from (select daos.original_id,
-             daos.id        internal_id,
-             daos.network,
-             st."Name"   as name,
-             st."Params" as params
-      from (select *
-            from daos
-                     inner join active_daos ad on ad.dao_id = daos.id) daos,
-           jsonb_to_recordset(daos.strategies) AS st("Name" text, "Params" jsonb)
-      where st."Name" = 'multichain'
-        and verified is true) data,
-     jsonb_to_recordset(data.params -> 'strategies') AS st("name" text, "params" jsonb)
-where st.params ->> 'symbol' is not null
-  and st.params ->> 'address' is not null
+select daos.original_id,
+       daos.id internal_id,
+       st.name strategy_name,
+       st.symbol,
+       st.network network_id,
+       st.address
+from dao_strategies st
+         inner join daos on daos.id = st.dao_id
+         inner join active_daos ad on ad.dao_id = st.dao_id
+where daos.verified is true
+  and (st.multichain or st.name in
+                        ('erc20-votes', 'erc20-balance-of', 'uni',
+                         'eth-balance', 'erc721', 'eth-with-balance',
+                         'contract-call', 'erc1155-balance-of', 'ens-domains-owned'))
+  and st.symbol <> ''
+  and st.address <> ''
 
 union all
 
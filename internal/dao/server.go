@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
@@ -12,8 +14,8 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 
-	"github.com/goverland-labs/goverland-core-storage/pkg/sdk/zerion"
-
+	"github.com/goverland-labs/goverland-core-storage/internal/pagination"
+	"github.com/goverland-labs/goverland-core-storage/pkg/grpcsrv"
 	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
 )
 
@@ -22,17 +24,29 @@ const (
 	defaultOffset             = 0
 	defaultTopCategoriesLimit = 10
 	maxPerTop                 = 20
+	defaultTreasuryDays       = 30
+	defaultSimilarLimit       = 10
+	defaultActivityDays       = 30
+	maxActivityPoints         = 1000
 )
 
 type Server struct {
 	storagepb.UnimplementedDaoServer
 
 	sp *Service
+	ts *TreasuryService
+	rs *RecommendationService
+	ss *SimilarityService
+	as *ActivityService
 }
 
-func NewServer(sp *Service) *Server {
+func NewServer(sp *Service, ts *TreasuryService, rs *RecommendationService, ss *SimilarityService, as *ActivityService) *Server {
 	return &Server{
 		sp: sp,
+		ts: ts,
+		rs: rs,
+		ss: ss,
+		as: as,
 	}
 }
 
@@ -73,9 +87,19 @@ func (s *Server) GetByFilter(_ context.Context, req *storagepb.DaoByFilterReques
 	if req.GetOffset() > 0 {
 		offset = int(req.GetOffset())
 	}
+
+	keyset := daoKeyset
+	if req.GetCursor() != "" {
+		var err error
+		if keyset, err = daoKeyset.Decode(req.GetCursor()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		offset = 0
+	}
+
 	filters := []Filter{
-		PageFilter{Limit: limit, Offset: offset},
-		OrderByPopularityIndexFilter{},
+		PageFilter{Limit: limit + 1, Offset: offset},
+		keyset,
 	}
 
 	if req.GetQuery() != "" {
@@ -98,15 +122,29 @@ func (s *Server) GetByFilter(_ context.Context, req *storagepb.DaoByFilterReques
 		})
 	}
 
-	list, err := s.sp.GetByFilters(filters)
+	list, err := s.sp.GetByFilters(filters, !req.GetSkipTotalCount())
 	if err != nil {
 		log.Error().Err(err).Msgf("get daos by filter: %+v", req)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
+	var nextCursor string
+	if list.Daos, nextCursor, err = pagination.Page(list.Daos, limit, daoCursor(keyset)); err != nil {
+		log.Error().Err(err).Msgf("encode dao cursor: %+v", req)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	if req.GetRollupChildren() {
+		if list.Daos, err = s.sp.RollupChildren(list.Daos); err != nil {
+			log.Error().Err(err).Msgf("rollup dao children: %+v", req)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
 	res := &storagepb.DaoByFilterResponse{
 		Daos:       make([]*storagepb.DaoInfo, len(list.Daos)),
 		TotalCount: uint64(list.TotalCount),
+		NextCursor: nextCursor,
 	}
 
 	for i, info := range list.Daos {
@@ -155,6 +193,11 @@ func (s *Server) GetTopByCategories(ctx context.Context, req *storagepb.TopByCat
 }
 
 func ConvertDaoToAPI(dao *Dao) *storagepb.DaoInfo {
+	var parentID string
+	if dao.ParentID != nil {
+		parentID = dao.ParentID.String()
+	}
+
 	return &storagepb.DaoInfo{
 		Id:                 dao.ID.String(),
 		Alias:              dao.OriginalID,
@@ -189,10 +232,11 @@ func ConvertDaoToAPI(dao *Dao) *storagepb.DaoInfo {
 		Verified:           dao.Verified,
 		PopularityIndex:    dao.PopularityIndex,
 		ActiveProposalsIds: dao.ActiveProposalsIDs,
-		TokenExist:         dao.FungibleId != "" && dao.VerificationStatus != "declined",
+		TokenExist:         dao.FungibleId != "" && dao.VerificationStatus != VerificationDeclined,
 		TokenSymbol:        dao.TokenSymbol,
 		FungibleId:         dao.FungibleId,
-		// TODO: parentID
+		Source:             dao.Source,
+		ParentId:           parentID,
 	}
 }
 
@@ -247,26 +291,64 @@ func (s *Server) GetRecommendationsList(
 	}
 
 	for _, details := range list {
-		resp.List = append(resp.List, &storagepb.DaoRecommendationDetails{
-			OriginalId: details.OriginalId,
-			InternalId: details.InternalId,
-			Name:       details.Name,
-			Symbol:     details.Symbol,
-			NetworkId:  details.NetworkId,
-			Address:    details.Address,
-		})
+		resp.List = append(resp.List, convertRecommendationToAPI(details))
+	}
+
+	return resp, nil
+}
+
+func (s *Server) GetRecommendationsForAddress(ctx context.Context, req *storagepb.RecommendationsForAddressRequest) (*storagepb.RecommendationsForAddressResponse, error) {
+	if req.GetAddress() == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	limit := defaultDaoLimit
+	if req.GetLimit() > 0 {
+		limit = int(req.GetLimit())
+	}
+
+	list, err := s.rs.GetForAddress(ctx, req.GetAddress(), limit)
+	if err != nil {
+		log.Error().Err(err).Msgf("get recommendations for address: %+v", req)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &storagepb.RecommendationsForAddressResponse{
+		List: make([]*storagepb.AddressRecommendation, len(list)),
+	}
+	for i, item := range list {
+		tokens := make([]*storagepb.DaoRecommendationDetails, len(item.Tokens))
+		for j, details := range item.Tokens {
+			tokens[j] = convertRecommendationToAPI(details)
+		}
+
+		resp.List[i] = &storagepb.AddressRecommendation{
+			Dao:    ConvertDaoToAPI(&item.Dao),
+			Tokens: tokens,
+		}
 	}
 
 	return resp, nil
 }
 
-func (s *Server) GetTokenInfo(_ context.Context, req *storagepb.TokenInfoRequest) (*storagepb.TokenInfoResponse, error) {
+func convertRecommendationToAPI(details Recommendation) *storagepb.DaoRecommendationDetails {
+	return &storagepb.DaoRecommendationDetails{
+		OriginalId: details.OriginalId,
+		InternalId: details.InternalId,
+		Name:       details.Name,
+		Symbol:     details.Symbol,
+		NetworkId:  details.NetworkId,
+		Address:    details.Address,
+	}
+}
+
+func (s *Server) GetTokenInfo(ctx context.Context, req *storagepb.TokenInfoRequest) (*storagepb.TokenInfoResponse, error) {
 	id, err := uuid.Parse(req.GetDaoId())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid dao ID")
 	}
 
-	data, err := s.sp.GetTokenInfo(id)
+	data, err := s.sp.GetTokenInfo(ctx, id)
 	if err != nil {
 		log.Error().Err(err).Msgf("get token info: %+v", req)
 		return nil, status.Error(codes.Internal, "internal error")
@@ -296,12 +378,16 @@ func (s *Server) GetTokenInfo(_ context.Context, req *storagepb.TokenInfoRequest
 	}, nil
 }
 
-func (s *Server) GetTokenChart(_ context.Context, req *storagepb.TokenChartRequest) (*storagepb.TokenChartResponse, error) {
+func (s *Server) GetTokenChart(ctx context.Context, req *storagepb.TokenChartRequest) (*storagepb.TokenChartResponse, error) {
 	id, err := uuid.Parse(req.GetDaoId())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid dao ID")
 	}
-	data, err := s.sp.GetTokenChart(id, req.GetPeriod())
+	if _, ok := tokenChartPeriods[req.GetPeriod()]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid period")
+	}
+
+	data, err := s.sp.GetTokenChart(ctx, id, req.GetPeriod())
 	if err != nil {
 		log.Error().Err(err).Msgf("get token chart: %+v", req)
 		return nil, status.Error(codes.Internal, "internal error")
@@ -331,16 +417,470 @@ func (s *Server) UpdateFungibleIds(ctx context.Context, req *storagepb.UpdateFun
 	return &storagepb.UpdateFungibleIdsResponse{Status: ok}, nil
 }
 
-func convertChartToAPI(data *zerion.ChartData) *storagepb.TokenChartResponse {
-	var pc float64
-	if data.ChartAttributes.Stats.First != 0 {
-		pc = (data.ChartAttributes.Stats.Last - data.ChartAttributes.Stats.First) / data.ChartAttributes.Stats.First
-	} else {
-		pc = 0
+func (s *Server) GetHistory(_ context.Context, req *storagepb.DaoHistoryRequest) (*storagepb.DaoHistoryResponse, error) {
+	id, err := s.resolveDaoID(req.GetDaoId())
+	if err != nil {
+		return nil, err
+	}
+
+	limit := defaultDaoLimit
+	if req.GetLimit() > 0 {
+		limit = int(req.GetLimit())
+	}
+
+	keyset := historyKeyset
+	if req.GetCursor() != "" {
+		if keyset, err = historyKeyset.Decode(req.GetCursor()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+	}
+
+	list, err := s.sp.GetHistory(id, req.GetFields(), keyset, limit+1)
+	if err != nil {
+		log.Error().Err(err).Msgf("get dao history: %+v", req)
+		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	points := make([]*storagepb.Point, len(data.ChartAttributes.Points))
-	for i, info := range data.ChartAttributes.Points {
+	list, nextCursor, err := pagination.Page(list, limit, func(page []History) (string, error) {
+		return keyset.Encode(page[len(page)-1].ID)
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("encode dao history cursor: %+v", req)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	res := &storagepb.DaoHistoryResponse{
+		Items:      make([]*storagepb.DaoHistoryItem, len(list)),
+		NextCursor: nextCursor,
+	}
+	for i, item := range list {
+		res.Items[i] = convertHistoryToAPI(item)
+	}
+
+	return res, nil
+}
+
+func (s *Server) GetChildren(_ context.Context, req *storagepb.DaoChildrenRequest) (*storagepb.DaoChildrenResponse, error) {
+	id, err := s.resolveDaoID(req.GetDaoId())
+	if err != nil {
+		return nil, err
+	}
+
+	daos, nextCursor, err := s.getDaosPage(ParentIDFilter{ParentID: id}, req.GetLimit(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	return &storagepb.DaoChildrenResponse{
+		Daos:       daos,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (s *Server) GetTreasury(_ context.Context, req *storagepb.DaoTreasuryRequest) (*storagepb.DaoTreasuryResponse, error) {
+	id, err := s.resolveDaoID(req.GetDaoId())
+	if err != nil {
+		return nil, err
+	}
+
+	days := defaultTreasuryDays
+	if req.GetDays() > 0 {
+		days = int(req.GetDays())
+	}
+
+	overview, err := s.ts.GetTreasury(id, time.Now().AddDate(0, 0, -days))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.InvalidArgument, "invalid dao ID")
+	}
+	if err != nil {
+		log.Error().Err(err).Msgf("get dao treasury: %+v", req)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return convertTreasuryToAPI(overview), nil
+}
+
+func (s *Server) GetSimilar(_ context.Context, req *storagepb.DaoSimilarRequest) (*storagepb.DaoSimilarResponse, error) {
+	id, err := s.resolveDaoID(req.GetDaoId())
+	if err != nil {
+		return nil, err
+	}
+
+	// only top neighbours are stored
+	limit := defaultSimilarLimit
+	if req.GetLimit() > 0 {
+		limit = min(int(req.GetLimit()), similarityTopN)
+	}
+
+	list, err := s.ss.GetSimilar(id, limit)
+	if err != nil {
+		log.Error().Err(err).Msgf("get similar daos: %+v", req)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &storagepb.DaoSimilarResponse{
+		List: make([]*storagepb.SimilarDao, len(list)),
+	}
+	for i, item := range list {
+		resp.List[i] = &storagepb.SimilarDao{
+			Dao:          ConvertDaoToAPI(&item.Dao),
+			Score:        item.Score,
+			SharedVoters: uint64(item.SharedVoters),
+		}
+	}
+
+	return resp, nil
+}
+
+var activityGranularities = map[storagepb.ActivityGranularity]string{
+	storagepb.ActivityGranularity_ACTIVITY_GRANULARITY_UNSPECIFIED: GranularityDay,
+	storagepb.ActivityGranularity_ACTIVITY_GRANULARITY_DAY:         GranularityDay,
+	storagepb.ActivityGranularity_ACTIVITY_GRANULARITY_WEEK:        GranularityWeek,
+	storagepb.ActivityGranularity_ACTIVITY_GRANULARITY_MONTH:       GranularityMonth,
+}
+
+func (s *Server) GetActivity(_ context.Context, req *storagepb.DaoActivityRequest) (*storagepb.DaoActivityResponse, error) {
+	id, err := s.resolveDaoID(req.GetDaoId())
+	if err != nil {
+		return nil, err
+	}
+
+	granularity, ok := activityGranularities[req.GetGranularity()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid granularity")
+	}
+
+	to := time.Now()
+	if req.To != nil {
+		to = req.GetTo().AsTime()
+	}
+	from := to.AddDate(0, 0, -defaultActivityDays)
+	if req.From != nil {
+		from = req.GetFrom().AsTime()
+	}
+	if from.After(to) {
+		return nil, status.Error(codes.InvalidArgument, "invalid range")
+	}
+	if granularity == GranularityDay && to.Sub(from) > maxActivityPoints*24*time.Hour {
+		return nil, status.Error(codes.InvalidArgument, "range is too long, use the coarser granularity")
+	}
+
+	points, err := s.as.GetActivity(id, from, to, granularity)
+	if err != nil {
+		log.Error().Err(err).Msgf("get dao activity: %+v", req)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &storagepb.DaoActivityResponse{
+		Points: make([]*storagepb.ActivityPoint, len(points)),
+	}
+	for i, point := range points {
+		resp.Points[i] = &storagepb.ActivityPoint{
+			Time:      timestamppb.New(point.Time),
+			Proposals: uint64(point.Proposals),
+			Votes:     uint64(point.Votes),
+			Voters:    uint64(point.Voters),
+			NewVoters: uint64(point.NewVoters),
+			Vp:        point.Vp,
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *Server) GetIdentities(_ context.Context, req *storagepb.DaoIdentitiesRequest) (*storagepb.DaoIdentitiesResponse, error) {
+	id, err := s.resolveDaoID(req.GetDaoId())
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := s.sp.GetIdentities(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.InvalidArgument, "invalid dao ID")
+	}
+	if err != nil {
+		log.Error().Err(err).Msgf("get dao identities: %+v", req)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return convertIdentitiesToAPI(list), nil
+}
+
+func (s *Server) LinkIdentity(_ context.Context, req *storagepb.LinkIdentityRequest) (*storagepb.DaoIdentitiesResponse, error) {
+	id, err := s.resolveDaoID(req.GetDaoId())
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := s.sp.LinkIdentity(id, req.GetIdentity())
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, status.Error(codes.InvalidArgument, "invalid dao ID")
+	case errors.Is(err, ErrInvalidIdentity):
+		return nil, status.Error(codes.InvalidArgument, "invalid identity")
+	case errors.Is(err, ErrIdentityLinked):
+		return nil, status.Error(codes.AlreadyExists, "identity is linked to another dao")
+	case err != nil:
+		log.Error().Err(err).Msgf("link dao identity: %+v", req)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return convertIdentitiesToAPI(list), nil
+}
+
+func convertIdentitiesToAPI(list []DaoID) *storagepb.DaoIdentitiesResponse {
+	resp := &storagepb.DaoIdentitiesResponse{
+		List: make([]*storagepb.DaoIdentity, len(list)),
+	}
+	for i, item := range list {
+		resp.List[i] = &storagepb.DaoIdentity{
+			OriginalId: item.OriginalID,
+			Source:     item.Source,
+			ExternalId: item.ExternalID,
+			Primary:    item.IsPrimary,
+		}
+	}
+
+	return resp
+}
+
+func (s *Server) GetByToken(_ context.Context, req *storagepb.DaoByTokenRequest) (*storagepb.DaoByTokenResponse, error) {
+	if req.GetAddress() == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	daos, nextCursor, err := s.getDaosPage(TokenFilter{Network: req.GetNetwork(), Address: req.GetAddress()}, req.GetLimit(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	return &storagepb.DaoByTokenResponse{
+		Daos:       daos,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (s *Server) GetByStrategy(_ context.Context, req *storagepb.DaoByStrategyRequest) (*storagepb.DaoByStrategyResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid strategy name")
+	}
+
+	daos, nextCursor, err := s.getDaosPage(StrategyFilter{Name: req.GetName()}, req.GetLimit(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	return &storagepb.DaoByStrategyResponse{
+		Daos:       daos,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// getDaosPage returns the page of daos by the filter ordered by the popularity index
+func (s *Server) getDaosPage(filter Filter, reqLimit uint64, cursor string) ([]*storagepb.DaoInfo, string, error) {
+	limit := defaultDaoLimit
+	if reqLimit > 0 {
+		limit = int(reqLimit)
+	}
+
+	var err error
+	keyset := daoKeyset
+	if cursor != "" {
+		if keyset, err = daoKeyset.Decode(cursor); err != nil {
+			return nil, "", status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+	}
+
+	list, err := s.sp.GetByFilters([]Filter{filter, keyset, PageFilter{Limit: limit + 1}}, false)
+	if err != nil {
+		log.Error().Err(err).Msgf("get daos page: %+v", filter)
+		return nil, "", status.Error(codes.Internal, "internal error")
+	}
+
+	daos, nextCursor, err := pagination.Page(list.Daos, limit, daoCursor(keyset))
+	if err != nil {
+		log.Error().Err(err).Msgf("encode daos page cursor: %+v", filter)
+		return nil, "", status.Error(codes.Internal, "internal error")
+	}
+
+	res := make([]*storagepb.DaoInfo, len(daos))
+	for i, info := range daos {
+		res[i] = ConvertDaoToAPI(&info)
+	}
+
+	return res, nextCursor, nil
+}
+
+func (s *Server) GetPendingVerifications(_ context.Context, req *storagepb.PendingVerificationsRequest) (*storagepb.PendingVerificationsResponse, error) {
+	daos, nextCursor, err := s.getDaosPage(VerificationStatusFilter{Status: VerificationPending}, req.GetLimit(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	return &storagepb.PendingVerificationsResponse{
+		Daos:       daos,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (s *Server) DecideVerification(ctx context.Context, req *storagepb.DecideVerificationRequest) (*storagepb.DecideVerificationResponse, error) {
+	id, err := s.resolveDaoID(req.GetDaoId())
+	if err != nil {
+		return nil, err
+	}
+
+	var decision string
+	switch req.GetStatus() {
+	case storagepb.VerificationDecisionStatus_VERIFICATION_DECISION_STATUS_APPROVED:
+		decision = VerificationApproved
+	case storagepb.VerificationDecisionStatus_VERIFICATION_DECISION_STATUS_DECLINED:
+		decision = VerificationDeclined
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+
+	if strings.TrimSpace(req.GetModerator()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "moderator is required")
+	}
+
+	var client string
+	if c, ok := grpcsrv.ClientFromContext(ctx); ok {
+		client = c.Name
+	}
+
+	dao, err := s.sp.DecideVerification(ctx, Decision{
+		DaoID:           id,
+		Status:          decision,
+		Comment:         req.GetComment(),
+		Moderator:       strings.TrimSpace(req.GetModerator()),
+		Client:          client,
+		ClearFungibleID: req.GetClearFungibleId(),
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.InvalidArgument, "invalid dao ID")
+	}
+	if errors.Is(err, ErrNoFungibleID) {
+		return nil, status.Error(codes.FailedPrecondition, "dao has no fungible id")
+	}
+	if err != nil {
+		log.Error().Err(err).Msgf("decide verification: %+v", req)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &storagepb.DecideVerificationResponse{
+		Dao: ConvertDaoToAPI(dao),
+	}, nil
+}
+
+func (s *Server) GetVerificationDecisions(_ context.Context, req *storagepb.VerificationDecisionsRequest) (*storagepb.VerificationDecisionsResponse, error) {
+	id, err := s.resolveDaoID(req.GetDaoId())
+	if err != nil {
+		return nil, err
+	}
+
+	limit := defaultDaoLimit
+	if req.GetLimit() > 0 {
+		limit = int(req.GetLimit())
+	}
+
+	keyset := verificationDecisionKeyset
+	if req.GetCursor() != "" {
+		if keyset, err = verificationDecisionKeyset.Decode(req.GetCursor()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+	}
+
+	list, err := s.sp.GetVerificationDecisions(id, keyset, limit+1)
+	if err != nil {
+		log.Error().Err(err).Msgf("get verification decisions: %+v", req)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	list, nextCursor, err := pagination.Page(list, limit, func(page []VerificationDecision) (string, error) {
+		return keyset.Encode(page[len(page)-1].ID)
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("encode verification decisions cursor: %+v", req)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	res := &storagepb.VerificationDecisionsResponse{
+		Items:      make([]*storagepb.VerificationDecisionItem, len(list)),
+		NextCursor: nextCursor,
+	}
+	for i, item := range list {
+		res.Items[i] = convertVerificationDecisionToAPI(item)
+	}
+
+	return res, nil
+}
+
+// daoCursor returns the cursor after the last dao of the page ordered by the popularity index
+func daoCursor(keyset pagination.Keyset) func(page []Dao) (string, error) {
+	return func(page []Dao) (string, error) {
+		last := page[len(page)-1]
+
+		return keyset.Encode(last.PopularityIndex, last.ID.String())
+	}
+}
+
+// resolveDaoID accepts both internal and original dao identifiers, returned errors are ready for the response
+func (s *Server) resolveDaoID(daoID string) (uuid.UUID, error) {
+	if daoID == "" {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid dao ID")
+	}
+
+	if id, err := uuid.Parse(daoID); err == nil {
+		return id, nil
+	}
+
+	dao, err := s.sp.GetDaoByOriginalID(daoID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid dao ID")
+	}
+	if err != nil {
+		log.Error().Err(err).Msgf("get dao by id: %s", daoID)
+		return uuid.Nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return dao.ID, nil
+}
+
+func convertHistoryToAPI(h History) *storagepb.DaoHistoryItem {
+	changes := make([]*storagepb.DaoFieldChange, len(h.Changes))
+	for i, change := range h.Changes {
+		changes[i] = &storagepb.DaoFieldChange{
+			Field:    change.Field,
+			OldValue: string(change.Old),
+			NewValue: string(change.New),
+		}
+	}
+
+	return &storagepb.DaoHistoryItem{
+		Id:        h.ID,
+		CreatedAt: timestamppb.New(h.CreatedAt),
+		Source:    h.Source,
+		Changes:   changes,
+	}
+}
+
+func convertVerificationDecisionToAPI(d VerificationDecision) *storagepb.VerificationDecisionItem {
+	return &storagepb.VerificationDecisionItem{
+		Id:                d.ID,
+		CreatedAt:         timestamppb.New(d.CreatedAt),
+		Moderator:         d.Moderator,
+		Client:            d.Client,
+		Status:            d.Status,
+		PreviousStatus:    d.PreviousStatus,
+		Comment:           d.Comment,
+		FungibleId:        d.FungibleID,
+		FungibleIdCleared: d.FungibleIDCleared,
+	}
+}
+
+func convertChartToAPI(data *TokenChart) *storagepb.TokenChartResponse {
+	points := make([]*storagepb.Point, len(data.Points))
+	for i, info := range data.Points {
 		points[i] = &storagepb.Point{
 			Time:  timestamppb.New(info.Time),
 			Price: info.Price,
@@ -348,8 +888,52 @@ func convertChartToAPI(data *zerion.ChartData) *storagepb.TokenChartResponse {
 	}
 
 	return &storagepb.TokenChartResponse{
-		Price:        data.ChartAttributes.Stats.Last,
-		PriceChanges: pc,
+		Price:        data.Price(),
+		PriceChanges: data.PriceChange(),
 		Points:       points,
 	}
 }
+
+func convertTreasuryToAPI(overview *TreasuryOverview) *storagepb.DaoTreasuryResponse {
+	res := &storagepb.DaoTreasuryResponse{
+		TotalValue: overview.Value,
+		Wallets:    make([]*storagepb.TreasuryWallet, len(overview.Wallets)),
+		Series:     make([]*storagepb.TreasuryValuePoint, len(overview.Series)),
+	}
+
+	for i, wallet := range overview.Wallets {
+		positions := make([]*storagepb.TreasuryPosition, len(wallet.Positions))
+		for j, p := range wallet.Positions {
+			positions[j] = &storagepb.TreasuryPosition{
+				FungibleId: p.FungibleID,
+				Chain:      p.Chain,
+				Name:       p.Name,
+				Symbol:     p.Symbol,
+				IconUrl:    p.IconURL,
+				Quantity:   p.Quantity,
+				Price:      p.Price,
+				Value:      p.Value,
+			}
+		}
+
+		res.Wallets[i] = &storagepb.TreasuryWallet{
+			Name:      wallet.Name,
+			Address:   wallet.Address,
+			Network:   wallet.Network,
+			Value:     wallet.Value,
+			Positions: positions,
+		}
+		if !wallet.UpdatedAt.IsZero() {
+			res.Wallets[i].UpdatedAt = timestamppb.New(wallet.UpdatedAt)
+		}
+	}
+
+	for i, point := range overview.Series {
+		res.Series[i] = &storagepb.TreasuryValuePoint{
+			Time:  timestamppb.New(point.Time),
+			Value: point.Value,
+		}
+	}
+
+	return res
+}
@@ -6,14 +6,14 @@ import (
 	"fmt"
 	"reflect"
 	"slices"
-	"sort"
 	"sync"
 	"time"
 
-	"golang.org/x/text/language"
-	message2 "golang.org/x/text/message"
-
-	"github.com/goverland-labs/goverland-core-storage/internal/discord"
+	"github.com/goverland-labs/goverland-core-storage/internal/marketdata"
+	"github.com/goverland-labs/goverland-core-storage/internal/notifier"
+	"github.com/goverland-labs/goverland-core-storage/internal/optimistic"
+	"github.com/goverland-labs/goverland-core-storage/internal/outbox"
+	"github.com/goverland-labs/goverland-core-storage/internal/pagination"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -25,7 +25,6 @@ import (
 	coreevents "github.com/goverland-labs/goverland-platform-events/events/core"
 
 	"github.com/goverland-labs/goverland-core-storage/internal/proposal"
-	"github.com/goverland-labs/goverland-core-storage/pkg/sdk/zerion"
 )
 
 const (
@@ -33,20 +32,34 @@ const (
 	popularDaoCategoryName = "popular_daos"
 )
 
-var (
-	systemCategories = []string{
-		newDaoCategoryName,
-		popularDaoCategoryName,
-	}
-)
+// defaultSystemCategories are used until category rules are loaded
+var defaultSystemCategories = []string{
+	newDaoCategoryName,
+	popularDaoCategoryName,
+}
 
 type Publisher interface {
 	PublishJSON(ctx context.Context, subject string, obj any) error
 }
 
+// Notifier sends notifications of the kind to moderators, see the notifier package for kinds and their data
+type Notifier interface {
+	Notify(ctx context.Context, kind string, data any) error
+}
+
 type DataProvider interface {
-	Create(dao Dao) error
-	Update(dao Dao) error
+	CallInTx(ctx context.Context, cb func(ctx context.Context) error) error
+	Create(ctx context.Context, dao Dao) error
+	Update(ctx context.Context, dao Dao) error
+	UpdatePopularityIndex(ctx context.Context, dao Dao) error
+	UpdateCategories(ctx context.Context, dao Dao) error
+	UpdateActivitySince(ctx context.Context, dao Dao) error
+	UpdateFungibleInfo(ctx context.Context, dao Dao) error
+	UpdateVerification(ctx context.Context, dao Dao) error
+	CreateVerificationDecision(ctx context.Context, d VerificationDecision) error
+	GetVerificationDecisions(daoID uuid.UUID, keyset pagination.Keyset, limit int) ([]VerificationDecision, error)
+	CreateHistory(ctx context.Context, h History) error
+	GetHistory(daoID uuid.UUID, fields []string, keyset pagination.Keyset, limit int) ([]History, error)
 	GetByID(id uuid.UUID) (*Dao, error)
 	GetByOriginalID(id string) (*Dao, error)
 	UpdateProposalCnt(id uuid.UUID) error
@@ -54,6 +67,9 @@ type DataProvider interface {
 	UpdateActiveVotesAll() error
 	UpdateProposalCntAll() error
 	GetByFilters(filters []Filter, count bool) (DaoList, error)
+	GetIDsByFilters(ctx context.Context, filters []Filter) ([]uuid.UUID, error)
+	GetChildrenRollup(parentIDs []uuid.UUID) ([]ChildrenRollup, error)
+	GetForCategoryUpdate(ctx context.Context, category string, ids []uuid.UUID) ([]Dao, error)
 	GetCategories() ([]string, error)
 	GetRecommended() ([]Recommendation, error)
 }
@@ -61,6 +77,8 @@ type DataProvider interface {
 type DaoIDProvider interface {
 	GetOrCreate(originID string) (uuid.UUID, error)
 	GetAll() ([]DaoID, error)
+	Link(id uuid.UUID, originalID string) ([]DaoID, error)
+	GetIdentities(id uuid.UUID) ([]DaoID, error)
 }
 
 type UniqueVoterProvider interface {
@@ -89,19 +107,23 @@ type Service struct {
 	recommendations   []Recommendation
 	recommendationsMu sync.RWMutex
 
+	systemCategories   []string
+	systemCategoriesMu sync.RWMutex
+
 	repo              DataProvider
 	fungibleChainRepo *FungibleChainRepo
+	tokenPrices       TokenPriceProvider
 	uniqueRepo        UniqueVoterProvider
 	events            Publisher
 	idProvider        DaoIDProvider
 	proposals         ProposalProvider
 
-	topDAOCache   *TopDAOCache
-	zerionClient  *zerion.Client
-	discordSender *discord.Sender
+	topDAOCache *TopDAOCache
+	market      marketdata.Provider
+	notifier    Notifier
 }
 
-func NewService(r DataProvider, ur UniqueVoterProvider, ip DaoIDProvider, p Publisher, pp ProposalProvider, topDAOCache *TopDAOCache, fungibleChainRepo *FungibleChainRepo, zerionClient *zerion.Client, discordSender *discord.Sender) (*Service, error) {
+func NewService(r DataProvider, ur UniqueVoterProvider, ip DaoIDProvider, p Publisher, pp ProposalProvider, topDAOCache *TopDAOCache, fungibleChainRepo *FungibleChainRepo, tokenPrices TokenPriceProvider, market marketdata.Provider, notifier Notifier) (*Service, error) {
 	return &Service{
 		repo:               r,
 		uniqueRepo:         ur,
@@ -114,8 +136,10 @@ func NewService(r DataProvider, ur UniqueVoterProvider, ip DaoIDProvider, p Publ
 		spacesLock:         sync.RWMutex{},
 		topDAOCache:        topDAOCache,
 		fungibleChainRepo:  fungibleChainRepo,
-		zerionClient:       zerionClient,
-		discordSender:      discordSender,
+		tokenPrices:        tokenPrices,
+		market:             market,
+		notifier:           notifier,
+		systemCategories:   defaultSystemCategories,
 	}, nil
 }
 
@@ -136,51 +160,71 @@ func (s *Service) PrefillDaoIDs() error {
 	return nil
 }
 
-func (s *Service) HandleDao(ctx context.Context, dao Dao) error {
+// HandleDao creates or updates the dao, the source is stored in the history of changes
+func (s *Service) HandleDao(ctx context.Context, dao Dao, source string) error {
+	if identity, err := ParseIdentity(dao.OriginalID); err == nil {
+		dao.OriginalID = identity.String()
+		dao.Source = identity.Source
+	}
+
 	id, err := s.GetIDByOriginalID(dao.OriginalID)
 	if err != nil {
 		return fmt.Errorf("getting/generating dao id: %w", err)
 	}
 	dao.ID = id
 
-	existed, err := s.repo.GetByID(id)
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return fmt.Errorf("handle: %w", err)
-	}
+	return optimistic.Retry(ctx, "handle_dao", func(ctx context.Context) error {
+		existed, err := s.repo.GetByID(id)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("handle: %w", err)
+		}
 
-	if existed == nil {
-		return s.processNew(ctx, dao)
-	}
+		if existed == nil {
+			return s.processNew(ctx, dao)
+		}
+
+		// the dao data is received only from the primary identity, linked sources just resolve to the dao
+		if existed.OriginalID != dao.OriginalID {
+			log.Debug().Str("dao_id", id.String()).Str("original_id", dao.OriginalID).Msg("skip dao of the linked identity")
 
-	return s.processExisted(ctx, dao, *existed)
+			return nil
+		}
+
+		return s.processExisted(ctx, dao, *existed, source)
+	})
 }
 
 func (s *Service) processNew(ctx context.Context, dao Dao) error {
-	if err := s.repo.Create(dao); err != nil {
-		return fmt.Errorf("can't create dao: %w", err)
-	}
+	err := s.repo.CallInTx(outbox.WithKey(ctx, dao.ID.String()), func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, dao); err != nil {
+			return fmt.Errorf("can't create dao: %w", err)
+		}
 
-	defer func(id uuid.UUID) {
-		if err := s.repo.UpdateProposalCnt(id); err != nil {
-			log.Warn().Err(err).Msgf("repo.UpdateProposalCnt: %s", id.String())
+		if err := s.events.PublishJSON(ctx, coreevents.SubjectDaoCreated, convertToCoreEvent(dao)); err != nil {
+			return fmt.Errorf("publish dao event #%s: %w", dao.ID, err)
 		}
-		if err := s.repo.UpdateActiveVotes(id); err != nil {
-			log.Warn().Err(err).Msgf("repo.UpdateActiveVotes: %s", id.String())
+
+		if err := s.events.PublishJSON(ctx, coreevents.SubjectCheckActivitySince, convertToCoreEvent(dao)); err != nil {
+			return fmt.Errorf("publish dao event #%s: %w", dao.ID, err)
 		}
-	}(dao.ID)
 
-	if err := s.events.PublishJSON(ctx, coreevents.SubjectDaoCreated, convertToCoreEvent(dao)); err != nil {
-		log.Error().Err(err).Msgf("publish dao event #%s", dao.ID)
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	if err := s.events.PublishJSON(ctx, coreevents.SubjectCheckActivitySince, convertToCoreEvent(dao)); err != nil {
-		log.Error().Err(err).Msgf("publish dao event #%s", dao.ID)
+	if err := s.repo.UpdateProposalCnt(dao.ID); err != nil {
+		log.Warn().Err(err).Msgf("repo.UpdateProposalCnt: %s", dao.ID.String())
+	}
+	if err := s.repo.UpdateActiveVotes(dao.ID); err != nil {
+		log.Warn().Err(err).Msgf("repo.UpdateActiveVotes: %s", dao.ID.String())
 	}
 
 	return nil
 }
 
-func (s *Service) processExisted(ctx context.Context, new, existed Dao) error {
+func (s *Service) processExisted(ctx context.Context, new, existed Dao, source string) error {
 	equal := compare(new, existed)
 	if equal {
 		return nil
@@ -189,40 +233,98 @@ func (s *Service) processExisted(ctx context.Context, new, existed Dao) error {
 	new.CreatedAt = existed.CreatedAt
 	new.ActivitySince = existed.ActivitySince
 	new.PopularityIndex = existed.PopularityIndex
-	new.Categories = enrichWithSystemCategories(new.Categories, existed.Categories)
+	new.ExternalPopularityIndex = existed.ExternalPopularityIndex
+	new.LocalPopularityIndex = existed.LocalPopularityIndex
+	new.Categories = enrichWithSystemCategories(new.Categories, existed.Categories, s.getSystemCategories())
 	new.FungibleId = existed.FungibleId
 	new.TokenSymbol = existed.TokenSymbol
 	new.VerificationStatus = existed.VerificationStatus
 	new.VerificationComment = existed.VerificationComment
-	err := s.repo.Update(new)
+	new.Version = existed.Version
+	err := s.repo.CallInTx(outbox.WithKey(ctx, new.ID.String()), func(ctx context.Context) error {
+		changes, err := s.update(ctx, existed, new, source, s.repo.Update)
+		if err != nil {
+			return err
+		}
+
+		if err := s.events.PublishJSON(ctx, coreevents.SubjectDaoUpdated, convertToCoreEvent(new)); err != nil {
+			return fmt.Errorf("publish dao event #%s: %w", new.ID, err)
+		}
+
+		if err := s.publishSettingsChanges(ctx, new, changes); err != nil {
+			return err
+		}
+
+		if err := s.events.PublishJSON(ctx, coreevents.SubjectCheckActivitySince, convertToCoreEvent(new)); err != nil {
+			return fmt.Errorf("publish dao event #%s: %w", new.ID, err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("update dao #%s: %w", new.ID, err)
+		return err
+	}
+
+	if err = s.repo.UpdateProposalCnt(new.ID); err != nil {
+		log.Warn().Err(err).Msgf("repo.UpdateProposalCnt: %s", new.ID.String())
 	}
+	if err = s.repo.UpdateActiveVotes(new.ID); err != nil {
+		log.Warn().Err(err).Msgf("repo.UpdateActiveVotes: %s", new.ID.String())
+	}
+
+	return nil
+}
 
-	defer func(id uuid.UUID) {
-		if err = s.repo.UpdateProposalCnt(id); err != nil {
-			log.Warn().Err(err).Msgf("repo.UpdateProposalCnt: %s", id.String())
+// update stores the dao by save with the record of changed fields and returns the changes.
+// The dao and the record are saved in one transaction.
+func (s *Service) update(ctx context.Context, existed, dao Dao, source string, save func(ctx context.Context, dao Dao) error) (Changes, error) {
+	changes := diff(existed, dao)
+	err := s.repo.CallInTx(ctx, func(ctx context.Context) error {
+		if err := save(ctx, dao); err != nil {
+			return fmt.Errorf("update dao #%s: %w", dao.ID, err)
 		}
-		if err = s.repo.UpdateActiveVotes(id); err != nil {
-			log.Warn().Err(err).Msgf("repo.UpdateActiveVotes: %s", id.String())
+
+		if len(changes) == 0 {
+			return nil
 		}
-	}(new.ID)
 
-	go func(dao Dao) {
-		if err := s.events.PublishJSON(ctx, coreevents.SubjectDaoUpdated, convertToCoreEvent(dao)); err != nil {
-			log.Error().Err(err).Msgf("publish dao event #%s", dao.ID)
+		err := s.repo.CreateHistory(ctx, History{
+			DaoID:   dao.ID,
+			Source:  source,
+			Changes: changes,
+		})
+		if err != nil {
+			return fmt.Errorf("create dao history #%s: %w", dao.ID, err)
 		}
 
-		if err := s.events.PublishJSON(ctx, coreevents.SubjectCheckActivitySince, convertToCoreEvent(dao)); err != nil {
-			log.Error().Err(err).Msgf("publish dao event #%s", dao.ID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// publishSettingsChanges publishes dedicated events for changed dao settings
+func (s *Service) publishSettingsChanges(ctx context.Context, dao Dao, changes Changes) error {
+	for _, sc := range classifySettings(changes) {
+		payload := SettingsChangedPayload{
+			DaoID:   dao.ID,
+			Alias:   dao.OriginalID,
+			Changes: sc.changes,
+		}
+		if err := s.events.PublishJSON(ctx, sc.subject, payload); err != nil {
+			return fmt.Errorf("publish dao settings event #%s: %w", dao.ID, err)
 		}
-	}(new)
+	}
 
 	return nil
 }
 
-func enrichWithSystemCategories(list, existed []string) []string {
-	for _, category := range systemCategories {
+// enrichWithSystemCategories keeps categories assigned by category rules, they are unknown for the source
+func enrichWithSystemCategories(list, existed, system []string) []string {
+	for _, category := range system {
 		if !slices.Contains(list, category) &&
 			slices.Contains(existed, category) {
 			list = append(list, category)
@@ -232,7 +334,7 @@ func enrichWithSystemCategories(list, existed []string) []string {
 	return list
 }
 
-func (s *Service) getFungibleId(strategies Strategies) (string, string) {
+func (s *Service) getFungibleId(ctx context.Context, strategies Strategies) (string, string) {
 	for _, strategy := range strategies {
 		if strategy.Name != "erc20-balance-of" && strategy.Name != "erc20-votes" && strategy.Name != "erc20-balance-of-delegation" && strategy.Name != "comp-like-votes" {
 			continue
@@ -241,27 +343,48 @@ func (s *Service) getFungibleId(strategies Strategies) (string, string) {
 		if adr == "" {
 			continue
 		}
-		l, err := s.zerionClient.GetFungibleList("", adr)
-		if err == nil && l != nil && len(l.List) == 1 {
-			data := l.List[0]
-			if data.Attributes.MarketData.Price != 0 {
-				return data.ID, data.Attributes.Symbol
-			}
+		token, err := s.market.FindByContract(ctx, strategy.Network, adr)
+		if err == nil {
+			return token.ID, token.Symbol
 		}
 	}
 
 	return "", ""
 }
 
+// tokenID returns the market data identifier of the dao token, the coingecko id of the space is used
+// if the token wasn't found by strategies and the coingecko provider is enabled
+func (s *Service) tokenID(dao Dao) string {
+	if dao.FungibleId != "" {
+		return dao.FungibleId
+	}
+
+	if dao.Coingecko != "" && s.providerEnabled(marketdata.CoinGecko) {
+		return marketdata.QualifiedID(marketdata.CoinGecko, dao.Coingecko)
+	}
+
+	return ""
+}
+
+// providerEnabled checks that the market data provider is configured, the single provider serves its own ids only
+func (s *Service) providerEnabled(name string) bool {
+	p, ok := s.market.(interface{ Enabled(name string) bool })
+
+	return ok && p.Enabled(name)
+}
+
 func compare(d1, d2 Dao) bool {
 	d1.CreatedAt = d2.CreatedAt
 	d1.UpdatedAt = d2.UpdatedAt
 	d1.ActivitySince = d2.ActivitySince
 	d1.PopularityIndex = d2.PopularityIndex
+	d1.ExternalPopularityIndex = d2.ExternalPopularityIndex
+	d1.LocalPopularityIndex = d2.LocalPopularityIndex
 	d1.FungibleId = d2.FungibleId
 	d1.TokenSymbol = d2.TokenSymbol
 	d1.VerificationStatus = d2.VerificationStatus
 	d1.VerificationComment = d2.VerificationComment
+	d1.Version = d2.Version
 
 	return reflect.DeepEqual(d1, d2)
 }
@@ -275,7 +398,10 @@ func (s *Service) GetByID(id uuid.UUID) (*Dao, error) {
 	return dao, nil
 }
 
+// GetDaoByOriginalID returns the dao by the source-qualified id, snapshot spaces are passed without the source
 func (s *Service) GetDaoByOriginalID(id string) (*Dao, error) {
+	id = normalizeOriginalID(id)
+
 	s.spacesLock.RLock()
 	data, ok := s.spacesByOriginalID[id]
 	s.spacesLock.RUnlock()
@@ -319,8 +445,39 @@ func (s *Service) GetIDByOriginalID(id string) (uuid.UUID, error) {
 	return val, nil
 }
 
-func (s *Service) GetByFilters(filters []Filter) (DaoList, error) {
-	list, err := s.repo.GetByFilters(filters, true)
+// LinkIdentity links the source-qualified identity to the dao, events of the identity are attributed to the dao
+func (s *Service) LinkIdentity(id uuid.UUID, originalID string) ([]DaoID, error) {
+	if _, err := s.repo.GetByID(id); err != nil {
+		return nil, fmt.Errorf("get dao by id: %w", err)
+	}
+
+	list, err := s.idProvider.Link(id, originalID)
+	if err != nil {
+		return nil, fmt.Errorf("link identity: %w", err)
+	}
+
+	s.daoMu.Lock()
+	for _, item := range list {
+		s.daoIds[item.OriginalID] = item.InternalID
+	}
+	s.daoMu.Unlock()
+
+	log.Info().Str("dao_id", id.String()).Str("original_id", originalID).Msg("dao identity linked")
+
+	return list, nil
+}
+
+func (s *Service) GetIdentities(id uuid.UUID) ([]DaoID, error) {
+	list, err := s.idProvider.GetIdentities(id)
+	if err != nil {
+		return nil, fmt.Errorf("get identities: %w", err)
+	}
+
+	return list, nil
+}
+
+func (s *Service) GetByFilters(filters []Filter, count bool) (DaoList, error) {
+	list, err := s.repo.GetByFilters(filters, count)
 	if err != nil {
 		return DaoList{}, fmt.Errorf("get by filters: %w", err)
 	}
@@ -328,6 +485,16 @@ func (s *Service) GetByFilters(filters []Filter) (DaoList, error) {
 	return list, nil
 }
 
+// GetHistory returns the page of dao changes, the fields filter is optional
+func (s *Service) GetHistory(id uuid.UUID, fields []string, keyset pagination.Keyset, limit int) ([]History, error) {
+	list, err := s.repo.GetHistory(id, fields, keyset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("get history: %w", err)
+	}
+
+	return list, nil
+}
+
 type topList struct {
 	List  []Dao
 	Total int64
@@ -337,17 +504,9 @@ func (s *Service) GetTopByCategories(_ context.Context, limit int) (map[string]t
 	return s.topDAOCache.GetTopList(uint(limit)), nil
 }
 
-func (s *Service) HandleActivitySince(_ context.Context, id uuid.UUID) (*Dao, error) {
-	dao, err := s.GetByID(id)
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		log.Warn().Str("dao_id", id.String()).Msg("dao is not ready yet")
-		return nil, nil
-	}
-	if err != nil {
-		return nil, fmt.Errorf("getting dao by id: %w", err)
-	}
-
-	pr, err := s.proposals.GetEarliestByDaoID(dao.ID)
+// HandleActivitySince actualizes the activity since field and publishes dao updated event if it was changed
+func (s *Service) HandleActivitySince(ctx context.Context, id uuid.UUID) (*Dao, error) {
+	pr, err := s.proposals.GetEarliestByDaoID(id)
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, nil
 	}
@@ -360,22 +519,49 @@ func (s *Service) HandleActivitySince(_ context.Context, id uuid.UUID) (*Dao, er
 		return nil, nil
 	}
 
-	if dao.ActivitySince != 0 && pr.Created > dao.ActivitySince {
-		return nil, nil
-	}
+	var updated *Dao
+	err = optimistic.Retry(ctx, "activity_since", func(ctx context.Context) error {
+		updated = nil
 
-	if dao.ActivitySince == 0 {
-		dao.ActivitySince = pr.Created
-	}
+		existed, err := s.repo.GetByID(id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Warn().Str("dao_id", id.String()).Msg("dao is not ready yet")
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("getting dao by id: %w", err)
+		}
 
-	if dao.ActivitySince > pr.Created {
+		if existed.ActivitySince != 0 && pr.Created >= existed.ActivitySince {
+			return nil
+		}
+
+		dao := *existed
 		dao.ActivitySince = pr.Created
+
+		err = s.repo.CallInTx(outbox.WithKey(ctx, dao.ID.String()), func(ctx context.Context) error {
+			if _, err := s.update(ctx, *existed, dao, HistorySourceActivitySince, s.repo.UpdateActivitySince); err != nil {
+				return err
+			}
+
+			return s.events.PublishJSON(ctx, coreevents.SubjectDaoUpdated, convertToCoreEvent(dao))
+		})
+		if err != nil {
+			return err
+		}
+
+		updated = &dao
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return dao, s.repo.Update(*dao)
+	return updated, nil
 }
 
-func (s *Service) UpdateFungibleId(_ context.Context, id uuid.UUID) {
+func (s *Service) UpdateFungibleId(ctx context.Context, id uuid.UUID) {
 	dao, err := s.GetByID(id)
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		log.Warn().Str("dao_id", id.String()).Msg("dao is not ready yet")
@@ -385,76 +571,58 @@ func (s *Service) UpdateFungibleId(_ context.Context, id uuid.UUID) {
 		return
 	}
 
-	if dao.FungibleId != "" || dao.VerificationStatus == "declined" {
+	s.processFungibleInfo(ctx, *dao)
+}
+
+// processFungibleInfo finds the fungible token by dao strategies and sends the dao to verification
+func (s *Service) processFungibleInfo(ctx context.Context, dao Dao) {
+	if dao.FungibleId != "" || dao.VerificationStatus == VerificationDeclined {
 		return
 	}
 
-	fi, ts := s.getFungibleId(dao.Strategies)
+	fi, ts := s.getFungibleId(ctx, dao.Strategies)
 	if fi == "" {
 		return
-
 	}
 
-	dao.VerificationStatus = "pending"
-	dao.FungibleId = fi
-	dao.TokenSymbol = ts
-	_ = s.repo.Update(*dao)
-	s.sendDaoToDiscord(*dao)
-}
-
-// todo: add transaction here to avoid concurrent update
-func (s *Service) processNewCategory(_ context.Context) error {
-	list, err := s.repo.GetByFilters([]Filter{
-		NotCategoryFilter{Category: newDaoCategoryName},
-		ActivitySinceRangeFilter{From: time.Now().Add(-90 * 24 * time.Hour)},
-		PageFilter{Limit: 300},
-	}, false)
-	if err != nil {
-		return fmt.Errorf("get by filters: %w", err)
-	}
+	var updated *Dao
+	err := optimistic.Retry(ctx, "fungible_info", func(ctx context.Context) error {
+		existed, err := s.repo.GetByID(dao.ID)
+		if err != nil {
+			return fmt.Errorf("get dao by id: %w", err)
+		}
 
-	for i := range list.Daos {
-		dao := list.Daos[i]
-		dao.Categories = append(dao.Categories, newDaoCategoryName)
+		if existed.FungibleId != "" || existed.VerificationStatus == VerificationDeclined {
+			return nil
+		}
 
-		if err = s.repo.Update(dao); err != nil {
-			return fmt.Errorf("update dao: %s: %w", dao.ID.String(), err)
+		dao := *existed
+		dao.VerificationStatus = VerificationPending
+		dao.FungibleId = fi
+		dao.TokenSymbol = ts
+		if _, err = s.update(ctx, *existed, dao, HistorySourceFungibleID, s.repo.UpdateFungibleInfo); err != nil {
+			return err
 		}
-	}
 
-	return nil
-}
+		updated = &dao
 
-// todo: add transaction here to avoid concurrent update
-func (s *Service) processOutdatedNewCategory(_ context.Context) error {
-	list, err := s.repo.GetByFilters([]Filter{
-		CategoryFilter{Category: newDaoCategoryName},
-		ActivitySinceRangeFilter{To: time.Now().Add(-91 * 24 * time.Hour)},
-	}, false)
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("get by filters: %w", err)
+		log.Error().Err(err).Str("dao_id", dao.ID.String()).Msg("update fungible info")
+		return
 	}
 
-	for i := range list.Daos {
-		dao := list.Daos[i]
-
-		dao.Categories = remove(dao.Categories, newDaoCategoryName)
-
-		if err = s.repo.Update(dao); err != nil {
-			return fmt.Errorf("update dao: %s: %w", dao.ID.String(), err)
-		}
+	if updated != nil {
+		s.notifyTokenFound(ctx, *updated)
 	}
-
-	return nil
 }
 
+// remove returns the copy of the list without r, the passed list is kept as is for the history of changes
 func remove(s []string, r string) []string {
-	for i, v := range s {
-		if v == r {
-			return append(s[:i], s[i+1:]...)
-		}
-	}
-	return s
+	return slices.DeleteFunc(slices.Clone(s), func(v string) bool {
+		return v == r
+	})
 }
 
 func (s *Service) ProcessUniqueVoters(_ context.Context, voters []UniqueVoter) error {
@@ -513,65 +681,6 @@ func (s *Service) ProcessDeletedProposal(_ context.Context, daoID uuid.UUID) err
 	return nil
 }
 
-func (s *Service) processPopularCategory(_ context.Context) error {
-	listCurrent, err := s.repo.GetByFilters([]Filter{
-		CategoryFilter{Category: popularDaoCategoryName},
-	}, false)
-
-	if err != nil {
-		return fmt.Errorf("get by filters: %w", err)
-	}
-
-	for i := range listCurrent.Daos {
-		dao := listCurrent.Daos[i]
-
-		dao.Categories = remove(dao.Categories, popularDaoCategoryName)
-
-		if err = s.repo.Update(dao); err != nil {
-			return fmt.Errorf("update dao: %s: %w", dao.ID.String(), err)
-		}
-	}
-
-	listNew, err := s.repo.GetByFilters([]Filter{
-		OrderByPopularityIndexFilter{},
-		PageFilter{Limit: 100},
-	}, false)
-	if err != nil {
-		return fmt.Errorf("get by filters: %w", err)
-	}
-
-	for i := range listNew.Daos {
-		dao := listNew.Daos[i]
-		dao.Categories = append(dao.Categories, popularDaoCategoryName)
-
-		if err = s.repo.Update(dao); err != nil {
-			return fmt.Errorf("update dao: %s: %w", dao.ID.String(), err)
-		}
-	}
-
-	return nil
-}
-
-func (s *Service) ProcessPopularityIndexUpdate(_ context.Context, id uuid.UUID, index float64) error {
-	existed, err := s.repo.GetByID(id)
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil
-	}
-	if err != nil {
-		return fmt.Errorf("handle: %w", err)
-	}
-
-	if existed != nil {
-		existed.PopularityIndex = index
-		err := s.repo.Update(*existed)
-		if err != nil {
-			return fmt.Errorf("update dao #%s: %w", id, err)
-		}
-	}
-
-	return nil
-}
-
 func (s *Service) processActiveVotes(_ context.Context) error {
 	err := s.repo.UpdateActiveVotesAll()
 	if err != nil {
@@ -612,17 +721,18 @@ func (s *Service) syncRecommendations(_ context.Context) error {
 	return nil
 }
 
-func (s *Service) GetTokenInfo(id uuid.UUID) (*TokenInfo, error) {
+func (s *Service) GetTokenInfo(ctx context.Context, id uuid.UUID) (*TokenInfo, error) {
 	dao, err := s.repo.GetByID(id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get dao: %w", err)
 	}
 
-	if dao.FungibleId == "" {
-		return nil, status.Error(codes.Internal, "can't receive the information from Zerion")
+	fid := s.tokenID(*dao)
+	if fid == "" {
+		return nil, status.Error(codes.Internal, "can't receive the token information")
 	}
 
-	data, err := s.zerionClient.GetFungibleData(dao.FungibleId)
+	token, err := s.market.GetToken(ctx, fid)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get token info: %w", err)
 	}
@@ -645,87 +755,33 @@ func (s *Service) GetTokenInfo(id uuid.UUID) (*TokenInfo, error) {
 		}
 	}
 
+	// chains of tokens without the fungible id aren't stored by the fungible chain worker
+	if len(chains) == 0 {
+		for _, chain := range token.Chains {
+			chains = append(chains, TokenChainInfo{
+				ChainID:  chain.ID,
+				Name:     chain.Name,
+				Decimals: chain.Decimals,
+				IconURL:  chain.IconURL,
+				Address:  chain.Address,
+			})
+		}
+	}
+
 	return &TokenInfo{
-		Name:                  data.Attributes.Name,
-		Symbol:                data.Attributes.Symbol,
-		TotalSupply:           data.Attributes.MarketData.TotalSupply,
-		CirculatingSupply:     data.Attributes.MarketData.CirculatingSupply,
-		MarketCap:             data.Attributes.MarketData.MarketCap,
-		FullyDilutedValuation: data.Attributes.MarketData.FullyDilutedValuation,
-		Price:                 data.Attributes.MarketData.Price,
-		FungibleID:            dao.FungibleId,
+		Name:                  token.Name,
+		Symbol:                token.Symbol,
+		TotalSupply:           token.Market.TotalSupply,
+		CirculatingSupply:     token.Market.CirculatingSupply,
+		MarketCap:             token.Market.MarketCap,
+		FullyDilutedValuation: token.Market.FullyDilutedValuation,
+		Price:                 token.Market.Price,
+		FungibleID:            fid,
 		Chains:                chains,
 	}, nil
 }
 
-func (s *Service) GetTokenPrice(id uuid.UUID, created int) float64 {
-	data, err := s.GetTokenChart(id, "hour")
-	if err != nil || data == nil {
-		return 0
-	}
-
-	points := data.ChartAttributes.Points
-	if len(points) == 0 {
-		return 0
-	}
-
-	createdTime := time.Unix(int64(created), 0)
-
-	idx := sort.Search(len(points), func(i int) bool {
-		return points[i].Time.After(createdTime)
-	})
-
-	if idx == 0 {
-		// All points are after created time
-		return 0
-	}
-
-	return points[idx-1].Price
-}
-
-func (s *Service) GetTokenChart(id uuid.UUID, period string) (*zerion.ChartData, error) {
-	dao, err := s.repo.GetByID(id)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get dao: %w", err)
-	}
-
-	if dao.FungibleId == "" {
-		return nil, status.Error(codes.Internal, "can't receive the information from Zerion")
-	}
-
-	data, err := s.zerionClient.GetFungibleChart(dao.FungibleId, period)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get token chart: %w", err)
-	}
-
-	return data, nil
-}
-
-func (s *Service) PopulateTokenPrices(ctx context.Context, id uuid.UUID) (bool, error) {
-	data, err := s.GetTokenChart(id, "month")
-	if err != nil || data == nil {
-		return false, fmt.Errorf("failed to get token prices: %w", err)
-	}
-	if err := s.events.PublishJSON(ctx, coreevents.DaoTokenPriceUpdated, convertToTokenPricesPayload(data.ChartAttributes.Points, id)); err != nil {
-		return false, fmt.Errorf("publish token prices event: %w", err)
-	}
-	return true, nil
-}
-
-func convertToTokenPricesPayload(list []zerion.Point, daoId uuid.UUID) coreevents.TokenPricesPayload {
-	res := make(coreevents.TokenPricesPayload, 0, len(list))
-	for _, point := range list {
-		res = append(res, coreevents.TokenPricePayload{
-			DaoID: daoId,
-			Time:  point.Time,
-			Price: point.Price,
-		})
-	}
-
-	return res
-}
-
-func (s *Service) UpdateFungibleIds(_ context.Context, category string) (bool, error) {
+func (s *Service) UpdateFungibleIds(ctx context.Context, category string) (bool, error) {
 	filters := []Filter{
 		FungibleIdEmptyFilter{},
 	}
@@ -739,40 +795,33 @@ func (s *Service) UpdateFungibleIds(_ context.Context, category string) (bool, e
 		return false, fmt.Errorf("get daos: %w", err)
 	}
 	for _, dao := range daos.Daos {
-		if dao.VerificationStatus != "declined" {
-			fi, ts := s.getFungibleId(dao.Strategies)
-			if fi != "" {
-				dao.VerificationStatus = "pending"
-				dao.FungibleId = fi
-				dao.TokenSymbol = ts
-				_ = s.repo.Update(dao)
-				s.sendDaoToDiscord(dao)
-			}
-		}
+		s.processFungibleInfo(ctx, dao)
 	}
 
 	return true, nil
 }
 
-func (s *Service) sendDaoToDiscord(dao Dao) {
+// notifyTokenFound sends the dao with the found token to moderators for the verification
+func (s *Service) notifyTokenFound(ctx context.Context, dao Dao) {
+	data := notifier.TokenFound{
+		DaoAlias:    dao.OriginalID,
+		CreatedAt:   dao.CreatedAt,
+		FungibleID:  dao.FungibleId,
+		TokenSymbol: dao.TokenSymbol,
+	}
+
 	pr, err := s.proposals.GetLatestByDaoID(dao.ID)
-	vp := float32(0)
 	if err == nil && pr != nil {
-		vp = pr.ScoresTotal
-	}
-	price := float64(0)
-	fdp := float64(0)
-	data, err := s.zerionClient.GetFungibleData(dao.FungibleId)
-	if err == nil && data != nil {
-		if data.Attributes.MarketData.Price != 0 {
-			price = data.Attributes.MarketData.Price
-			fdp = data.Attributes.MarketData.FullyDilutedValuation
-		}
+		data.VotingPower = pr.ScoresTotal
 	}
-	pEN := message2.NewPrinter(language.English)
-	message := pEN.Sprintf("DAO: https://gl.app/dao/%s\nCreated: %s\n FungibleId: %s\nToken %s: Price - %v, FDV - %v\nLast Proposal VP: %v",
-		dao.OriginalID, dao.CreatedAt.Format(time.DateOnly), dao.FungibleId, dao.TokenSymbol, price, fdp, vp)
-	if err := s.discordSender.SendMessage(message); err != nil {
-		log.Warn().Err(err).Msgf("can't send discord message for dao: %s", dao.ID.String())
+
+	token, err := s.market.GetToken(ctx, dao.FungibleId)
+	if err == nil && token != nil {
+		data.Price = token.Market.Price
+		data.FDV = token.Market.FullyDilutedValuation
+	}
+
+	if err := s.notifier.Notify(ctx, notifier.KindTokenFound, data); err != nil {
+		log.Warn().Err(err).Str("dao_id", dao.ID.String()).Msg("notify token found")
 	}
 }
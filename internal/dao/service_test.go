@@ -10,6 +10,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/optimistic"
 )
 
 var (
@@ -52,6 +54,40 @@ func TestUnitCompare(t *testing.T) {
 	}
 }
 
+func TestUnitDiff(t *testing.T) {
+	for name, tc := range map[string]struct {
+		old      Dao
+		new      Dao
+		expected Changes
+	}{
+		"equal": {
+			old: Dao{ID: id1, Strategies: Strategies{{Name: "erc20-votes"}}},
+			new: Dao{ID: id1, Strategies: Strategies{{Name: "erc20-votes"}}},
+		},
+		"nil and empty lists are equal": {
+			old: Dao{ID: id1},
+			new: Dao{ID: id1, Categories: Categories{}, Treasures: Treasuries{}},
+		},
+		"ignored fields": {
+			old: Dao{ID: id1, CreatedAt: time.Now(), PopularityIndex: 1, FollowersCount: 2, ActiveProposalsIDs: []string{"a"}},
+			new: Dao{ID: id1, UpdatedAt: time.Now(), PopularityIndex: 2, VotersCount: 3},
+		},
+		"changed fields": {
+			old: Dao{ID: id1, Name: "name", Categories: Categories{"defi"}, Voting: Voting{Quorum: 1, Type: "basic"}},
+			new: Dao{ID: id1, Name: "name", Categories: Categories{"defi", "new_daos"}, Voting: Voting{Quorum: 2, Type: "basic"}, FungibleId: "id"},
+			expected: Changes{
+				{Field: "voting.quorum", Old: []byte(`1`), New: []byte(`2`)},
+				{Field: "categories", Old: []byte(`["defi"]`), New: []byte(`["defi","new_daos"]`)},
+				{Field: "fungible_id", Old: []byte(`""`), New: []byte(`"id"`)},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, diff(tc.old, tc.new))
+		})
+	}
+}
+
 func TestUnitHandleDao(t *testing.T) {
 	idp := func(ctrl *gomock.Controller) DaoIDProvider {
 		m := NewMockDaoIDProvider(ctrl)
@@ -59,6 +95,12 @@ func TestUnitHandleDao(t *testing.T) {
 		return m
 	}
 
+	inTx := func(m *MockDataProvider) {
+		m.EXPECT().CallInTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, cb func(context.Context) error) error {
+			return cb(ctx)
+		})
+	}
+
 	for name, tc := range map[string]struct {
 		dp       func(ctrl *gomock.Controller) DataProvider
 		p        func(ctrl *gomock.Controller) Publisher
@@ -68,8 +110,9 @@ func TestUnitHandleDao(t *testing.T) {
 		"correct creating": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(nil, gorm.ErrRecordNotFound)
-				m.EXPECT().Create(gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil)
 				m.EXPECT().UpdateProposalCnt(gomock.Any()).Times(1).Return(nil)
 				m.EXPECT().UpdateActiveVotes(gomock.Any()).Times(1).Return(nil)
 				return m
@@ -85,8 +128,71 @@ func TestUnitHandleDao(t *testing.T) {
 		"correct updating": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(&Dao{ID: id1, Name: "updated"}, nil)
-				m.EXPECT().Update(gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().CreateHistory(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ context.Context, h History) error {
+					require.Equal(t, History{
+						DaoID:  id1,
+						Source: "aggregator.dao.updated",
+						Changes: Changes{
+							{Field: "name", Old: []byte(`"updated"`), New: []byte(`"name"`)},
+						},
+					}, h)
+					return nil
+				})
+				m.EXPECT().UpdateProposalCnt(gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().UpdateActiveVotes(gomock.Any()).Times(1).Return(nil)
+				return m
+			},
+			p: func(ctrl *gomock.Controller) Publisher {
+				m := NewMockPublisher(ctrl)
+				m.EXPECT().PublishJSON(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(nil)
+				return m
+			},
+			event:    Dao{ID: id1, Name: "name"},
+			expected: nil,
+		},
+		"publish settings changes": {
+			dp: func(ctrl *gomock.Controller) DataProvider {
+				m := NewMockDataProvider(ctrl)
+				inTx(m)
+				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(&Dao{ID: id1, Voting: Voting{Quorum: 1}}, nil)
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().CreateHistory(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().UpdateProposalCnt(gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().UpdateActiveVotes(gomock.Any()).Times(1).Return(nil)
+				return m
+			},
+			p: func(ctrl *gomock.Controller) Publisher {
+				m := NewMockPublisher(ctrl)
+				gomock.InOrder(
+					m.EXPECT().PublishJSON(gomock.Any(), "core.dao.updated", gomock.Any()).Times(1).Return(nil),
+					m.EXPECT().PublishJSON(gomock.Any(), SubjectDaoVotingChanged, SettingsChangedPayload{
+						DaoID:   id1,
+						Changes: Changes{{Field: "voting.quorum", Old: []byte(`1`), New: []byte(`2`)}},
+					}).Times(1).Return(nil),
+					m.EXPECT().PublishJSON(gomock.Any(), "core.dao.check_activity_since", gomock.Any()).Times(1).Return(nil),
+				)
+				return m
+			},
+			event:    Dao{ID: id1, Voting: Voting{Quorum: 2}},
+			expected: nil,
+		},
+		"retry on version conflict": {
+			dp: func(ctrl *gomock.Controller) DataProvider {
+				m := NewMockDataProvider(ctrl)
+				inTx(m)
+				gomock.InOrder(
+					m.EXPECT().GetByID(gomock.Any()).Times(1).Return(&Dao{ID: id1, Name: "updated", Version: 1}, nil),
+					m.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(optimistic.ErrConflict),
+					m.EXPECT().GetByID(gomock.Any()).Times(1).Return(&Dao{ID: id1, Name: "updated", Version: 2}, nil),
+					m.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ context.Context, dao Dao) error {
+						require.Equal(t, 2, dao.Version)
+						return nil
+					}),
+				)
+				m.EXPECT().CreateHistory(gomock.Any(), gomock.Any()).Times(1).Return(nil)
 				m.EXPECT().UpdateProposalCnt(gomock.Any()).Times(1).Return(nil)
 				m.EXPECT().UpdateActiveVotes(gomock.Any()).Times(1).Return(nil)
 				return m
@@ -102,8 +208,9 @@ func TestUnitHandleDao(t *testing.T) {
 		"do not update for equal objects": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(&Dao{ID: id1}, nil)
-				m.EXPECT().Update(gomock.Any()).Times(0).Return(nil)
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0).Return(nil)
 				return m
 			},
 			p: func(ctrl *gomock.Controller) Publisher {
@@ -115,6 +222,7 @@ func TestUnitHandleDao(t *testing.T) {
 		"raise err on problems with reading from DB": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(nil, errors.New("unexpected error"))
 				return m
 			},
@@ -127,8 +235,9 @@ func TestUnitHandleDao(t *testing.T) {
 		"raise err on problems with creating in DB": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(nil, gorm.ErrRecordNotFound)
-				m.EXPECT().Create(gomock.Any()).Times(1).Return(errors.New("unexpected error"))
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("unexpected error"))
 				return m
 			},
 			p: func(ctrl *gomock.Controller) Publisher {
@@ -137,28 +246,30 @@ func TestUnitHandleDao(t *testing.T) {
 			event:    Dao{ID: id1},
 			expected: errors.New("unexpected error"),
 		},
-		"allow do not send event after creating": {
+		"raise err on problems with storing event after creating": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(nil, gorm.ErrRecordNotFound)
-				m.EXPECT().Create(gomock.Any()).Times(1).Return(nil)
-				m.EXPECT().UpdateProposalCnt(gomock.Any()).Times(1).Return(nil)
-				m.EXPECT().UpdateActiveVotes(gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().UpdateProposalCnt(gomock.Any()).Times(0)
+				m.EXPECT().UpdateActiveVotes(gomock.Any()).Times(0)
 				return m
 			},
 			p: func(ctrl *gomock.Controller) Publisher {
 				m := NewMockPublisher(ctrl)
-				m.EXPECT().PublishJSON(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(errors.New("unexpected error"))
+				m.EXPECT().PublishJSON(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(errors.New("unexpected error"))
 				return m
 			},
 			event:    Dao{ID: id1},
-			expected: nil,
+			expected: errors.New("unexpected error"),
 		},
 		"raise err on problems with updating in DB": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(&Dao{ID: id1, Name: "name"}, nil)
-				m.EXPECT().Update(gomock.Any()).Times(1).Return(errors.New("unexpected error"))
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("unexpected error"))
 				return m
 			},
 			p: func(ctrl *gomock.Controller) Publisher {
@@ -167,22 +278,40 @@ func TestUnitHandleDao(t *testing.T) {
 			event:    Dao{ID: id1},
 			expected: errors.New("unexpected error"),
 		},
-		"allow do not send event after updating": {
+		"raise err on problems with storing history": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(&Dao{ID: id1, Name: "name"}, nil)
-				m.EXPECT().Update(gomock.Any()).Times(1).Return(nil)
-				m.EXPECT().UpdateProposalCnt(gomock.Any()).Times(1).Return(nil)
-				m.EXPECT().UpdateActiveVotes(gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().CreateHistory(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("unexpected error"))
+				m.EXPECT().UpdateProposalCnt(gomock.Any()).Times(0)
+				return m
+			},
+			p: func(ctrl *gomock.Controller) Publisher {
+				return NewMockPublisher(ctrl)
+			},
+			event:    Dao{ID: id1},
+			expected: errors.New("unexpected error"),
+		},
+		"raise err on problems with storing event after updating": {
+			dp: func(ctrl *gomock.Controller) DataProvider {
+				m := NewMockDataProvider(ctrl)
+				inTx(m)
+				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(&Dao{ID: id1, Name: "name"}, nil)
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().CreateHistory(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().UpdateProposalCnt(gomock.Any()).Times(0)
+				m.EXPECT().UpdateActiveVotes(gomock.Any()).Times(0)
 				return m
 			},
 			p: func(ctrl *gomock.Controller) Publisher {
 				m := NewMockPublisher(ctrl)
-				m.EXPECT().PublishJSON(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(errors.New("unexpected error"))
+				m.EXPECT().PublishJSON(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(errors.New("unexpected error"))
 				return m
 			},
 			event:    Dao{ID: id1},
-			expected: nil,
+			expected: errors.New("unexpected error"),
 		},
 	} {
 		t.Run(name, func(t *testing.T) {
@@ -191,10 +320,10 @@ func TestUnitHandleDao(t *testing.T) {
 				<-time.After(10 * time.Millisecond)
 				ctrl.Finish()
 			}()
-			s, err := NewService(tc.dp(ctrl), nil, idp(ctrl), tc.p(ctrl), nil, nil, nil, nil, nil)
+			s, err := NewService(tc.dp(ctrl), nil, idp(ctrl), tc.p(ctrl), nil, nil, nil, nil, nil, nil)
 			require.Nil(t, err)
 
-			err = s.HandleDao(context.Background(), tc.event)
+			err = s.HandleDao(context.Background(), tc.event, "aggregator.dao.updated")
 			if tc.expected == nil {
 				require.Nil(t, err)
 				return
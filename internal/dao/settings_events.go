@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Subjects of dao settings changes, payloads contain only the changed fields
+const (
+	SubjectDaoVotingChanged       = "core.dao.voting.changed"
+	SubjectDaoStrategiesChanged   = "core.dao.strategies.changed"
+	SubjectDaoTreasuriesChanged   = "core.dao.treasuries.changed"
+	SubjectDaoCategoriesChanged   = "core.dao.categories.changed"
+	SubjectDaoVerificationChanged = "core.dao.verification.changed"
+)
+
+// settingsSubjects maps top level fields to subjects, the order defines the order of published events
+var settingsSubjects = []struct {
+	field   string
+	subject string
+}{
+	{field: "voting", subject: SubjectDaoVotingChanged},
+	{field: "strategies", subject: SubjectDaoStrategiesChanged},
+	{field: "treasures", subject: SubjectDaoTreasuriesChanged},
+	{field: "categories", subject: SubjectDaoCategoriesChanged},
+	{field: "verified", subject: SubjectDaoVerificationChanged},
+}
+
+type SettingsChangedPayload struct {
+	DaoID   uuid.UUID `json:"dao_id"`
+	Alias   string    `json:"alias"`
+	Changes Changes   `json:"changes"`
+}
+
+type settingsChange struct {
+	subject string
+	changes Changes
+}
+
+// classifySettings groups changes of dao settings by subjects, other changes are skipped
+func classifySettings(changes Changes) []settingsChange {
+	var result []settingsChange
+	for _, s := range settingsSubjects {
+		var list Changes
+		for _, change := range changes {
+			if change.Field == s.field || strings.HasPrefix(change.Field, s.field+".") {
+				list = append(list, change)
+			}
+		}
+
+		if len(list) != 0 {
+			result = append(result, settingsChange{subject: s.subject, changes: list})
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,39 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitClassifySettings(t *testing.T) {
+	for name, tc := range map[string]struct {
+		changes  Changes
+		expected []settingsChange
+	}{
+		"no settings changes": {
+			changes: Changes{{Field: "name"}, {Field: "about"}},
+		},
+		"grouped by subject": {
+			changes: Changes{
+				{Field: "name"},
+				{Field: "verified"},
+				{Field: "voting.quorum"},
+				{Field: "strategies"},
+				{Field: "voting.period"},
+			},
+			expected: []settingsChange{
+				{subject: SubjectDaoVotingChanged, changes: Changes{{Field: "voting.quorum"}, {Field: "voting.period"}}},
+				{subject: SubjectDaoStrategiesChanged, changes: Changes{{Field: "strategies"}}},
+				{subject: SubjectDaoVerificationChanged, changes: Changes{{Field: "verified"}}},
+			},
+		},
+		"prefix is matched by the whole field name": {
+			changes: Changes{{Field: "voting_power"}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, classifySettings(tc.changes))
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Parameters of the similarity calculation
+const (
+	// similarityMinVoters skips small daos, their overlaps are too noisy
+	similarityMinVoters = 50
+	// similarityMinSharedVoters is the min number of common voters of similar daos
+	similarityMinSharedVoters = 5
+	// similarityMaxVoterDaos skips voters of too many daos like farmers, they link unrelated daos
+	similarityMaxVoterDaos = 100
+	// similarityTopN is the number of stored neighbours of every dao
+	similarityTopN = 20
+	// similarityChunkSize is the number of daos recalculated in one transaction
+	similarityChunkSize = 50
+)
+
+// Similarity is the neighbour of the dao, the score is the Jaccard index of voter sets
+type Similarity struct {
+	DaoID        uuid.UUID `gorm:"primary_key"`
+	SimilarDaoID uuid.UUID `gorm:"primary_key"`
+	Score        float64
+	SharedVoters int
+	UpdatedAt    time.Time
+}
+
+func (Similarity) TableName() string {
+	return "dao_similarities"
+}
+
+type SimilarityParams struct {
+	MinVoters       int
+	MinSharedVoters int
+	MaxVoterDaos    int
+	TopN            int
+}
+
+// SimilarDao is the similar dao with the score of the similarity
+type SimilarDao struct {
+	Dao          Dao
+	Score        float64
+	SharedVoters int
+}
+
+type SimilarityDataProvider interface {
+	// RecalculateChunk replaces similarities of the daos and returns the number of stored ones
+	RecalculateChunk(ctx context.Context, daoIDs []uuid.UUID, params SimilarityParams, updatedAt time.Time) (int64, error)
+	DeleteOutdated(ctx context.Context, before time.Time) error
+	GetLastUpdate(ctx context.Context) (time.Time, error)
+	GetSimilar(daoID uuid.UUID, limit int) ([]Similarity, error)
+}
+
+type SimilarityService struct {
+	repo SimilarityDataProvider
+	daos DataProvider
+}
+
+func NewSimilarityService(r SimilarityDataProvider, daos DataProvider) *SimilarityService {
+	return &SimilarityService{
+		repo: r,
+		daos: daos,
+	}
+}
+
+// Recalculate replaces similarities of daos with enough voters chunk by chunk and returns the number of stored ones.
+// Similarities of daos which were not recalculated are removed once all chunks are stored.
+func (s *SimilarityService) Recalculate(ctx context.Context) (int64, error) {
+	start := time.Now().Truncate(time.Second)
+	ids, err := s.daos.GetIDsByFilters(ctx, []Filter{MinVotersCountFilter{Count: similarityMinVoters}})
+	if err != nil {
+		return 0, fmt.Errorf("get daos: %w", err)
+	}
+
+	params := SimilarityParams{
+		MinVoters:       similarityMinVoters,
+		MinSharedVoters: similarityMinSharedVoters,
+		MaxVoterDaos:    similarityMaxVoterDaos,
+		TopN:            similarityTopN,
+	}
+
+	var cnt int64
+	for chunk := range slices.Chunk(ids, similarityChunkSize) {
+		if err = ctx.Err(); err != nil {
+			return cnt, err
+		}
+
+		stored, err := s.repo.RecalculateChunk(ctx, chunk, params, start)
+		if err != nil {
+			return cnt, fmt.Errorf("recalculate similarities: %w", err)
+		}
+
+		cnt += stored
+	}
+
+	if err = s.repo.DeleteOutdated(ctx, start); err != nil {
+		return cnt, fmt.Errorf("delete outdated similarities: %w", err)
+	}
+
+	return cnt, nil
+}
+
+// LastCalculation returns the time of the latest recalculation, zero time is returned if there is no one
+func (s *SimilarityService) LastCalculation(ctx context.Context) (time.Time, error) {
+	last, err := s.repo.GetLastUpdate(ctx)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get last update: %w", err)
+	}
+
+	return last, nil
+}
+
+// GetSimilar returns the most similar daos first, daos which are removed after the calculation are skipped
+func (s *SimilarityService) GetSimilar(id uuid.UUID, limit int) ([]SimilarDao, error) {
+	list, err := s.repo.GetSimilar(id, limit)
+	if err != nil {
+		return nil, fmt.Errorf("get similar: %w", err)
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(list))
+	for i, item := range list {
+		ids[i] = item.SimilarDaoID.String()
+	}
+
+	daos, err := s.daos.GetByFilters([]Filter{DaoIDsFilter{DaoIDs: ids}}, false)
+	if err != nil {
+		return nil, fmt.Errorf("get daos: %w", err)
+	}
+
+	byID := make(map[uuid.UUID]Dao, len(daos.Daos))
+	for _, dao := range daos.Daos {
+		byID[dao.ID] = dao
+	}
+
+	res := make([]SimilarDao, 0, len(list))
+	for _, item := range list {
+		dao, ok := byID[item.SimilarDaoID]
+		if !ok {
+			continue
+		}
+
+		res = append(res, SimilarDao{
+			Dao:          dao,
+			Score:        item.Score,
+			SharedVoters: item.SharedVoters,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,136 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SimilarityRepo struct {
+	db *gorm.DB
+}
+
+func NewSimilarityRepo(db *gorm.DB) *SimilarityRepo {
+	return &SimilarityRepo{db: db}
+}
+
+// RecalculateChunk calculates the Jaccard index of voter sets of daos from the chunk and all daos which share voters
+// with them. Only top neighbours of every dao are kept, previous neighbours of the chunk are replaced in the transaction.
+func (r *SimilarityRepo) RecalculateChunk(ctx context.Context, daoIDs []uuid.UUID, params SimilarityParams, updatedAt time.Time) (int64, error) {
+	var (
+		dummy = Similarity{}
+		_     = dummy.DaoID
+		_     = dummy.SimilarDaoID
+		_     = dummy.Score
+		_     = dummy.SharedVoters
+		_     = dummy.UpdatedAt
+		voter = UniqueVoter{}
+		_     = voter.DaoID
+		_     = voter.Voter
+		dao   = Dao{}
+		_     = dao.VotersCount
+	)
+
+	var cnt int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`delete from dao_similarities where dao_id in ?`, daoIDs).Error; err != nil {
+			return fmt.Errorf("delete similarities: %w", err)
+		}
+
+		// voters count might be stale, so the union size is never less than the number of shared voters
+		res := tx.Exec(`
+insert into dao_similarities (dao_id, similar_dao_id, score, shared_voters, updated_at)
+with sizes as (select id dao_id, voters_count voters
+               from daos
+               where voters_count >= ?),
+     members as (select v.dao_id, v.voter
+                 from dao_voter v
+                          inner join sizes s on s.dao_id = v.dao_id
+                 where v.voter in (select dv.voter
+                                   from dao_voter dv
+                                            inner join sizes ds on ds.dao_id = dv.dao_id
+                                   where dv.voter in (select voter from dao_voter where dao_id in ?)
+                                   group by dv.voter
+                                   having count(*) between 2 and ?)),
+     shared as (select a.dao_id, b.dao_id similar_dao_id, count(*) shared_voters
+                from members a
+                         inner join members b on b.voter = a.voter and b.dao_id <> a.dao_id
+                where a.dao_id in ?
+                group by a.dao_id, b.dao_id
+                having count(*) >= ?),
+     ranked as (select sh.dao_id,
+                       sh.similar_dao_id,
+                       sh.shared_voters::double precision /
+                       greatest(sa.voters + sb.voters - sh.shared_voters, sh.shared_voters) score,
+                       sh.shared_voters
+                from shared sh
+                         inner join sizes sa on sa.dao_id = sh.dao_id
+                         inner join sizes sb on sb.dao_id = sh.similar_dao_id)
+select dao_id, similar_dao_id, score, shared_voters, ?
+from (select *, row_number() over (partition by dao_id order by score desc, similar_dao_id) rn
+      from ranked) data
+where rn <= ?
+`, params.MinVoters, daoIDs, params.MaxVoterDaos, daoIDs, params.MinSharedVoters, updatedAt, params.TopN)
+		if res.Error != nil {
+			return fmt.Errorf("insert similarities: %w", res.Error)
+		}
+
+		cnt = res.RowsAffected
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return cnt, nil
+}
+
+// DeleteOutdated removes similarities which were not recalculated since the time
+func (r *SimilarityRepo) DeleteOutdated(ctx context.Context, before time.Time) error {
+	var (
+		dummy = Similarity{}
+		_     = dummy.UpdatedAt
+	)
+
+	if err := r.db.WithContext(ctx).Exec(`delete from dao_similarities where updated_at < ?`, before).Error; err != nil {
+		return fmt.Errorf("delete outdated similarities: %w", err)
+	}
+
+	return nil
+}
+
+// GetLastUpdate returns the time of the latest calculation, zero time is returned if nothing is calculated yet
+func (r *SimilarityRepo) GetLastUpdate(ctx context.Context) (time.Time, error) {
+	var (
+		dummy = Similarity{}
+		_     = dummy.UpdatedAt
+	)
+
+	var last sql.NullTime
+	if err := r.db.WithContext(ctx).Raw(`select max(updated_at) from dao_similarities`).Row().Scan(&last); err != nil {
+		return time.Time{}, fmt.Errorf("get last similarity update: %w", err)
+	}
+
+	return last.Time, nil
+}
+
+// GetSimilar returns neighbours of the dao ordered by the score
+func (r *SimilarityRepo) GetSimilar(daoID uuid.UUID, limit int) ([]Similarity, error) {
+	var list []Similarity
+	err := r.db.
+		Where("dao_id = ?", daoID).
+		Order("score desc, similar_dao_id").
+		Limit(limit).
+		Find(&list).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("get similar daos #%s: %w", daoID, err)
+	}
+
+	return list, nil
+}
@@ -0,0 +1,124 @@
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type similarityStub struct {
+	stored   []Similarity
+	chunks   [][]uuid.UUID
+	outdated time.Time
+}
+
+func (s *similarityStub) RecalculateChunk(_ context.Context, daoIDs []uuid.UUID, _ SimilarityParams, _ time.Time) (int64, error) {
+	s.chunks = append(s.chunks, daoIDs)
+
+	return int64(len(daoIDs)), nil
+}
+
+func (s *similarityStub) DeleteOutdated(_ context.Context, before time.Time) error {
+	s.outdated = before
+
+	return nil
+}
+
+func (s *similarityStub) GetLastUpdate(_ context.Context) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+func (s *similarityStub) GetSimilar(_ uuid.UUID, limit int) ([]Similarity, error) {
+	return s.stored[:min(limit, len(s.stored))], nil
+}
+
+func TestUnitRecalculateSimilarities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ids := make([]uuid.UUID, similarityChunkSize+1)
+	for i := range ids {
+		ids[i] = uuid.New()
+	}
+
+	dp := NewMockDataProvider(ctrl)
+	dp.EXPECT().GetIDsByFilters(gomock.Any(), []Filter{MinVotersCountFilter{Count: similarityMinVoters}}).Return(ids, nil)
+
+	repo := &similarityStub{}
+	cnt, err := NewSimilarityService(repo, dp).Recalculate(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, len(ids), cnt)
+	require.Equal(t, [][]uuid.UUID{ids[:similarityChunkSize], ids[similarityChunkSize:]}, repo.chunks)
+	require.False(t, repo.outdated.IsZero())
+}
+
+func TestUnitGetSimilar(t *testing.T) {
+	var (
+		id3     = uuid.New()
+		removed = uuid.New()
+	)
+
+	for name, tc := range map[string]struct {
+		stored   []Similarity
+		limit    int
+		daos     []Dao
+		expected []SimilarDao
+	}{
+		"no similar daos": {
+			limit: 10,
+		},
+		"ordered by the score": {
+			stored: []Similarity{
+				{DaoID: id1, SimilarDaoID: id2, Score: 0.5, SharedVoters: 100},
+				{DaoID: id1, SimilarDaoID: id3, Score: 0.1, SharedVoters: 20},
+			},
+			limit: 10,
+			// daos are returned in the popularity order
+			daos: []Dao{{ID: id3}, {ID: id2}},
+			expected: []SimilarDao{
+				{Dao: Dao{ID: id2}, Score: 0.5, SharedVoters: 100},
+				{Dao: Dao{ID: id3}, Score: 0.1, SharedVoters: 20},
+			},
+		},
+		"skip removed daos": {
+			stored: []Similarity{
+				{DaoID: id1, SimilarDaoID: removed, Score: 0.5, SharedVoters: 100},
+				{DaoID: id1, SimilarDaoID: id3, Score: 0.1, SharedVoters: 20},
+			},
+			limit:    10,
+			daos:     []Dao{{ID: id3}},
+			expected: []SimilarDao{{Dao: Dao{ID: id3}, Score: 0.1, SharedVoters: 20}},
+		},
+		"limited": {
+			stored: []Similarity{
+				{DaoID: id1, SimilarDaoID: id2, Score: 0.5, SharedVoters: 100},
+				{DaoID: id1, SimilarDaoID: id3, Score: 0.1, SharedVoters: 20},
+			},
+			limit:    1,
+			daos:     []Dao{{ID: id2}},
+			expected: []SimilarDao{{Dao: Dao{ID: id2}, Score: 0.5, SharedVoters: 100}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dp := NewMockDataProvider(ctrl)
+			if len(tc.stored) != 0 {
+				dp.EXPECT().GetByFilters(gomock.Any(), false).DoAndReturn(func(filters []Filter, _ bool) (DaoList, error) {
+					require.Len(t, filters[0].(DaoIDsFilter).DaoIDs, min(tc.limit, len(tc.stored)))
+
+					return DaoList{Daos: tc.daos}, nil
+				})
+			}
+
+			list, err := NewSimilarityService(&similarityStub{stored: tc.stored}, dp).GetSimilar(id1, tc.limit)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, list)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	similarityCalculationDelay = 24 * time.Hour
+)
+
+// SimilarityWorker recalculates similar daos by voter overlaps
+type SimilarityWorker struct {
+	similarity *SimilarityService
+}
+
+func NewSimilarityWorker(ss *SimilarityService) *SimilarityWorker {
+	return &SimilarityWorker{
+		similarity: ss,
+	}
+}
+
+// Process recalculates similarities once per the delay, the schedule is kept between restarts by the time
+// of the latest calculation
+func (w *SimilarityWorker) Process(ctx context.Context) error {
+	delay := w.untilNextCalculation(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+
+		start := time.Now()
+		cnt, err := w.similarity.Recalculate(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("recalculate dao similarities")
+		} else {
+			log.Info().Int64("similarities", cnt).Dur("duration", time.Since(start)).Msg("dao similarities recalculated")
+		}
+
+		delay = similarityCalculationDelay
+	}
+}
+
+func (w *SimilarityWorker) untilNextCalculation(ctx context.Context) time.Duration {
+	last, err := w.similarity.LastCalculation(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("get last dao similarities calculation")
+
+		return 0
+	}
+
+	return max(time.Until(last.Add(similarityCalculationDelay)), 0)
+}
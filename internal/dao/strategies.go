@@ -0,0 +1,93 @@
+package dao
+
+import (
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+const multichainStrategyName = "multichain"
+
+// DaoStrategy is the row of the normalized strategy index, multichain sub-strategies are flattened.
+// It's rebuilt from Dao.Strategies on every save of the dao from the source.
+type DaoStrategy struct {
+	DaoID uuid.UUID `gorm:"primary_key"`
+	// Idx is the position in the flattened list of strategies
+	Idx      int `gorm:"primary_key"`
+	Name     string
+	Network  string
+	Address  string
+	Symbol   string
+	Decimals int
+	// Multichain is set for sub-strategies of the multichain strategy
+	Multichain bool
+}
+
+func (DaoStrategy) TableName() string {
+	return "dao_strategies"
+}
+
+// flattenStrategies returns indexed strategies of the dao, networks are inherited from the parent strategy and the dao
+func flattenStrategies(dao Dao) []DaoStrategy {
+	list := make([]DaoStrategy, 0, len(dao.Strategies))
+	for _, strategy := range dao.Strategies {
+		network := firstNonEmpty(strategy.Network, dao.Network)
+		if strategy.Name != multichainStrategyName {
+			list = append(list, newDaoStrategy(dao.ID, strategy.Name, network, strategy.Params, false))
+
+			continue
+		}
+
+		subs, _ := strategy.Params["strategies"].([]any)
+		for _, item := range subs {
+			sub, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			name, _ := sub["name"].(string)
+			subNetwork, _ := sub["network"].(string)
+			params, _ := sub["params"].(map[string]any)
+			list = append(list, newDaoStrategy(dao.ID, name, firstNonEmpty(subNetwork, network), params, true))
+		}
+	}
+
+	for i := range list {
+		list[i].Idx = i
+	}
+
+	return list
+}
+
+func newDaoStrategy(daoID uuid.UUID, name, network string, params map[string]any, multichain bool) DaoStrategy {
+	address, _ := params["address"].(string)
+	symbol, _ := params["symbol"].(string)
+
+	var decimals int
+	switch v := params["decimals"].(type) {
+	case float64:
+		decimals = int(v)
+	case string:
+		decimals, _ = strconv.Atoi(v)
+	}
+
+	return DaoStrategy{
+		DaoID:      daoID,
+		Name:       name,
+		Network:    network,
+		Address:    address,
+		Symbol:     symbol,
+		Decimals:   decimals,
+		Multichain: multichain,
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
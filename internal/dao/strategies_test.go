@@ -0,0 +1,35 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitFlattenStrategies(t *testing.T) {
+	dao := Dao{
+		ID:      id1,
+		Network: "1",
+		Strategies: Strategies{
+			{Name: "erc20-balance-of", Params: map[string]any{"address": "0xToken", "symbol": "TKN", "decimals": float64(18)}},
+			{Name: "multichain", Network: "10", Params: map[string]any{
+				"symbol": "MULTI",
+				"strategies": []any{
+					map[string]any{"name": "erc20-votes", "network": "137", "params": map[string]any{"address": "0xPolygon", "symbol": "pTKN", "decimals": "6"}},
+					map[string]any{"name": "erc20-balance-of", "params": map[string]any{"address": "0xOptimism"}},
+					"invalid",
+				},
+			}},
+			{Name: "ticket", Network: "100"},
+		},
+	}
+
+	require.Equal(t, []DaoStrategy{
+		{DaoID: id1, Idx: 0, Name: "erc20-balance-of", Network: "1", Address: "0xToken", Symbol: "TKN", Decimals: 18},
+		{DaoID: id1, Idx: 1, Name: "erc20-votes", Network: "137", Address: "0xPolygon", Symbol: "pTKN", Decimals: 6, Multichain: true},
+		{DaoID: id1, Idx: 2, Name: "erc20-balance-of", Network: "10", Address: "0xOptimism", Multichain: true},
+		{DaoID: id1, Idx: 3, Name: "ticket", Network: "100"},
+	}, flattenStrategies(dao))
+
+	require.Empty(t, flattenStrategies(Dao{ID: id1}))
+}
@@ -0,0 +1,294 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	coreevents "github.com/goverland-labs/goverland-platform-events/events/core"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/marketdata"
+	"github.com/goverland-labs/goverland-core-storage/internal/outbox"
+)
+
+const (
+	day = 24 * time.Hour
+
+	// populatedPricesPeriod is the chart period published by PopulateTokenPrices
+	populatedPricesPeriod = "month"
+	// tokenPriceMaxAge is the max distance to the stored price which can be used as the price at the moment
+	tokenPriceMaxAge = 3 * time.Hour
+	// tokenPriceRequestTimeout limits the provider request made while the proposal is stored
+	tokenPriceRequestTimeout = 5 * time.Second
+)
+
+// TokenPrice is the price of the fungible token in usd at the moment
+type TokenPrice struct {
+	FungibleID string    `gorm:"primary_key"`
+	Time       time.Time `gorm:"primary_key"`
+	Price      float64
+}
+
+func (TokenPrice) TableName() string {
+	return "token_prices"
+}
+
+// TokenPriceBackfill marks the token which history was filled from all backfill periods
+type TokenPriceBackfill struct {
+	FungibleID string `gorm:"primary_key"`
+	CreatedAt  time.Time
+}
+
+func (TokenPriceBackfill) TableName() string {
+	return "token_price_backfills"
+}
+
+type TokenChart struct {
+	Points []TokenPrice
+}
+
+// Price returns the last price of the chart
+func (c TokenChart) Price() float64 {
+	if len(c.Points) == 0 {
+		return 0
+	}
+
+	return c.Points[len(c.Points)-1].Price
+}
+
+// PriceChange returns the relative change between the first and the last prices
+func (c TokenChart) PriceChange() float64 {
+	if len(c.Points) == 0 || c.Points[0].Price == 0 {
+		return 0
+	}
+
+	return (c.Price() - c.Points[0].Price) / c.Points[0].Price
+}
+
+type TokenPriceProvider interface {
+	Save(list []TokenPrice) error
+	IsBackfilled(fungibleID string) (bool, error)
+	MarkBackfilled(fungibleID string) error
+	// GetRange returns prices ordered by time, the zero from isn't limited
+	GetRange(fungibleID string, from, to time.Time) ([]TokenPrice, error)
+	// GetLatest returns the latest price at or before the moment, nil is returned if there is no such price
+	GetLatest(fungibleID string, at time.Time) (*TokenPrice, error)
+}
+
+// tokenChartPeriod describes the chart period of market data providers, gap is the max allowed age
+// of the latest stored price before the period is filled from the provider
+type tokenChartPeriod struct {
+	window time.Duration
+	gap    time.Duration
+}
+
+var tokenChartPeriods = map[string]tokenChartPeriod{
+	"hour":    {window: time.Hour, gap: 10 * time.Minute},
+	"day":     {window: day, gap: tokenPriceMaxAge},
+	"week":    {window: 7 * day, gap: tokenPriceMaxAge},
+	"month":   {window: 30 * day, gap: 6 * time.Hour},
+	"3months": {window: 90 * day, gap: day},
+	"6months": {window: 180 * day, gap: day},
+	"year":    {window: 365 * day, gap: 2 * day},
+	"5years":  {window: 5 * 365 * day, gap: 7 * day},
+	"max":     {gap: 7 * day},
+}
+
+// backfillPeriods are requested from the coarse to the fine one, so recent prices are the most detailed
+var backfillPeriods = []string{"max", "year", "month", "week"}
+
+// GetTokenPrice returns the stored token price at the moment of the proposal creation.
+// The price is requested from the provider only if there is no stored price close to the moment.
+func (s *Service) GetTokenPrice(ctx context.Context, id uuid.UUID, created int) float64 {
+	dao, err := s.repo.GetByID(id)
+	if err != nil {
+		return 0
+	}
+
+	fid := s.tokenID(*dao)
+	if fid == "" {
+		return 0
+	}
+
+	at := time.Unix(int64(created), 0)
+	price, err := s.tokenPrices.GetLatest(fid, at)
+	if err == nil && price != nil && at.Sub(price.Time) <= tokenPriceMaxAge {
+		return price.Price
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, tokenPriceRequestTimeout)
+	defer cancel()
+
+	if err = s.fillTokenPrices(ctx, fid, periodFor(time.Since(at))); err != nil {
+		return 0
+	}
+
+	price, err = s.tokenPrices.GetLatest(fid, at)
+	if err != nil || price == nil {
+		return 0
+	}
+
+	return price.Price
+}
+
+// GetTokenChart returns stored prices of the period, outdated prices are filled from the provider
+func (s *Service) GetTokenChart(ctx context.Context, id uuid.UUID, period string) (*TokenChart, error) {
+	dao, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dao: %w", err)
+	}
+
+	fid := s.tokenID(*dao)
+	if fid == "" {
+		return nil, status.Error(codes.Internal, "can't receive the token information")
+	}
+
+	p, ok := tokenChartPeriods[period]
+	if !ok {
+		return nil, fmt.Errorf("unknown period %s", period)
+	}
+
+	var (
+		now  = time.Now()
+		from time.Time
+	)
+	if p.window != 0 {
+		from = now.Add(-p.window)
+	}
+
+	points, err := s.tokenPrices.GetRange(fid, from, now)
+	if err != nil {
+		return nil, fmt.Errorf("get token prices: %w", err)
+	}
+
+	if !hasGaps(points, now, p.gap) {
+		return &TokenChart{Points: points}, nil
+	}
+
+	if err = s.fillTokenPrices(ctx, fid, period); err != nil {
+		return nil, fmt.Errorf("failed to get token chart: %w", err)
+	}
+
+	points, err = s.tokenPrices.GetRange(fid, from, now)
+	if err != nil {
+		return nil, fmt.Errorf("get token prices: %w", err)
+	}
+
+	return &TokenChart{Points: points}, nil
+}
+
+func (s *Service) PopulateTokenPrices(ctx context.Context, id uuid.UUID) (bool, error) {
+	data, err := s.GetTokenChart(ctx, id, populatedPricesPeriod)
+	if err != nil || data == nil {
+		return false, fmt.Errorf("failed to get token prices: %w", err)
+	}
+	if err := s.events.PublishJSON(outbox.WithKey(ctx, id.String()), coreevents.DaoTokenPriceUpdated, convertToTokenPricesPayload(data.Points, id)); err != nil {
+		return false, fmt.Errorf("publish token prices event: %w", err)
+	}
+	return true, nil
+}
+
+// StoreTokenPrices saves current token prices, the latest price of the same moment wins
+func (s *Service) StoreTokenPrices(list []TokenPrice) error {
+	if err := s.tokenPrices.Save(list); err != nil {
+		return fmt.Errorf("save token prices: %w", err)
+	}
+
+	return nil
+}
+
+// BackfillTokenPrices fills the history of token prices from provider charts once,
+// the token is marked as backfilled only if all periods are filled, so failed ones are retried by the next call
+func (s *Service) BackfillTokenPrices(ctx context.Context, fungibleID string) error {
+	backfilled, err := s.tokenPrices.IsBackfilled(fungibleID)
+	if err != nil {
+		return fmt.Errorf("check token prices backfill: %w", err)
+	}
+
+	if backfilled {
+		return nil
+	}
+
+	for _, period := range backfillPeriods {
+		if err = s.fillTokenPrices(ctx, fungibleID, period); err != nil {
+			return err
+		}
+	}
+
+	if err = s.tokenPrices.MarkBackfilled(fungibleID); err != nil {
+		return fmt.Errorf("mark token prices backfill: %w", err)
+	}
+
+	return nil
+}
+
+// fillTokenPrices stores points of the provider chart
+func (s *Service) fillTokenPrices(ctx context.Context, fungibleID, period string) error {
+	points, err := s.market.GetChart(ctx, fungibleID, period)
+	if err != nil {
+		return fmt.Errorf("get %s token chart: %w", period, err)
+	}
+
+	list := convertChartToTokenPrices(fungibleID, points)
+	if len(list) == 0 {
+		return nil
+	}
+
+	return s.StoreTokenPrices(list)
+}
+
+// hasGaps checks if any interval between stored points or the latest point is older than the gap,
+// the beginning of the history is filled by BackfillTokenPrices
+func hasGaps(points []TokenPrice, to time.Time, gap time.Duration) bool {
+	if len(points) == 0 {
+		return true
+	}
+
+	for i := 1; i < len(points); i++ {
+		if points[i].Time.Sub(points[i-1].Time) > gap {
+			return true
+		}
+	}
+
+	return to.Sub(points[len(points)-1].Time) > gap
+}
+
+// periodFor returns the shortest chart period which contains the moment in the past
+func periodFor(ago time.Duration) string {
+	for _, period := range []string{"hour", "day", "week", "month", "3months", "6months", "year", "5years"} {
+		if ago < tokenChartPeriods[period].window {
+			return period
+		}
+	}
+
+	return "max"
+}
+
+func convertChartToTokenPrices(fungibleID string, list []marketdata.Point) []TokenPrice {
+	res := make([]TokenPrice, len(list))
+	for i, point := range list {
+		res[i] = TokenPrice{
+			FungibleID: fungibleID,
+			Time:       point.Time,
+			Price:      point.Price,
+		}
+	}
+
+	return res
+}
+
+func convertToTokenPricesPayload(list []TokenPrice, daoId uuid.UUID) coreevents.TokenPricesPayload {
+	res := make(coreevents.TokenPricesPayload, 0, len(list))
+	for _, point := range list {
+		res = append(res, coreevents.TokenPricePayload{
+			DaoID: daoId,
+			Time:  point.Time,
+			Price: point.Price,
+		})
+	}
+
+	return res
+}
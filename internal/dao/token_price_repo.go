@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TokenPriceRepo struct {
+	db *gorm.DB
+}
+
+func NewTokenPriceRepo(db *gorm.DB) *TokenPriceRepo {
+	return &TokenPriceRepo{db: db}
+}
+
+// Save inserts prices, the price of the existing moment is replaced
+func (r *TokenPriceRepo) Save(list []TokenPrice) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "fungible_id"}, {Name: "time"}},
+		DoUpdates: clause.AssignmentColumns([]string{"price"}),
+	}).CreateInBatches(list, defaultBatchSize).Error
+}
+
+func (r *TokenPriceRepo) IsBackfilled(fungibleID string) (bool, error) {
+	var cnt int64
+	err := r.db.
+		Model(&TokenPriceBackfill{}).
+		Where(&TokenPriceBackfill{FungibleID: fungibleID}).
+		Count(&cnt).
+		Error
+	if err != nil {
+		return false, fmt.Errorf("check token prices backfill %s: %w", fungibleID, err)
+	}
+
+	return cnt > 0, nil
+}
+
+func (r *TokenPriceRepo) MarkBackfilled(fungibleID string) error {
+	return r.db.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&TokenPriceBackfill{FungibleID: fungibleID}).
+		Error
+}
+
+func (r *TokenPriceRepo) GetRange(fungibleID string, from, to time.Time) ([]TokenPrice, error) {
+	db := r.db.Where("fungible_id = ? and time <= ?", fungibleID, to)
+	if !from.IsZero() {
+		db = db.Where("time >= ?", from)
+	}
+
+	var list []TokenPrice
+	if err := db.Order("time").Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("get token prices %s: %w", fungibleID, err)
+	}
+
+	return list, nil
+}
+
+func (r *TokenPriceRepo) GetLatest(fungibleID string, at time.Time) (*TokenPrice, error) {
+	var price TokenPrice
+	err := r.db.
+		Where("fungible_id = ? and time <= ?", fungibleID, at).
+		Order("time desc").
+		Take(&price).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get latest token price %s: %w", fungibleID, err)
+	}
+
+	return &price, nil
+}
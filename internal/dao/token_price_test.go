@@ -0,0 +1,229 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/marketdata"
+	"github.com/goverland-labs/goverland-core-storage/pkg/sdk/zerion"
+)
+
+func TestUnitTokenChart(t *testing.T) {
+	now := time.Now()
+
+	for name, tc := range map[string]struct {
+		points []TokenPrice
+		price  float64
+		change float64
+	}{
+		"empty": {},
+		"growth": {
+			points: []TokenPrice{{Time: now.Add(-time.Hour), Price: 2}, {Time: now, Price: 3}},
+			price:  3,
+			change: 0.5,
+		},
+		"zero first price": {
+			points: []TokenPrice{{Time: now.Add(-time.Hour), Price: 0}, {Time: now, Price: 3}},
+			price:  3,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			chart := TokenChart{Points: tc.points}
+			require.Equal(t, tc.price, chart.Price())
+			require.Equal(t, tc.change, chart.PriceChange())
+		})
+	}
+}
+
+func TestUnitTokenID(t *testing.T) {
+	providers := func(names ...string) marketdata.Provider {
+		registered := map[string]marketdata.Provider{
+			marketdata.Zerion:    marketdata.NewZerionProvider(nil),
+			marketdata.CoinGecko: marketdata.NewCoinGeckoProvider(nil),
+		}
+
+		p, err := marketdata.NewProviders(names, registered)
+		require.NoError(t, err)
+
+		return p
+	}
+
+	for name, tc := range map[string]struct {
+		dao      Dao
+		market   marketdata.Provider
+		expected string
+	}{
+		"fungible id": {
+			dao:      Dao{FungibleId: "uni", Coingecko: "uniswap"},
+			market:   providers(marketdata.Zerion),
+			expected: "uni",
+		},
+		"coingecko id": {
+			dao:      Dao{Coingecko: "uniswap"},
+			market:   providers(marketdata.Zerion, marketdata.CoinGecko),
+			expected: "coingecko:uniswap",
+		},
+		"coingecko is disabled": {
+			dao:    Dao{Coingecko: "uniswap"},
+			market: providers(marketdata.Zerion),
+		},
+		"single provider": {
+			dao:    Dao{Coingecko: "uniswap"},
+			market: marketdata.NewZerionProvider(nil),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			service, err := NewService(nil, nil, nil, nil, nil, nil, nil, nil, tc.market, nil)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, service.tokenID(tc.dao))
+		})
+	}
+}
+
+func TestUnitPeriodFor(t *testing.T) {
+	for ago, expected := range map[time.Duration]string{
+		time.Minute:     "hour",
+		2 * time.Hour:   "day",
+		3 * day:         "week",
+		100 * day:       "6months",
+		10 * 365 * day:  "max",
+		5*365*day - day: "5years",
+	} {
+		require.Equal(t, expected, periodFor(ago), ago.String())
+	}
+}
+
+func TestUnitGetTokenPrice(t *testing.T) {
+	var (
+		daoID   = uuid.New()
+		created = time.Now().Add(-30 * time.Minute).Truncate(time.Second)
+		stored  = TokenPrice{FungibleID: "token", Time: created.Add(-time.Hour), Price: 1.5}
+		filled  = TokenPrice{FungibleID: "token", Time: created.Add(-time.Minute), Price: 1.7}
+	)
+
+	for name, tc := range map[string]struct {
+		tp       func(ctrl *gomock.Controller) TokenPriceProvider
+		expected float64
+		requests int
+	}{
+		"stored price": {
+			tp: func(ctrl *gomock.Controller) TokenPriceProvider {
+				m := NewMockTokenPriceProvider(ctrl)
+				m.EXPECT().GetLatest("token", created).Return(&stored, nil)
+
+				return m
+			},
+			expected: 1.5,
+		},
+		"fill outdated price from zerion": {
+			tp: func(ctrl *gomock.Controller) TokenPriceProvider {
+				m := NewMockTokenPriceProvider(ctrl)
+				m.EXPECT().GetLatest("token", created).Return(&TokenPrice{Time: created.Add(-4 * time.Hour), Price: 1}, nil)
+				m.EXPECT().Save([]TokenPrice{filled}).Return(nil)
+				m.EXPECT().GetLatest("token", created).Return(&filled, nil)
+
+				return m
+			},
+			expected: 1.7,
+			requests: 1,
+		},
+		"no prices at all": {
+			tp: func(ctrl *gomock.Controller) TokenPriceProvider {
+				m := NewMockTokenPriceProvider(ctrl)
+				m.EXPECT().GetLatest("token", created).Times(2).Return(nil, nil)
+				m.EXPECT().Save(gomock.Any()).Return(nil)
+
+				return m
+			},
+			requests: 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var requests int
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				require.Equal(t, "/fungibles/token/charts/hour/", r.URL.Path)
+				_, _ = fmt.Fprintf(w, `{"data":{"attributes":{"points":[[%d,1.7]]}}}`, filled.Time.Unix())
+			}))
+			defer srv.Close()
+
+			dp := NewMockDataProvider(ctrl)
+			dp.EXPECT().GetByID(daoID).Return(&Dao{ID: daoID, FungibleId: "token"}, nil)
+
+			s, err := NewService(dp, nil, nil, nil, nil, nil, nil, tc.tp(ctrl), marketdata.NewZerionProvider(zerion.NewClient(srv.URL, "", srv.Client())), nil)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, s.GetTokenPrice(context.Background(), daoID, int(created.Unix())))
+			require.Equal(t, tc.requests, requests)
+		})
+	}
+}
+
+func TestUnitHasGaps(t *testing.T) {
+	now := time.Now()
+	point := func(ago time.Duration) TokenPrice {
+		return TokenPrice{Time: now.Add(-ago)}
+	}
+
+	for name, tc := range map[string]struct {
+		points   []TokenPrice
+		expected bool
+	}{
+		"empty":         {expected: true},
+		"actual":        {points: []TokenPrice{point(3 * time.Hour), point(2 * time.Hour), point(time.Hour)}},
+		"outdated":      {points: []TokenPrice{point(5 * time.Hour), point(4 * time.Hour)}, expected: true},
+		"gap in middle": {points: []TokenPrice{point(8 * time.Hour), point(time.Hour)}, expected: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, hasGaps(tc.points, now, 2*time.Hour))
+		})
+	}
+}
+
+func TestUnitBackfillTokenPrices(t *testing.T) {
+	for name, tc := range map[string]struct {
+		failed string
+		marked bool
+	}{
+		"all periods are filled": {marked: true},
+		"period is failed":       {failed: "year"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tc.failed != "" && r.URL.Path == fmt.Sprintf("/fungibles/token/charts/%s/", tc.failed) {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				_, _ = fmt.Fprintf(w, `{"data":{"attributes":{"points":[[%d,1.7]]}}}`, time.Now().Unix())
+			}))
+			defer srv.Close()
+
+			tp := NewMockTokenPriceProvider(ctrl)
+			tp.EXPECT().IsBackfilled("token").Return(false, nil)
+			tp.EXPECT().Save(gomock.Any()).AnyTimes().Return(nil)
+			if tc.marked {
+				tp.EXPECT().MarkBackfilled("token").Return(nil)
+			}
+
+			s, err := NewService(NewMockDataProvider(ctrl), nil, nil, nil, nil, nil, nil, tp, marketdata.NewZerionProvider(zerion.NewClient(srv.URL, "", srv.Client())), nil)
+			require.NoError(t, err)
+
+			err = s.BackfillTokenPrices(context.Background(), "token")
+			require.Equal(t, tc.failed != "", err != nil)
+		})
+	}
+}
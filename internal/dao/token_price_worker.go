@@ -2,14 +2,13 @@ package dao
 
 import (
 	"context"
-	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	coreevents "github.com/goverland-labs/goverland-platform-events/events/core"
 	"golang.org/x/exp/maps"
 
-	"github.com/goverland-labs/goverland-core-storage/pkg/sdk/zerion"
+	"github.com/goverland-labs/goverland-core-storage/internal/marketdata"
 
 	"github.com/rs/zerolog/log"
 )
@@ -20,28 +19,36 @@ const (
 )
 
 type TokenPriceWorker struct {
-	service      *Service
-	zerionClient *zerion.Client
+	service *Service
+	market  marketdata.Provider
 }
 
-func NewTokenPriceWorker(s *Service, z *zerion.Client) *TokenPriceWorker {
+func NewTokenPriceWorker(s *Service, m marketdata.Provider) *TokenPriceWorker {
 	return &TokenPriceWorker{
-		service:      s,
-		zerionClient: z,
+		service: s,
+		market:  m,
 	}
 }
 
 func (w *TokenPriceWorker) Process(ctx context.Context) error {
 	for {
-		filters := []Filter{FungibleIdFilter{}}
+		filters := []Filter{TokenIDFilter{}}
 
-		list, err := w.service.GetByFilters(filters)
+		list, err := w.service.GetByFilters(filters, false)
 		if err != nil {
 			log.Error().Err(err).Msg("getTokenPrice")
 		}
 		fm := make(map[string]uuid.UUID)
 		for _, d := range list.Daos {
-			fm[d.FungibleId] = d.ID
+			// the coingecko id is skipped if the provider is disabled
+			if fid := w.service.tokenID(d); fid != "" {
+				fm[fid] = d.ID
+			}
+		}
+		for fid := range fm {
+			if err := w.service.BackfillTokenPrices(ctx, fid); err != nil {
+				log.Error().Err(err).Str("fungible_id", fid).Msg("backfill token prices")
+			}
 		}
 		if len(fm) > 0 {
 			fids := maps.Keys(fm)
@@ -53,12 +60,16 @@ func (w *TokenPriceWorker) Process(ctx context.Context) error {
 					fids = fids[MAX_IDS_BY_REQUEST:]
 				}
 				idsCount = idsCount - MAX_IDS_BY_REQUEST
-				l, err := w.zerionClient.GetFungibleList(strings.Join(ids, ","), "")
+				prices, err := w.market.GetPrices(ctx, ids)
 				if err != nil {
-					log.Error().Err(err).Msg("zerion client error")
+					log.Error().Err(err).Msg("market data provider error")
 					break
 				}
-				if err := w.service.events.PublishJSON(ctx, coreevents.DaoTokenPriceUpdated, convertToCorePaylod(l.List, fm)); err != nil {
+				now := time.Now()
+				if err := w.service.StoreTokenPrices(convertToTokenPrices(prices, now)); err != nil {
+					log.Error().Err(err).Msg("store token prices")
+				}
+				if err := w.service.events.PublishJSON(ctx, coreevents.DaoTokenPriceUpdated, convertToCorePaylod(prices, fm, now)); err != nil {
 					log.Error().Err(err).Msgf("publish token prices event")
 				}
 			}
@@ -71,18 +82,31 @@ func (w *TokenPriceWorker) Process(ctx context.Context) error {
 	}
 }
 
-func convertToCorePaylod(list []zerion.FungibleData, fungiblesMap map[string]uuid.UUID) coreevents.TokenPricesPayload {
-	res := make(coreevents.TokenPricesPayload, 0, len(list))
-	for i := range list {
-		daoId, exist := fungiblesMap[list[i].ID]
+func convertToCorePaylod(prices map[string]float64, fungiblesMap map[string]uuid.UUID, now time.Time) coreevents.TokenPricesPayload {
+	res := make(coreevents.TokenPricesPayload, 0, len(prices))
+	for fid, price := range prices {
+		daoId, exist := fungiblesMap[fid]
 		if exist {
 			res = append(res, coreevents.TokenPricePayload{
 				DaoID: daoId,
-				Time:  time.Now(),
-				Price: list[i].Attributes.MarketData.Price,
+				Time:  now,
+				Price: price,
 			})
 		}
 	}
 
 	return res
 }
+
+func convertToTokenPrices(prices map[string]float64, now time.Time) []TokenPrice {
+	res := make([]TokenPrice, 0, len(prices))
+	for fid, price := range prices {
+		res = append(res, TokenPrice{
+			FungibleID: fid,
+			Time:       now,
+			Price:      price,
+		})
+	}
+
+	return res
+}
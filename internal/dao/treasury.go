@@ -0,0 +1,286 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/marketdata"
+	"github.com/goverland-labs/goverland-core-storage/pkg/sdk/zerion"
+)
+
+// TreasurySnapshot stores positions of one treasury wallet at the moment of the snapshot
+type TreasurySnapshot struct {
+	ID        uint64 `gorm:"primary_key"`
+	CreatedAt time.Time
+	DaoID     uuid.UUID
+	// Address is stored in lower case, the same wallet may be listed for several networks
+	Address   string
+	Value     float64
+	Positions TreasuryPositions `gorm:"serializer:json"`
+}
+
+func (TreasurySnapshot) TableName() string {
+	return "dao_treasury_snapshots"
+}
+
+type TreasuryPosition struct {
+	FungibleID string  `json:"fungible_id"`
+	Chain      string  `json:"chain"`
+	Name       string  `json:"name"`
+	Symbol     string  `json:"symbol"`
+	IconURL    string  `json:"icon_url,omitempty"`
+	Quantity   float64 `json:"quantity"`
+	Price      float64 `json:"price"`
+	Value      float64 `json:"value"`
+}
+
+type TreasuryPositions []TreasuryPosition
+
+// TreasuryWallet is the treasury of the dao with the latest snapshot of its positions
+type TreasuryWallet struct {
+	Treasury
+	Value     float64
+	UpdatedAt time.Time
+	Positions TreasuryPositions
+}
+
+type TreasuryValuePoint struct {
+	Time  time.Time
+	Value float64
+}
+
+type TreasuryOverview struct {
+	Value   float64
+	Wallets []TreasuryWallet
+	Series  []TreasuryValuePoint
+}
+
+type TreasuryDataProvider interface {
+	CreateSnapshot(snapshot TreasurySnapshot) error
+	GetLatestSnapshots(daoID uuid.UUID, addresses []string) ([]TreasurySnapshot, error)
+	GetValueSeries(daoID uuid.UUID, addresses []string, from time.Time) ([]TreasuryValuePoint, error)
+}
+
+// WalletProvider returns positions of the wallet on zerion chains, the zerion client is used in production
+type WalletProvider interface {
+	GetWalletPositions(ctx context.Context, address string, chainIDs []string) ([]zerion.Position, error)
+	GetChains(ctx context.Context) ([]zerion.ChainData, error)
+}
+
+type TreasuryService struct {
+	repo    TreasuryDataProvider
+	daos    DataProvider
+	wallets WalletProvider
+}
+
+func NewTreasuryService(r TreasuryDataProvider, daos DataProvider, wallets WalletProvider) *TreasuryService {
+	return &TreasuryService{
+		repo:    r,
+		daos:    daos,
+		wallets: wallets,
+	}
+}
+
+// Snapshot stores current positions of all dao treasuries, wallets are requested once per address on chains of
+// its networks. Addresses which already have the snapshot of the day are skipped, so restarts don't duplicate them.
+func (s *TreasuryService) Snapshot(ctx context.Context, dao Dao) error {
+	addresses := treasuryAddresses(dao.Treasures)
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	latest, err := s.repo.GetLatestSnapshots(dao.ID, addresses)
+	if err != nil {
+		return fmt.Errorf("get latest snapshots: %w", err)
+	}
+
+	day := time.Now().UTC().Truncate(24 * time.Hour)
+	taken := make(map[string]bool, len(latest))
+	for _, snapshot := range latest {
+		taken[snapshot.Address] = !snapshot.CreatedAt.Before(day)
+	}
+
+	chains, err := s.chainsByNetwork(ctx)
+	if err != nil {
+		return fmt.Errorf("get chains: %w", err)
+	}
+
+	networks := treasuryNetworks(dao.Treasures)
+	for _, address := range addresses {
+		if taken[address] {
+			continue
+		}
+
+		list, err := s.wallets.GetWalletPositions(ctx, address, walletChains(networks[address], chains))
+		if err != nil {
+			return fmt.Errorf("get wallet positions %s: %w", address, err)
+		}
+
+		snapshot := TreasurySnapshot{
+			DaoID:     dao.ID,
+			Address:   address,
+			Positions: convertToTreasuryPositions(list),
+		}
+		for _, p := range snapshot.Positions {
+			snapshot.Value += p.Value
+		}
+
+		if err = s.repo.CreateSnapshot(snapshot); err != nil {
+			return fmt.Errorf("create treasury snapshot %s: %w", address, err)
+		}
+	}
+
+	return nil
+}
+
+// chainsByNetwork returns zerion chain ids by decimal networks
+func (s *TreasuryService) chainsByNetwork(ctx context.Context) (map[string]string, error) {
+	list, err := s.wallets.GetChains(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]string, len(list))
+	for _, chain := range list {
+		if network := marketdata.HexToNetwork(chain.Attributes.ExternalID); network != "" {
+			res[network] = chain.ID
+		}
+	}
+
+	return res, nil
+}
+
+// GetTreasury returns the latest composition of current dao treasuries and the daily value series since the time
+func (s *TreasuryService) GetTreasury(id uuid.UUID, from time.Time) (*TreasuryOverview, error) {
+	dao, err := s.daos.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("get dao: %w", err)
+	}
+
+	addresses := treasuryAddresses(dao.Treasures)
+	if len(addresses) == 0 {
+		return &TreasuryOverview{}, nil
+	}
+
+	snapshots, err := s.repo.GetLatestSnapshots(id, addresses)
+	if err != nil {
+		return nil, fmt.Errorf("get latest snapshots: %w", err)
+	}
+
+	latest := make(map[string]TreasurySnapshot, len(snapshots))
+	for _, snapshot := range snapshots {
+		latest[snapshot.Address] = snapshot
+	}
+
+	overview := &TreasuryOverview{
+		Wallets: make([]TreasuryWallet, 0, len(dao.Treasures)),
+	}
+	for _, t := range dao.Treasures {
+		wallet := TreasuryWallet{Treasury: t}
+		if snapshot, ok := latest[strings.ToLower(t.Address)]; ok {
+			wallet.Value = snapshot.Value
+			wallet.UpdatedAt = snapshot.CreatedAt
+			wallet.Positions = snapshot.Positions
+		}
+		overview.Wallets = append(overview.Wallets, wallet)
+	}
+
+	for _, snapshot := range latest {
+		overview.Value += snapshot.Value
+	}
+
+	overview.Series, err = s.repo.GetValueSeries(id, addresses, from)
+	if err != nil {
+		return nil, fmt.Errorf("get value series: %w", err)
+	}
+
+	return overview, nil
+}
+
+// treasuryAddresses returns unique lower cased addresses of treasuries
+func treasuryAddresses(list Treasuries) []string {
+	res := make([]string, 0, len(list))
+	seen := make(map[string]struct{}, len(list))
+	for _, t := range list {
+		address := strings.ToLower(strings.TrimSpace(t.Address))
+		if address == "" {
+			continue
+		}
+
+		if _, ok := seen[address]; ok {
+			continue
+		}
+		seen[address] = struct{}{}
+
+		res = append(res, address)
+	}
+
+	return res
+}
+
+// treasuryNetworks returns networks of treasuries by lower cased addresses.
+// Addresses with any treasury without the network get no networks, so positions on all chains are used.
+func treasuryNetworks(list Treasuries) map[string][]string {
+	res := make(map[string][]string, len(list))
+	all := make(map[string]bool, len(list))
+	for _, t := range list {
+		address := strings.ToLower(strings.TrimSpace(t.Address))
+		network := strings.TrimSpace(t.Network)
+		if network == "" {
+			all[address] = true
+		}
+
+		if !slices.Contains(res[address], network) {
+			res[address] = append(res[address], network)
+		}
+	}
+
+	for address := range all {
+		res[address] = nil
+	}
+
+	return res
+}
+
+// walletChains returns zerion chains of the networks, nil means all chains.
+// Networks which are unknown to zerion are not filtered out, so nil is returned for them too.
+func walletChains(networks []string, chains map[string]string) []string {
+	res := make([]string, 0, len(networks))
+	for _, network := range networks {
+		chain, ok := chains[network]
+		if !ok {
+			return nil
+		}
+
+		res = append(res, chain)
+	}
+
+	if len(res) == 0 {
+		return nil
+	}
+
+	return res
+}
+
+func convertToTreasuryPositions(list []zerion.Position) TreasuryPositions {
+	res := make(TreasuryPositions, len(list))
+	for i, p := range list {
+		res[i] = TreasuryPosition{
+			FungibleID: p.Relationships.Fungible.Data.ID,
+			Chain:      p.Relationships.Chain.Data.ID,
+			Name:       p.Attributes.FungibleInfo.Name,
+			Symbol:     p.Attributes.FungibleInfo.Symbol,
+			IconURL:    p.Attributes.FungibleInfo.Icon.URL,
+			Quantity:   p.Attributes.Quantity.Float,
+			Price:      p.Attributes.Price,
+			Value:      p.Attributes.Value,
+		}
+	}
+
+	return res
+}
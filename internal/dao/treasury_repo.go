@@ -0,0 +1,77 @@
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TreasuryRepo struct {
+	db *gorm.DB
+}
+
+func NewTreasuryRepo(db *gorm.DB) *TreasuryRepo {
+	return &TreasuryRepo{db: db}
+}
+
+func (r *TreasuryRepo) CreateSnapshot(snapshot TreasurySnapshot) error {
+	return r.db.Create(&snapshot).Error
+}
+
+// GetLatestSnapshots returns the latest snapshot of every passed address of the dao
+func (r *TreasuryRepo) GetLatestSnapshots(daoID uuid.UUID, addresses []string) ([]TreasurySnapshot, error) {
+	var (
+		dummy = TreasurySnapshot{}
+		_     = dummy.DaoID
+		_     = dummy.Address
+		_     = dummy.CreatedAt
+	)
+
+	var list []TreasurySnapshot
+	err := r.db.
+		Raw(`
+select distinct on (address) *
+from dao_treasury_snapshots
+where dao_id = ?
+  and address in ?
+order by address, created_at desc
+`, daoID, addresses).
+		Scan(&list).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("get latest treasury snapshots #%s: %w", daoID, err)
+	}
+
+	return list, nil
+}
+
+// GetValueSeries returns the daily value of passed addresses, the last snapshot of the day is used for every address
+func (r *TreasuryRepo) GetValueSeries(daoID uuid.UUID, addresses []string, from time.Time) ([]TreasuryValuePoint, error) {
+	var (
+		dummy = TreasurySnapshot{}
+		_     = dummy.Value
+	)
+
+	var list []TreasuryValuePoint
+	err := r.db.
+		Raw(`
+select day as time, sum(value) as value
+from (select distinct on (address, date_trunc('day', created_at)) date_trunc('day', created_at) as day, value
+      from dao_treasury_snapshots
+      where dao_id = ?
+        and address in ?
+        and created_at >= ?
+      order by address, date_trunc('day', created_at), created_at desc) daily
+group by day
+order by day
+`, daoID, addresses, from).
+		Scan(&list).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("get treasury value series #%s: %w", daoID, err)
+	}
+
+	return list, nil
+}
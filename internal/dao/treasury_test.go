@@ -0,0 +1,203 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goverland-labs/goverland-core-storage/pkg/sdk/zerion"
+)
+
+type walletsStub map[string][]zerion.Position
+
+func (s walletsStub) GetWalletPositions(_ context.Context, address string, chainIDs []string) ([]zerion.Position, error) {
+	list, ok := s[address]
+	if !ok {
+		return nil, errors.New("unknown wallet")
+	}
+
+	if chainIDs == nil {
+		return list, nil
+	}
+
+	var res []zerion.Position
+	for _, p := range list {
+		if slices.Contains(chainIDs, p.Relationships.Chain.Data.ID) {
+			res = append(res, p)
+		}
+	}
+
+	return res, nil
+}
+
+func (s walletsStub) GetChains(_ context.Context) ([]zerion.ChainData, error) {
+	return []zerion.ChainData{
+		{ID: "ethereum", Attributes: zerion.ChainAttributes{ExternalID: "0x1"}},
+		{ID: "arbitrum", Attributes: zerion.ChainAttributes{ExternalID: "0xa4b1"}},
+	}, nil
+}
+
+func position(fungibleID, chain string, quantity, price float64) zerion.Position {
+	var p zerion.Position
+	p.Relationships.Fungible.Data.ID = fungibleID
+	p.Relationships.Chain.Data.ID = chain
+	p.Attributes.FungibleInfo.Symbol = fungibleID
+	p.Attributes.Quantity.Float = quantity
+	p.Attributes.Price = price
+	p.Attributes.Value = quantity * price
+
+	return p
+}
+
+func TestUnitTreasurySnapshot(t *testing.T) {
+	daoID := uuid.New()
+
+	wallets := walletsStub{
+		"0xabc": {
+			position("eth", "ethereum", 2, 3000),
+			position("usdc", "arbitrum", 500, 1),
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		treasures Treasuries
+		latest    []TreasurySnapshot
+		expected  []TreasurySnapshot
+		err       bool
+	}{
+		"one snapshot per address": {
+			treasures: Treasuries{
+				{Name: "main", Address: "0xABC", Network: "1"},
+				{Name: "arbitrum", Address: "0xabc", Network: "42161"},
+			},
+			expected: []TreasurySnapshot{{
+				DaoID:   daoID,
+				Address: "0xabc",
+				Value:   6500,
+				Positions: TreasuryPositions{
+					{FungibleID: "eth", Chain: "ethereum", Symbol: "eth", Quantity: 2, Price: 3000, Value: 6000},
+					{FungibleID: "usdc", Chain: "arbitrum", Symbol: "usdc", Quantity: 500, Price: 1, Value: 500},
+				},
+			}},
+		},
+		"positions on the treasury network": {
+			treasures: Treasuries{{Name: "main", Address: "0xabc", Network: "1"}},
+			expected: []TreasurySnapshot{{
+				DaoID:     daoID,
+				Address:   "0xabc",
+				Value:     6000,
+				Positions: TreasuryPositions{{FungibleID: "eth", Chain: "ethereum", Symbol: "eth", Quantity: 2, Price: 3000, Value: 6000}},
+			}},
+		},
+		"positions on all chains for the unknown network": {
+			treasures: Treasuries{{Name: "main", Address: "0xabc", Network: "100"}},
+			expected: []TreasurySnapshot{{
+				DaoID:   daoID,
+				Address: "0xabc",
+				Value:   6500,
+				Positions: TreasuryPositions{
+					{FungibleID: "eth", Chain: "ethereum", Symbol: "eth", Quantity: 2, Price: 3000, Value: 6000},
+					{FungibleID: "usdc", Chain: "arbitrum", Symbol: "usdc", Quantity: 500, Price: 1, Value: 500},
+				},
+			}},
+		},
+		"skip addresses with the snapshot of the day": {
+			treasures: Treasuries{{Name: "main", Address: "0xabc", Network: "1"}},
+			latest:    []TreasurySnapshot{{CreatedAt: time.Now(), DaoID: daoID, Address: "0xabc"}},
+		},
+		"take the snapshot after the previous day": {
+			treasures: Treasuries{{Name: "main", Address: "0xabc", Network: "1"}},
+			latest:    []TreasurySnapshot{{CreatedAt: time.Now().AddDate(0, 0, -1), DaoID: daoID, Address: "0xabc"}},
+			expected: []TreasurySnapshot{{
+				DaoID:     daoID,
+				Address:   "0xabc",
+				Value:     6000,
+				Positions: TreasuryPositions{{FungibleID: "eth", Chain: "ethereum", Symbol: "eth", Quantity: 2, Price: 3000, Value: 6000}},
+			}},
+		},
+		"skip empty addresses": {
+			treasures: Treasuries{{Name: "empty"}},
+		},
+		"raise err on provider problems": {
+			treasures: Treasuries{{Name: "unknown", Address: "0xdef"}},
+			err:       true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var stored []TreasurySnapshot
+			repo := NewMockTreasuryDataProvider(ctrl)
+			repo.EXPECT().GetLatestSnapshots(daoID, gomock.Any()).AnyTimes().Return(tc.latest, nil)
+			repo.EXPECT().CreateSnapshot(gomock.Any()).AnyTimes().DoAndReturn(func(s TreasurySnapshot) error {
+				stored = append(stored, s)
+				return nil
+			})
+
+			service := NewTreasuryService(repo, nil, wallets)
+			err := service.Snapshot(context.Background(), Dao{ID: daoID, Treasures: tc.treasures})
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, stored)
+		})
+	}
+}
+
+func TestUnitGetTreasury(t *testing.T) {
+	var (
+		daoID   = uuid.New()
+		updated = time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+		from    = updated.AddDate(0, 0, -30)
+	)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	daos := NewMockDataProvider(ctrl)
+	daos.EXPECT().GetByID(daoID).Return(&Dao{
+		ID: daoID,
+		Treasures: Treasuries{
+			{Name: "main", Address: "0xABC", Network: "1"},
+			{Name: "grants", Address: "0xdef", Network: "1"},
+		},
+	}, nil)
+
+	positions := TreasuryPositions{{FungibleID: "eth", Value: 6000}}
+	series := []TreasuryValuePoint{{Time: updated, Value: 6000}}
+
+	repo := NewMockTreasuryDataProvider(ctrl)
+	repo.EXPECT().GetLatestSnapshots(daoID, []string{"0xabc", "0xdef"}).Return([]TreasurySnapshot{
+		{CreatedAt: updated, DaoID: daoID, Address: "0xabc", Value: 6000, Positions: positions},
+	}, nil)
+	repo.EXPECT().GetValueSeries(daoID, []string{"0xabc", "0xdef"}, from).Return(series, nil)
+
+	service := NewTreasuryService(repo, daos, walletsStub{})
+	overview, err := service.GetTreasury(daoID, from)
+	require.NoError(t, err)
+	require.Equal(t, &TreasuryOverview{
+		Value: 6000,
+		Wallets: []TreasuryWallet{
+			{
+				Treasury:  Treasury{Name: "main", Address: "0xABC", Network: "1"},
+				Value:     6000,
+				UpdatedAt: updated,
+				Positions: positions,
+			},
+			{
+				Treasury: Treasury{Name: "grants", Address: "0xdef", Network: "1"},
+			},
+		},
+		Series: series,
+	}, overview)
+}
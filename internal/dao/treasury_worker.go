@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	treasurySnapshotDelay = 24 * time.Hour
+)
+
+// TreasuryWorker takes daily snapshots of dao treasuries
+type TreasuryWorker struct {
+	service  *Service
+	treasury *TreasuryService
+}
+
+func NewTreasuryWorker(s *Service, ts *TreasuryService) *TreasuryWorker {
+	return &TreasuryWorker{
+		service:  s,
+		treasury: ts,
+	}
+}
+
+func (w *TreasuryWorker) Process(ctx context.Context) error {
+	for {
+		w.process(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(treasurySnapshotDelay):
+		}
+	}
+}
+
+func (w *TreasuryWorker) process(ctx context.Context) {
+	list, err := w.service.GetByFilters([]Filter{TreasuriesFilter{}}, false)
+	if err != nil {
+		log.Error().Err(err).Msg("get daos with treasuries")
+		return
+	}
+
+	start := time.Now()
+	for _, dao := range list.Daos {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err = w.treasury.Snapshot(ctx, dao); err != nil {
+			log.Error().Err(err).Str("dao_id", dao.ID.String()).Msg("snapshot dao treasury")
+		}
+	}
+
+	log.Info().Int("daos", len(list.Daos)).Dur("duration", time.Since(start)).Msg("treasury snapshots taken")
+}
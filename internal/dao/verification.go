@@ -0,0 +1,203 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	coreevents "github.com/goverland-labs/goverland-platform-events/events/core"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/notifier"
+	"github.com/goverland-labs/goverland-core-storage/internal/optimistic"
+	"github.com/goverland-labs/goverland-core-storage/internal/outbox"
+	"github.com/goverland-labs/goverland-core-storage/internal/pagination"
+)
+
+// Statuses of the token verification, daos with found fungible ids are pending until the moderator decision
+const (
+	VerificationPending  = "pending"
+	VerificationApproved = "approved"
+	VerificationDeclined = "declined"
+)
+
+// SubjectDaoTokenVerificationChanged is published on each moderator decision
+const SubjectDaoTokenVerificationChanged = "core.dao.token_verification.changed"
+
+// notifyTimeout limits the delivery of the notification including retries of backends
+const notifyTimeout = time.Minute
+
+var (
+	ErrInvalidVerificationStatus = errors.New("invalid verification status")
+	ErrNoFungibleID              = errors.New("dao has no fungible id")
+)
+
+// verificationDecisionKeyset returns the latest decisions first
+var verificationDecisionKeyset = pagination.NewKeyset(
+	pagination.Key{Column: "id", Desc: true},
+)
+
+// VerificationDecision is the audit record of the moderator decision
+type VerificationDecision struct {
+	ID        uint64 `gorm:"primary_key"`
+	CreatedAt time.Time
+	DaoID     uuid.UUID
+	Moderator string
+	// Client is the authenticated api client which submitted the decision, it's empty if auth is disabled
+	Client         string
+	Status         string
+	PreviousStatus string
+	Comment        string
+	// FungibleID is the fungible id which was verified
+	FungibleID        string
+	FungibleIDCleared bool
+}
+
+func (VerificationDecision) TableName() string {
+	return "dao_verification_decisions"
+}
+
+// Decision describes the moderator decision, ClearFungibleID is applied only to declined daos
+type Decision struct {
+	DaoID           uuid.UUID
+	Status          string
+	Comment         string
+	Moderator       string
+	Client          string
+	ClearFungibleID bool
+}
+
+type VerificationChangedPayload struct {
+	DaoID             uuid.UUID `json:"dao_id"`
+	Alias             string    `json:"alias"`
+	Status            string    `json:"status"`
+	PreviousStatus    string    `json:"previous_status"`
+	Comment           string    `json:"comment"`
+	Moderator         string    `json:"moderator"`
+	FungibleID        string    `json:"fungible_id"`
+	FungibleIDCleared bool      `json:"fungible_id_cleared"`
+}
+
+// DecideVerification applies the moderator decision to the dao, previous decisions can be revised.
+// Declined daos are not sent to verification again, so the cleared fungible id is not looked up anymore.
+func (s *Service) DecideVerification(ctx context.Context, d Decision) (*Dao, error) {
+	if d.Status != VerificationApproved && d.Status != VerificationDeclined {
+		return nil, ErrInvalidVerificationStatus
+	}
+
+	var (
+		updated  *Dao
+		decision VerificationDecision
+	)
+	err := optimistic.Retry(ctx, "verification", func(ctx context.Context) error {
+		existed, err := s.repo.GetByID(d.DaoID)
+		if err != nil {
+			return fmt.Errorf("get dao by id: %w", err)
+		}
+
+		if d.Status == VerificationApproved && existed.FungibleId == "" {
+			return ErrNoFungibleID
+		}
+
+		dao := *existed
+		dao.VerificationStatus = d.Status
+		dao.VerificationComment = d.Comment
+
+		cleared := d.Status == VerificationDeclined && d.ClearFungibleID && existed.FungibleId != ""
+		if cleared {
+			dao.FungibleId = ""
+			dao.TokenSymbol = ""
+		}
+
+		decision = VerificationDecision{
+			DaoID:             dao.ID,
+			Moderator:         d.Moderator,
+			Client:            d.Client,
+			Status:            d.Status,
+			PreviousStatus:    existed.VerificationStatus,
+			Comment:           d.Comment,
+			FungibleID:        existed.FungibleId,
+			FungibleIDCleared: cleared,
+		}
+
+		err = s.repo.CallInTx(outbox.WithKey(ctx, dao.ID.String()), func(ctx context.Context) error {
+			if _, err := s.update(ctx, *existed, dao, HistorySourceModerator+d.Moderator, s.repo.UpdateVerification); err != nil {
+				return err
+			}
+
+			if err := s.repo.CreateVerificationDecision(ctx, decision); err != nil {
+				return fmt.Errorf("create verification decision #%s: %w", dao.ID, err)
+			}
+
+			if err := s.events.PublishJSON(ctx, SubjectDaoTokenVerificationChanged, convertToVerificationPayload(dao, decision)); err != nil {
+				return fmt.Errorf("publish verification event #%s: %w", dao.ID, err)
+			}
+
+			return s.events.PublishJSON(ctx, coreevents.SubjectDaoUpdated, convertToCoreEvent(dao))
+		})
+		if err != nil {
+			return err
+		}
+
+		updated = &dao
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("dao_id", updated.ID.String()).
+		Str("status", d.Status).
+		Str("moderator", d.Moderator).
+		Msg("token verification decided")
+
+	// the decision is already stored, so the moderator doesn't wait for the delivery
+	go s.notifyVerificationDecision(*updated, decision)
+
+	return updated, nil
+}
+
+func (s *Service) notifyVerificationDecision(dao Dao, decision VerificationDecision) {
+	ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+	defer cancel()
+
+	err := s.notifier.Notify(ctx, notifier.KindVerificationDecision, notifier.VerificationDecision{
+		DaoAlias:          dao.OriginalID,
+		Status:            decision.Status,
+		Comment:           decision.Comment,
+		Moderator:         decision.Moderator,
+		FungibleID:        decision.FungibleID,
+		FungibleIDCleared: decision.FungibleIDCleared,
+	})
+	if err != nil {
+		log.Warn().Err(err).Str("dao_id", dao.ID.String()).Msg("notify verification decision")
+	}
+}
+
+// GetVerificationDecisions returns the page of moderator decisions of the dao
+func (s *Service) GetVerificationDecisions(id uuid.UUID, keyset pagination.Keyset, limit int) ([]VerificationDecision, error) {
+	list, err := s.repo.GetVerificationDecisions(id, keyset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("get verification decisions: %w", err)
+	}
+
+	return list, nil
+}
+
+func convertToVerificationPayload(dao Dao, decision VerificationDecision) VerificationChangedPayload {
+	return VerificationChangedPayload{
+		DaoID:             dao.ID,
+		Alias:             dao.OriginalID,
+		Status:            decision.Status,
+		PreviousStatus:    decision.PreviousStatus,
+		Comment:           decision.Comment,
+		Moderator:         decision.Moderator,
+		FungibleID:        decision.FungibleID,
+		FungibleIDCleared: decision.FungibleIDCleared,
+	}
+}
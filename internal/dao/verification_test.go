@@ -0,0 +1,121 @@
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/notifier"
+)
+
+func TestUnitDecideVerification(t *testing.T) {
+	pending := Dao{ID: id1, OriginalID: "dao.eth", FungibleId: "token", TokenSymbol: "TKN", VerificationStatus: VerificationPending}
+
+	for name, tc := range map[string]struct {
+		existed  Dao
+		decision Decision
+		expected Dao
+		payload  VerificationChangedPayload
+		err      error
+	}{
+		"approve": {
+			existed:  pending,
+			decision: Decision{DaoID: id1, Status: VerificationApproved, Comment: "ok", Moderator: "alice"},
+			expected: Dao{ID: id1, OriginalID: "dao.eth", FungibleId: "token", TokenSymbol: "TKN", VerificationStatus: VerificationApproved, VerificationComment: "ok"},
+			payload: VerificationChangedPayload{
+				DaoID: id1, Alias: "dao.eth", Status: VerificationApproved, PreviousStatus: VerificationPending,
+				Comment: "ok", Moderator: "alice", FungibleID: "token",
+			},
+		},
+		"decline and keep fungible id": {
+			existed:  pending,
+			decision: Decision{DaoID: id1, Status: VerificationDeclined, Comment: "scam", Moderator: "bob"},
+			expected: Dao{ID: id1, OriginalID: "dao.eth", FungibleId: "token", TokenSymbol: "TKN", VerificationStatus: VerificationDeclined, VerificationComment: "scam"},
+			payload: VerificationChangedPayload{
+				DaoID: id1, Alias: "dao.eth", Status: VerificationDeclined, PreviousStatus: VerificationPending,
+				Comment: "scam", Moderator: "bob", FungibleID: "token",
+			},
+		},
+		"decline and clear fungible id": {
+			existed:  pending,
+			decision: Decision{DaoID: id1, Status: VerificationDeclined, Comment: "wrong token", Moderator: "bob", ClearFungibleID: true},
+			expected: Dao{ID: id1, OriginalID: "dao.eth", VerificationStatus: VerificationDeclined, VerificationComment: "wrong token"},
+			payload: VerificationChangedPayload{
+				DaoID: id1, Alias: "dao.eth", Status: VerificationDeclined, PreviousStatus: VerificationPending,
+				Comment: "wrong token", Moderator: "bob", FungibleID: "token", FungibleIDCleared: true,
+			},
+		},
+		"approve without fungible id": {
+			existed:  Dao{ID: id1},
+			decision: Decision{DaoID: id1, Status: VerificationApproved, Moderator: "alice"},
+			err:      ErrNoFungibleID,
+		},
+		"invalid status": {
+			existed:  pending,
+			decision: Decision{DaoID: id1, Status: VerificationPending, Moderator: "alice"},
+			err:      ErrInvalidVerificationStatus,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dp := NewMockDataProvider(ctrl)
+			dp.EXPECT().CallInTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, cb func(context.Context) error) error {
+				return cb(ctx)
+			})
+			dp.EXPECT().GetByID(id1).AnyTimes().Return(&tc.existed, nil)
+
+			p := NewMockPublisher(ctrl)
+			n := NewMockNotifier(ctrl)
+			notified := make(chan struct{})
+			if tc.err == nil {
+				dp.EXPECT().UpdateVerification(gomock.Any(), tc.expected).Return(nil)
+				dp.EXPECT().CreateHistory(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, h History) error {
+					require.Equal(t, HistorySourceModerator+tc.decision.Moderator, h.Source)
+					return nil
+				})
+				dp.EXPECT().CreateVerificationDecision(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d VerificationDecision) error {
+					require.Equal(t, tc.payload, convertToVerificationPayload(tc.expected, d))
+					return nil
+				})
+				gomock.InOrder(
+					p.EXPECT().PublishJSON(gomock.Any(), SubjectDaoTokenVerificationChanged, tc.payload).Return(nil),
+					p.EXPECT().PublishJSON(gomock.Any(), "core.dao.updated", gomock.Any()).Return(nil),
+				)
+				n.EXPECT().Notify(gomock.Any(), notifier.KindVerificationDecision, notifier.VerificationDecision{
+					DaoAlias:          tc.payload.Alias,
+					Status:            tc.payload.Status,
+					Comment:           tc.payload.Comment,
+					Moderator:         tc.payload.Moderator,
+					FungibleID:        tc.payload.FungibleID,
+					FungibleIDCleared: tc.payload.FungibleIDCleared,
+				}).DoAndReturn(func(context.Context, string, any) error {
+					close(notified)
+					return nil
+				})
+			}
+
+			s, err := NewService(dp, nil, nil, p, nil, nil, nil, nil, nil, n)
+			require.NoError(t, err)
+
+			dao, err := s.DecideVerification(context.Background(), tc.decision)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, *dao)
+
+			select {
+			case <-notified:
+			case <-time.After(time.Second):
+				t.Fatal("verification decision is not notified")
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package internal
+
+import (
+	"log"
+	"os"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/config"
+)
+
+func openDB(cfg config.DB) (*gorm.DB, error) {
+	db, err := gorm.Open(postgres.Open(cfg.DSN), &gorm.Config{
+		Logger: logger.New(log.New(os.Stdout, "\r\n", log.LstdFlags), logger.Config{
+			SlowThreshold:             200 * time.Millisecond,
+			LogLevel:                  logger.Warn,
+			IgnoreRecordNotFoundError: true,
+			Colorful:                  false,
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ps, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	ps.SetMaxOpenConns(cfg.MaxOpenConnections)
+
+	if cfg.Debug {
+		return db.Debug(), nil
+	}
+
+	return db, nil
+}
@@ -8,6 +8,9 @@ import (
 	"github.com/google/uuid"
 	coreevents "github.com/goverland-labs/goverland-platform-events/events/core"
 	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/outbox"
 )
 
 const (
@@ -77,18 +80,25 @@ func (s *Service) checkLifeTime(ctx context.Context) error {
 func (s *Service) registerEventOnce(ctx context.Context, delegate MixedDelegation, subject string) {
 	group := fmt.Sprintf("delegation_%s_%s_%d_%s", subject, delegate.DaoID, delegate.LastBlockTimestamp, delegate.ProposalID)
 
-	var err error
 	if ok, err := s.er.EventExist(ctx, delegate.AddressTo, group, subject); ok || err != nil {
 		return
 	}
 
-	if err = s.publisher.PublishJSON(ctx, subject, convertToCoreEvent(delegate)); err != nil {
-		log.Error().Err(err).Msg("register delegate event")
-	}
+	err := s.repo.CallInTx(func(tx *gorm.DB) error {
+		ctx := outbox.WithKey(outbox.WithTx(ctx, tx), delegate.DaoID)
+		registered, err := s.er.RegisterEvent(ctx, delegate.AddressTo, group, subject)
+		if err != nil || !registered {
+			return err
+		}
 
-	if err = s.er.RegisterEvent(ctx, delegate.AddressTo, group, subject); err != nil {
+		if err := s.publisher.PublishJSON(ctx, subject, convertToCoreEvent(delegate)); err != nil {
+			return fmt.Errorf("publish: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
 		log.Error().Err(err).Msg("register delegate event")
-		return
 	}
 }
 
@@ -887,7 +887,7 @@ func (r *Repo) GetERC20Balance(
 ) (*ERC20Balance, error) {
 	var balance ERC20Balance
 	err := r.db.
-		Where("address = ? AND token = ? AND chain_id = ?", address, token, chainID).
+		Where("address = ? AND token = ? AND chain_id = ?", strings.ToLower(address), token, chainID).
 		First(&balance).
 		Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -907,7 +907,7 @@ func (r *Repo) UpsertERC20Balance(tx *gorm.DB, token, chainID, address string, d
 	balance := &ERC20Balance{
 		Token:   token,
 		ChainID: chainID,
-		Address: address,
+		Address: strings.ToLower(address),
 		Value:   deltaValue,
 	}
 
@@ -22,7 +22,7 @@ const (
 )
 
 type DaoSearcher interface {
-	GetByFilters(filters []dao.Filter) (dao.DaoList, error)
+	GetByFilters(filters []dao.Filter, count bool) (dao.DaoList, error)
 }
 
 type Server struct {
@@ -180,7 +180,7 @@ func (s *Server) GetTopDelegates(ctx context.Context, req *storagepb.GetTopDeleg
 	daoIDs := slices.Collect(maps.Keys(delegations))
 	daoList, err := s.ds.GetByFilters([]dao.Filter{
 		dao.DaoIDsFilter{DaoIDs: daoIDs},
-	})
+	}, false)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to get dao info")
 	}
@@ -258,7 +258,7 @@ func (s *Server) GetTopDelegators(ctx context.Context, req *storagepb.GetTopDele
 	daoIDs := slices.Collect(maps.Keys(delegators))
 	daoList, err := s.ds.GetByFilters([]dao.Filter{
 		dao.DaoIDsFilter{DaoIDs: daoIDs},
-	})
+	}, false)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to get dao info")
 	}
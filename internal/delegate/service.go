@@ -22,6 +22,7 @@ import (
 
 	"github.com/goverland-labs/goverland-core-storage/internal/dao"
 	"github.com/goverland-labs/goverland-core-storage/internal/ensresolver"
+	"github.com/goverland-labs/goverland-core-storage/internal/outbox"
 	"github.com/goverland-labs/goverland-core-storage/internal/proposal"
 )
 
@@ -49,7 +50,7 @@ type Publisher interface {
 
 type EventRegistered interface {
 	EventExist(_ context.Context, id, t, event string) (bool, error)
-	RegisterEvent(_ context.Context, id, t, event string) error
+	RegisterEvent(_ context.Context, id, t, event string) (bool, error)
 }
 
 type EnsResolver interface {
@@ -552,8 +553,8 @@ func (s *Service) handleSplitDelegation(ctx context.Context, hr History) error {
 				DaoID:     delegatedDao.ID,
 			}
 
-			if err = s.publisher.PublishJSON(ctx, events.SubjectDelegateCreated, event); err != nil {
-				logger.Warn().Err(err).Msgf("failed to publish delegate payload")
+			if err = s.publisher.PublishJSON(outbox.WithKey(outbox.WithTx(ctx, tx), delegatedDao.ID.String()), events.SubjectDelegateCreated, event); err != nil {
+				return fmt.Errorf("publish delegate payload: %w", err)
 			}
 		}
 
@@ -791,7 +792,7 @@ func (s *Service) handleProposalCreated(ctx context.Context, pr Proposal) error
 		DaoID:      daoID,
 		ProposalID: pr.ID,
 	}
-	if err = s.publisher.PublishJSON(ctx, events.SubjectDelegateCreateProposal, event); err != nil {
+	if err = s.publisher.PublishJSON(outbox.WithKey(ctx, pr.ID), events.SubjectDelegateCreateProposal, event); err != nil {
 		return fmt.Errorf("s.publisher.PublishJSON: %w", err)
 	}
 
@@ -822,7 +823,7 @@ func (s *Service) handleVotesCreated(ctx context.Context, batch []Vote) error {
 			ProposalID: info.ProposalID,
 		}
 
-		if err = s.publisher.PublishJSON(ctx, events.SubjectDelegateVotingVoted, event); err != nil {
+		if err = s.publisher.PublishJSON(outbox.WithKey(ctx, info.ProposalID), events.SubjectDelegateVotingVoted, event); err != nil {
 			log.Err(err).Msgf("publish delegate voted: %s %s", info.AddressTo, info.ProposalID)
 		}
 	}
@@ -2,6 +2,7 @@ package ensresolver
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -9,6 +10,8 @@ import (
 	"github.com/goverland-labs/goverland-helpers-ens-resolver/protocol/enspb"
 	coreevents "github.com/goverland-labs/goverland-platform-events/events/core"
 	"github.com/rs/zerolog/log"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/outbox"
 )
 
 const (
@@ -110,8 +113,11 @@ func (s *Service) processAddresses(list []string) {
 		return
 	}
 
-	if err := s.publisher.PublishJSON(ctx, coreevents.SubjectEnsResolverResolved, convertToCoreEvent(result)); err != nil {
-		log.Error().Err(err).Msgf("publish ens names event")
+	for _, item := range result {
+		ctx := outbox.WithKey(ctx, strings.ToLower(item.Address))
+		if err := s.publisher.PublishJSON(ctx, coreevents.SubjectEnsResolverResolved, convertToCoreEvent([]EnsName{item})); err != nil {
+			log.Error().Err(err).Msgf("publish ens name event: %s", item.Address)
+		}
 	}
 }
 
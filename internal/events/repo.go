@@ -1,9 +1,13 @@
 package events
 
 import (
+	"context"
 	"fmt"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/outbox"
 )
 
 type Repo struct {
@@ -14,8 +18,15 @@ func NewRepo(db *gorm.DB) *Repo {
 	return &Repo{db: db}
 }
 
-func (r *Repo) Create(e RegisteredEvent) error {
-	return r.db.Create(&e).Error
+// Create stores the event and reports if it's stored, the event registered before is skipped
+// without the error, so the transaction of the caller is not aborted by the unique constraint
+func (r *Repo) Create(ctx context.Context, e RegisteredEvent) (bool, error) {
+	res := outbox.Conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(&e)
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	return res.RowsAffected > 0, nil
 }
 
 func (r *Repo) Update(e RegisteredEvent) error {
@@ -15,7 +15,7 @@ const (
 
 // todo: add unit tests
 type DataProvider interface {
-	Create(RegisteredEvent) error
+	Create(context.Context, RegisteredEvent) (bool, error)
 	Update(RegisteredEvent) error
 	GetByTypeAndEvent(string, string, string) (*RegisteredEvent, error)
 	GetLast(limit int) ([]*RegisteredEvent, error)
@@ -79,22 +79,14 @@ func (s *Service) EventExist(_ context.Context, id, t, event string) (bool, erro
 	return false, err
 }
 
-func (s *Service) RegisterEvent(_ context.Context, id, t, event string) error {
-	err := s.repo.Create(RegisteredEvent{
+// RegisterEvent stores the event and reports if it's registered by this call, false means it was registered before.
+// The cache is filled on reading only, because the transaction of the caller might be rolled back.
+func (s *Service) RegisterEvent(ctx context.Context, id, t, event string) (bool, error) {
+	return s.repo.Create(ctx, RegisteredEvent{
 		Type:   t,
 		TypeID: id,
 		Event:  event,
 	})
-	if err != nil {
-		return err
-	}
-
-	key := prepareKey(id, t, event)
-	s.mu.Lock()
-	s.cache[key] = struct{}{}
-	s.mu.Unlock()
-
-	return nil
 }
 
 func prepareKey(id, t, event string) string {
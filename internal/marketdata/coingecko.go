@@ -0,0 +1,174 @@
+package marketdata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/goverland-labs/goverland-core-storage/pkg/sdk/coingecko"
+)
+
+type coinGeckoPlatform struct {
+	id      string
+	network string
+	name    string
+}
+
+// coinGeckoPlatforms maps asset platforms of coingecko to evm networks
+var coinGeckoPlatforms = []coinGeckoPlatform{
+	{id: "ethereum", network: "1", name: "Ethereum"},
+	{id: "optimistic-ethereum", network: "10", name: "Optimism"},
+	{id: "binance-smart-chain", network: "56", name: "BNB Chain"},
+	{id: "xdai", network: "100", name: "Gnosis"},
+	{id: "polygon-pos", network: "137", name: "Polygon"},
+	{id: "fantom", network: "250", name: "Fantom"},
+	{id: "zksync", network: "324", name: "zkSync"},
+	{id: "base", network: "8453", name: "Base"},
+	{id: "arbitrum-one", network: "42161", name: "Arbitrum"},
+	{id: "avalanche", network: "43114", name: "Avalanche"},
+	{id: "linea", network: "59144", name: "Linea"},
+	{id: "scroll", network: "534352", name: "Scroll"},
+}
+
+// coinGeckoDays maps chart periods to days of the market chart
+var coinGeckoDays = map[string]string{
+	"hour":    "1",
+	"day":     "1",
+	"week":    "7",
+	"month":   "30",
+	"3months": "90",
+	"6months": "180",
+	"year":    "365",
+	"5years":  "1825",
+	"max":     "max",
+}
+
+// CoinGeckoProvider adapts the coingecko client to the Provider
+type CoinGeckoProvider struct {
+	client *coingecko.Client
+}
+
+func NewCoinGeckoProvider(client *coingecko.Client) *CoinGeckoProvider {
+	return &CoinGeckoProvider{
+		client: client,
+	}
+}
+
+// FindByContract looks for the token on the platform of the network, unknown networks have no tokens
+func (p *CoinGeckoProvider) FindByContract(_ context.Context, network, address string) (*Token, error) {
+	var platform string
+	for _, info := range coinGeckoPlatforms {
+		if info.network == network {
+			platform = info.id
+			break
+		}
+	}
+
+	if platform == "" {
+		return nil, ErrNotFound
+	}
+
+	coin, err := p.client.GetCoinByContract(platform, address)
+	if errors.Is(err, coingecko.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get coin by contract: %w", err)
+	}
+
+	if coin.MarketData.CurrentPrice.USD == 0 {
+		return nil, ErrNotFound
+	}
+
+	return convertCoinToToken(coin), nil
+}
+
+func (p *CoinGeckoProvider) GetToken(_ context.Context, id string) (*Token, error) {
+	coin, err := p.client.GetCoin(id)
+	if errors.Is(err, coingecko.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get coin: %w", err)
+	}
+
+	return convertCoinToToken(coin), nil
+}
+
+func (p *CoinGeckoProvider) GetPrices(_ context.Context, ids []string) (map[string]float64, error) {
+	prices, err := p.client.GetPrices(ids)
+	if err != nil {
+		return nil, fmt.Errorf("get prices: %w", err)
+	}
+
+	return prices, nil
+}
+
+func (p *CoinGeckoProvider) GetChart(_ context.Context, id, period string) ([]Point, error) {
+	days, ok := coinGeckoDays[period]
+	if !ok {
+		return nil, fmt.Errorf("unknown period %s", period)
+	}
+
+	chart, err := p.client.GetMarketChart(id, days)
+	if errors.Is(err, coingecko.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get market chart: %w", err)
+	}
+
+	// the shortest chart of coingecko is the daily one
+	var from time.Time
+	if period == "hour" {
+		from = time.Now().Add(-time.Hour)
+	}
+
+	res := make([]Point, 0, len(chart.Prices))
+	for _, point := range chart.Prices {
+		if point.Time.Before(from) {
+			continue
+		}
+
+		res = append(res, Point{
+			Time:  point.Time,
+			Price: point.Price,
+		})
+	}
+
+	return res, nil
+}
+
+func convertCoinToToken(coin *coingecko.Coin) *Token {
+	token := &Token{
+		ID:     coin.ID,
+		Name:   coin.Name,
+		Symbol: strings.ToUpper(coin.Symbol),
+		Market: Market{
+			Price:                 coin.MarketData.CurrentPrice.USD,
+			TotalSupply:           coin.MarketData.TotalSupply,
+			CirculatingSupply:     coin.MarketData.CirculatingSupply,
+			MarketCap:             coin.MarketData.MarketCap.USD,
+			FullyDilutedValuation: coin.MarketData.FullyDilutedValuation.USD,
+		},
+	}
+
+	for _, info := range coinGeckoPlatforms {
+		detail, ok := coin.DetailPlatforms[info.id]
+		if !ok || detail.ContractAddress == "" {
+			continue
+		}
+
+		token.Chains = append(token.Chains, Chain{
+			ID:       info.id,
+			Network:  info.network,
+			Name:     info.name,
+			Address:  detail.ContractAddress,
+			Decimals: detail.DecimalPlace,
+		})
+	}
+
+	return token
+}
@@ -0,0 +1,229 @@
+package marketdata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Provider names, they are used in the configuration and as prefixes of token identifiers
+const (
+	Zerion    = "zerion"
+	CoinGecko = "coingecko"
+)
+
+// idSeparator separates the provider name and the token id of the provider.
+// Zerion ids are stored without the prefix as they were the only ones before providers were introduced.
+const idSeparator = ":"
+
+var ErrNotFound = errors.New("token not found")
+
+type Token struct {
+	// ID is the qualified identifier of the token, see QualifiedID
+	ID     string
+	Name   string
+	Symbol string
+	Market Market
+	Chains []Chain
+}
+
+type Market struct {
+	Price                 float64
+	TotalSupply           float64
+	CirculatingSupply     float64
+	MarketCap             float64
+	FullyDilutedValuation float64
+}
+
+// Chain is the implementation of the token on the chain
+type Chain struct {
+	// ID is the chain identifier of the provider
+	ID string
+	// Network is the decimal evm chain id, it's empty for non evm chains
+	Network  string
+	Name     string
+	IconURL  string
+	Address  string
+	Decimals int
+}
+
+type Point struct {
+	Time  time.Time
+	Price float64
+}
+
+// Provider returns the market data of tokens, identifiers are raw identifiers of the provider.
+// Chart periods are hour, day, week, month, 3months, 6months, year, 5years and max.
+type Provider interface {
+	// FindByContract returns the token by the contract address, network is the decimal evm chain id
+	FindByContract(ctx context.Context, network, address string) (*Token, error)
+	GetToken(ctx context.Context, id string) (*Token, error)
+	// GetPrices returns usd prices by token ids, unknown tokens are skipped
+	GetPrices(ctx context.Context, ids []string) (map[string]float64, error)
+	GetChart(ctx context.Context, id, period string) ([]Point, error)
+}
+
+// QualifiedID returns the identifier of the token which is routed to the provider by Providers
+func QualifiedID(provider, id string) string {
+	if provider == Zerion {
+		return id
+	}
+
+	return provider + idSeparator + id
+}
+
+func splitID(id string) (string, string) {
+	provider, raw, ok := strings.Cut(id, idSeparator)
+	if !ok {
+		return Zerion, id
+	}
+
+	return provider, raw
+}
+
+type namedProvider struct {
+	name     string
+	provider Provider
+}
+
+// Providers implements Provider on top of the list of providers.
+// Tokens are searched by contracts in the order of providers, other requests are routed by qualified identifiers.
+type Providers struct {
+	list []namedProvider
+}
+
+// NewProviders creates providers in the passed order, the first one is the primary provider
+func NewProviders(order []string, registered map[string]Provider) (*Providers, error) {
+	list := make([]namedProvider, 0, len(order))
+	for _, name := range order {
+		p, ok := registered[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown market data provider %s", name)
+		}
+
+		list = append(list, namedProvider{name: name, provider: p})
+	}
+
+	if len(list) == 0 {
+		return nil, errors.New("no market data providers")
+	}
+
+	return &Providers{list: list}, nil
+}
+
+func (p *Providers) FindByContract(ctx context.Context, network, address string) (*Token, error) {
+	var errs []error
+	for _, np := range p.list {
+		token, err := np.provider.FindByContract(ctx, network, address)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", np.name, err))
+			continue
+		}
+
+		token.ID = QualifiedID(np.name, token.ID)
+
+		return token, nil
+	}
+
+	if len(errs) != 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return nil, ErrNotFound
+}
+
+func (p *Providers) GetToken(ctx context.Context, id string) (*Token, error) {
+	name, raw := splitID(id)
+	provider, err := p.get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := provider.GetToken(ctx, raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	token.ID = id
+
+	return token, nil
+}
+
+// GetPrices groups identifiers by providers, prices are returned by qualified identifiers.
+// Failed providers are logged and skipped, so their tokens are missed in the result.
+func (p *Providers) GetPrices(ctx context.Context, ids []string) (map[string]float64, error) {
+	grouped := make(map[string][]string)
+	for _, id := range ids {
+		name, raw := splitID(id)
+		grouped[name] = append(grouped[name], raw)
+	}
+
+	res := make(map[string]float64, len(ids))
+	for name, list := range grouped {
+		prices, err := p.getPrices(ctx, name, list)
+		if err != nil {
+			log.Error().Err(err).Str("provider", name).Int("tokens", len(list)).Msg("get market data prices")
+
+			continue
+		}
+
+		for raw, price := range prices {
+			res[QualifiedID(name, raw)] = price
+		}
+	}
+
+	return res, nil
+}
+
+func (p *Providers) getPrices(ctx context.Context, name string, ids []string) (map[string]float64, error) {
+	provider, err := p.get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := provider.GetPrices(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return prices, nil
+}
+
+func (p *Providers) GetChart(ctx context.Context, id, period string) ([]Point, error) {
+	name, raw := splitID(id)
+	provider, err := p.get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := provider.GetChart(ctx, raw, period)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return points, nil
+}
+
+// Enabled checks that the provider is configured, identifiers of disabled providers are not served
+func (p *Providers) Enabled(name string) bool {
+	_, err := p.get(name)
+
+	return err == nil
+}
+
+func (p *Providers) get(name string) (Provider, error) {
+	for _, np := range p.list {
+		if np.name == name {
+			return np.provider, nil
+		}
+	}
+
+	return nil, fmt.Errorf("market data provider %s is not enabled", name)
+}
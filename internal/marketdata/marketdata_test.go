@@ -0,0 +1,209 @@
+package marketdata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/goverland-labs/goverland-core-storage/pkg/sdk/coingecko"
+)
+
+type providerStub struct {
+	tokens map[string]Token
+	err    error
+}
+
+func (p providerStub) FindByContract(_ context.Context, _, address string) (*Token, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	for _, token := range p.tokens {
+		for _, chain := range token.Chains {
+			if chain.Address == address {
+				return &token, nil
+			}
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+func (p providerStub) GetToken(_ context.Context, id string) (*Token, error) {
+	token, ok := p.tokens[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return &token, nil
+}
+
+func (p providerStub) GetPrices(_ context.Context, ids []string) (map[string]float64, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	res := make(map[string]float64)
+	for _, id := range ids {
+		if token, ok := p.tokens[id]; ok {
+			res[id] = token.Market.Price
+		}
+	}
+
+	return res, nil
+}
+
+func (p providerStub) GetChart(_ context.Context, _, _ string) ([]Point, error) {
+	return nil, nil
+}
+
+func TestUnitProvidersFindByContract(t *testing.T) {
+	zerion := providerStub{tokens: map[string]Token{
+		"uni": {ID: "uni", Symbol: "UNI", Chains: []Chain{{Address: "0x1"}}},
+	}}
+	gecko := providerStub{tokens: map[string]Token{
+		"uniswap": {ID: "uniswap", Symbol: "UNI", Chains: []Chain{{Address: "0x1"}}},
+		"safe":    {ID: "safe", Symbol: "SAFE", Chains: []Chain{{Address: "0x2"}}},
+	}}
+
+	for name, tc := range map[string]struct {
+		order    []string
+		zerion   Provider
+		address  string
+		expected string
+		err      error
+	}{
+		"primary listing": {
+			order:    []string{Zerion, CoinGecko},
+			zerion:   zerion,
+			address:  "0x1",
+			expected: "uni",
+		},
+		"fallback listing": {
+			order:    []string{Zerion, CoinGecko},
+			zerion:   zerion,
+			address:  "0x2",
+			expected: "coingecko:safe",
+		},
+		"fallback on primary errors": {
+			order:    []string{Zerion, CoinGecko},
+			zerion:   providerStub{err: errors.New("unavailable")},
+			address:  "0x1",
+			expected: "coingecko:uniswap",
+		},
+		"configured order": {
+			order:    []string{CoinGecko, Zerion},
+			zerion:   zerion,
+			address:  "0x1",
+			expected: "coingecko:uniswap",
+		},
+		"not found": {
+			order:   []string{Zerion},
+			zerion:  zerion,
+			address: "0x2",
+			err:     ErrNotFound,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			p, err := NewProviders(tc.order, map[string]Provider{Zerion: tc.zerion, CoinGecko: gecko})
+			require.NoError(t, err)
+
+			token, err := p.FindByContract(context.Background(), "1", tc.address)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, token.ID)
+		})
+	}
+}
+
+func TestUnitProvidersRouting(t *testing.T) {
+	p, err := NewProviders([]string{Zerion, CoinGecko}, map[string]Provider{
+		Zerion: providerStub{tokens: map[string]Token{
+			"uni": {ID: "uni", Market: Market{Price: 7}},
+		}},
+		CoinGecko: providerStub{tokens: map[string]Token{
+			"safe": {ID: "safe", Market: Market{Price: 0.5}},
+		}},
+	})
+	require.NoError(t, err)
+
+	token, err := p.GetToken(context.Background(), "coingecko:safe")
+	require.NoError(t, err)
+	require.Equal(t, "coingecko:safe", token.ID)
+
+	prices, err := p.GetPrices(context.Background(), []string{"uni", "coingecko:safe", "coingecko:unknown"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]float64{"uni": 7, "coingecko:safe": 0.5}, prices)
+
+	_, err = p.GetToken(context.Background(), "unknown:token")
+	require.Error(t, err)
+
+	_, err = NewProviders([]string{"unknown"}, nil)
+	require.Error(t, err)
+}
+
+func TestUnitProvidersPricesOfFailedProvider(t *testing.T) {
+	p, err := NewProviders([]string{Zerion, CoinGecko}, map[string]Provider{
+		Zerion: providerStub{err: errors.New("unexpected error")},
+		CoinGecko: providerStub{tokens: map[string]Token{
+			"safe": {ID: "safe", Market: Market{Price: 0.5}},
+		}},
+	})
+	require.NoError(t, err)
+
+	prices, err := p.GetPrices(context.Background(), []string{"uni", "coingecko:safe", "unknown:token"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]float64{"coingecko:safe": 0.5}, prices)
+}
+
+func TestUnitCoinGeckoProvider(t *testing.T) {
+	now := time.Now()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/coins/ethereum/contract/0xabc":
+			_, _ = fmt.Fprint(w, `{"id":"token","symbol":"tkn","name":"Token",
+				"detail_platforms":{"ethereum":{"decimal_place":18,"contract_address":"0xabc"},"solana":{"contract_address":"So1"}},
+				"market_data":{"current_price":{"usd":2.5},"total_supply":1000}}`)
+		case "/coins/token/market_chart":
+			require.Equal(t, "1", r.URL.Query().Get("days"))
+			_, _ = fmt.Fprintf(w, `{"prices":[[%d,1],[%d,2]]}`, now.Add(-2*time.Hour).UnixMilli(), now.Add(-time.Minute).UnixMilli())
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	p := NewCoinGeckoProvider(coingecko.NewClient(srv.URL, "", "", srv.Client()))
+
+	token, err := p.FindByContract(context.Background(), "1", "0xABC")
+	require.NoError(t, err)
+	require.Equal(t, &Token{
+		ID:     "token",
+		Name:   "Token",
+		Symbol: "TKN",
+		Market: Market{Price: 2.5, TotalSupply: 1000},
+		Chains: []Chain{{ID: "ethereum", Network: "1", Name: "Ethereum", Address: "0xabc", Decimals: 18}},
+	}, token)
+
+	_, err = p.FindByContract(context.Background(), "1", "0xdef")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = p.FindByContract(context.Background(), "31337", "0xabc")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	points, err := p.GetChart(context.Background(), "token", "hour")
+	require.NoError(t, err)
+	require.Len(t, points, 1)
+	require.Equal(t, 2.0, points[0].Price)
+}
@@ -0,0 +1,146 @@
+package marketdata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/goverland-labs/goverland-core-storage/pkg/sdk/zerion"
+)
+
+// ZerionProvider adapts the zerion client to the Provider
+type ZerionProvider struct {
+	client *zerion.Client
+}
+
+func NewZerionProvider(client *zerion.Client) *ZerionProvider {
+	return &ZerionProvider{
+		client: client,
+	}
+}
+
+// FindByContract looks for the token on all chains, the network is ignored.
+// Tokens without the price are treated as unknown ones.
+func (p *ZerionProvider) FindByContract(ctx context.Context, _, address string) (*Token, error) {
+	list, err := p.client.GetFungibleList(ctx, "", address)
+	if err != nil {
+		return nil, fmt.Errorf("get fungible list: %w", err)
+	}
+
+	if len(list.List) != 1 || list.List[0].Attributes.MarketData.Price == 0 {
+		return nil, ErrNotFound
+	}
+
+	return p.convertToToken(ctx, list.List[0]), nil
+}
+
+func (p *ZerionProvider) GetToken(ctx context.Context, id string) (*Token, error) {
+	data, err := p.client.GetFungibleData(ctx, id)
+	if errors.Is(err, zerion.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get fungible data: %w", err)
+	}
+
+	return p.convertToToken(ctx, *data), nil
+}
+
+func (p *ZerionProvider) GetPrices(ctx context.Context, ids []string) (map[string]float64, error) {
+	list, err := p.client.GetFungibleList(ctx, strings.Join(ids, ","), "")
+	if err != nil {
+		return nil, fmt.Errorf("get fungible list: %w", err)
+	}
+
+	res := make(map[string]float64, len(list.List))
+	for _, data := range list.List {
+		res[data.ID] = data.Attributes.MarketData.Price
+	}
+
+	return res, nil
+}
+
+func (p *ZerionProvider) GetChart(ctx context.Context, id, period string) ([]Point, error) {
+	data, err := p.client.GetFungibleChart(ctx, id, period)
+	if errors.Is(err, zerion.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get fungible chart: %w", err)
+	}
+
+	res := make([]Point, len(data.ChartAttributes.Points))
+	for i, point := range data.ChartAttributes.Points {
+		res[i] = Point{
+			Time:  point.Time,
+			Price: point.Price,
+		}
+	}
+
+	return res, nil
+}
+
+func (p *ZerionProvider) convertToToken(ctx context.Context, data zerion.FungibleData) *Token {
+	chains := p.getChains(ctx)
+
+	token := &Token{
+		ID:     data.ID,
+		Name:   data.Attributes.Name,
+		Symbol: data.Attributes.Symbol,
+		Market: Market{
+			Price:                 data.Attributes.MarketData.Price,
+			TotalSupply:           data.Attributes.MarketData.TotalSupply,
+			CirculatingSupply:     data.Attributes.MarketData.CirculatingSupply,
+			MarketCap:             data.Attributes.MarketData.MarketCap,
+			FullyDilutedValuation: data.Attributes.MarketData.FullyDilutedValuation,
+		},
+		Chains: make([]Chain, 0, len(data.Attributes.Implementations)),
+	}
+
+	for _, impl := range data.Attributes.Implementations {
+		chain := Chain{
+			ID:       impl.ChainID,
+			Address:  impl.Address,
+			Decimals: impl.Decimals,
+		}
+
+		if info, ok := chains[impl.ChainID]; ok {
+			chain.Name = info.Attributes.Name
+			chain.IconURL = info.Attributes.Icon.URL
+			chain.Network = HexToNetwork(info.Attributes.ExternalID)
+		}
+
+		token.Chains = append(token.Chains, chain)
+	}
+
+	return token
+}
+
+// getChains returns chains by ids, chains are cached by the client.
+// Tokens are returned without chain details if chains can't be fetched.
+func (p *ZerionProvider) getChains(ctx context.Context) map[string]zerion.ChainData {
+	list, err := p.client.GetChains(ctx)
+	if err != nil {
+		return nil
+	}
+
+	chains := make(map[string]zerion.ChainData, len(list))
+	for _, chain := range list {
+		chains[chain.ID] = chain
+	}
+
+	return chains
+}
+
+// HexToNetwork converts the external chain id like 0x89 to the decimal one
+func HexToNetwork(externalID string) string {
+	cutHex, _ := strings.CutPrefix(strings.ToLower(externalID), "0x")
+	val, err := strconv.ParseInt(cutHex, 16, 64)
+	if err != nil {
+		return ""
+	}
+
+	return strconv.FormatInt(val, 10)
+}
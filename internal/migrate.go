@@ -0,0 +1,73 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/config"
+	"github.com/goverland-labs/goverland-core-storage/internal/migration"
+	"github.com/goverland-labs/goverland-core-storage/resources"
+)
+
+// Migrate handles the migrate subcommand:
+//
+//	migrate [up]            apply all pending migrations
+//	migrate status          show applied and pending migrations
+//	migrate baseline <ver>  mark migrations up to the version as applied without executing them
+func Migrate(cfg config.App, args []string) error {
+	db, err := openDB(cfg.DB)
+	if err != nil {
+		return fmt.Errorf("open db: %w", err)
+	}
+
+	ms, err := migration.NewService(migration.NewRepo(db), resources.Migrations)
+	if err != nil {
+		return fmt.Errorf("migration.NewService: %w", err)
+	}
+
+	cmd := "up"
+	if len(args) > 0 {
+		cmd = args[0]
+	}
+
+	ctx := context.Background()
+	switch cmd {
+	case "up":
+		applied, err := ms.Up(ctx)
+		if err != nil {
+			return err
+		}
+
+		log.Info().Int("applied", applied).Msg("migrations are applied")
+	case "status":
+		status, err := ms.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range status.Applied {
+			log.Info().Str("migration", m.Name).Time("applied_at", m.AppliedAt).Msg("applied")
+		}
+		for _, m := range status.Pending {
+			log.Info().Str("migration", m.Name).Bool("transactional", m.Transactional).Msg("pending")
+		}
+	case "baseline":
+		if len(args) < 2 {
+			return fmt.Errorf("baseline version is required")
+		}
+
+		version, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("parse baseline version: %w", err)
+		}
+
+		return ms.Baseline(ctx, version)
+	default:
+		return fmt.Errorf("unknown migrate command: %s", cmd)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package migration
+
+import (
+	"time"
+)
+
+// Migration is the single SQL file from the resources folder
+type Migration struct {
+	// Version is parsed from the V{n}_ prefix, the base schema has zero version
+	Version int
+	// Name is the file name without extension, it is unique in contrast to the version
+	Name     string
+	Checksum string
+	// Statements are separated SQL statements without comments
+	Statements []string
+	// Transactional is false for files which contains CONCURRENTLY statements
+	// or manages the transaction by itself
+	Transactional bool
+}
+
+type AppliedMigration struct {
+	Name      string `gorm:"primary_key"`
+	Version   int
+	Checksum  string
+	AppliedAt time.Time
+}
+
+func (AppliedMigration) TableName() string {
+	return "schema_migrations"
+}
+
+type Status struct {
+	Applied []AppliedMigration
+	Pending []Migration
+}
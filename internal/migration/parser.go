@@ -0,0 +1,173 @@
+package migration
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const baseSchemaFile = "schema.sql"
+
+var (
+	fileNameRegexp    = regexp.MustCompile(`^V(\d+)_([a-zA-Z0-9_]+)\.sql$`)
+	dollarTagRegexp   = regexp.MustCompile(`^\$([a-zA-Z_][a-zA-Z0-9_]*)?\$`)
+	concurrentlyRegex = regexp.MustCompile(`(?i)\bconcurrently\b`)
+
+	txControlKeywords = map[string]struct{}{
+		"begin":    {},
+		"start":    {},
+		"commit":   {},
+		"end":      {},
+		"rollback": {},
+	}
+)
+
+// Load reads all migrations from the fsys root and returns them in applying order:
+// by version and by name for the files with the same version
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read dir: %w", err)
+	}
+
+	list := make([]Migration, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+			continue
+		}
+
+		version, err := parseVersion(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read file %s: %w", entry.Name(), err)
+		}
+
+		list = append(list, newMigration(version, strings.TrimSuffix(entry.Name(), ".sql"), string(body)))
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].Version != list[j].Version {
+			return list[i].Version < list[j].Version
+		}
+
+		return list[i].Name < list[j].Name
+	})
+
+	return list, nil
+}
+
+func parseVersion(fileName string) (int, error) {
+	if fileName == baseSchemaFile {
+		return 0, nil
+	}
+
+	matches := fileNameRegexp.FindStringSubmatch(fileName)
+	if matches == nil {
+		return 0, fmt.Errorf("unexpected migration file name: %s", fileName)
+	}
+
+	version, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, fmt.Errorf("parse version %s: %w", fileName, err)
+	}
+
+	return version, nil
+}
+
+func newMigration(version int, name, body string) Migration {
+	sum := sha256.Sum256([]byte(body))
+	statements := splitStatements(body)
+
+	transactional := true
+	for _, st := range statements {
+		if concurrentlyRegex.MatchString(st) || isTxControl(st) {
+			transactional = false
+			break
+		}
+	}
+
+	return Migration{
+		Version:       version,
+		Name:          name,
+		Checksum:      hex.EncodeToString(sum[:]),
+		Statements:    statements,
+		Transactional: transactional,
+	}
+}
+
+func isTxControl(statement string) bool {
+	keyword, _, _ := strings.Cut(statement, " ")
+	_, ok := txControlKeywords[strings.ToLower(strings.TrimSpace(keyword))]
+
+	return ok
+}
+
+// splitStatements splits the SQL script by semicolons and drops comments.
+// Quoted strings, identifiers and dollar-quoted bodies are kept as is.
+func splitStatements(body string) []string {
+	var (
+		result  []string
+		current strings.Builder
+	)
+
+	flush := func() {
+		st := strings.TrimSpace(current.String())
+		if st != "" {
+			result = append(result, st)
+		}
+		current.Reset()
+	}
+
+	for i := 0; i < len(body); {
+		rest := body[i:]
+		switch {
+		case strings.HasPrefix(rest, "--"):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			current.WriteByte('\n')
+			i += end + 1
+		case strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest[2:], "*/")
+			if end < 0 {
+				end = len(rest) - 4
+			}
+			current.WriteByte(' ')
+			i += end + 4
+		case rest[0] == '\'' || rest[0] == '"':
+			end := strings.IndexByte(rest[1:], rest[0])
+			if end < 0 {
+				end = len(rest) - 2
+			}
+			current.WriteString(rest[:end+2])
+			i += end + 2
+		case rest[0] == '$' && dollarTagRegexp.MatchString(rest):
+			tag := dollarTagRegexp.FindString(rest)
+			end := strings.Index(rest[len(tag):], tag)
+			if end < 0 {
+				end = len(rest) - 2*len(tag)
+			}
+			current.WriteString(rest[:end+2*len(tag)])
+			i += end + 2*len(tag)
+		case rest[0] == ';':
+			flush()
+			i++
+		default:
+			current.WriteByte(rest[0])
+			i++
+		}
+	}
+	flush()
+
+	return result
+}
@@ -0,0 +1,118 @@
+package migration
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goverland-labs/goverland-core-storage/resources"
+)
+
+func TestUnitSplitStatements(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in  string
+		out []string
+	}{
+		"empty": {
+			in:  "-- only comment\n",
+			out: nil,
+		},
+		"comments are removed": {
+			in:  "-- create column\nalter table daos add column x int; /* second */ update daos set x = 1;",
+			out: []string{"alter table daos add column x int", "update daos set x = 1"},
+		},
+		"semicolon in quotes": {
+			in:  `update daos set name = 'a;b' where "weird;col" = 1;`,
+			out: []string{`update daos set name = 'a;b' where "weird;col" = 1`},
+		},
+		"dollar quoted body": {
+			in:  "do\n$$\nbegin\n  delete from votes; -- keep\nend;\n$$;\nselect 1",
+			out: []string{"do\n$$\nbegin\n  delete from votes; -- keep\nend;\n$$", "select 1"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.out, splitStatements(tc.in))
+		})
+	}
+}
+
+func TestUnitLoad(t *testing.T) {
+	list, err := Load(fstest.MapFS{
+		"schema.sql":                     {Data: []byte("create table daos (id uuid);")},
+		"V2_second.sql":                  {Data: []byte("create index concurrently idx on daos (id);")},
+		"V1_b.sql":                       {Data: []byte("begin; update daos set id = id; commit;")},
+		"V1_a.sql":                       {Data: []byte("alter table daos add column x int;")},
+		"migrations.go":                  {Data: []byte("package resources")},
+		"V10_after_second_by_number.sql": {Data: []byte("select 1;")},
+	})
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(list))
+	for _, m := range list {
+		names = append(names, m.Name)
+	}
+	assert.Equal(t, []string{"schema", "V1_a", "V1_b", "V2_second", "V10_after_second_by_number"}, names)
+	assert.True(t, list[1].Transactional)
+	assert.False(t, list[2].Transactional)
+	assert.False(t, list[3].Transactional)
+
+	_, err = Load(fstest.MapFS{"V1-wrong.sql": {Data: []byte("select 1;")}})
+	assert.Error(t, err)
+}
+
+func TestUnitLoadResources(t *testing.T) {
+	list, err := Load(resources.Migrations)
+	require.NoError(t, err)
+	assert.NotEmpty(t, list)
+}
+
+func TestUnitPlan(t *testing.T) {
+	known := []Migration{
+		{Version: 1, Name: "V1_a", Checksum: "a"},
+		{Version: 2, Name: "V2_b", Checksum: "b"},
+	}
+
+	for name, tc := range map[string]struct {
+		applied []AppliedMigration
+		pending []string
+		err     error
+	}{
+		"fresh database": {
+			pending: []string{"V1_a", "V2_b"},
+		},
+		"partially applied": {
+			applied: []AppliedMigration{{Version: 1, Name: "V1_a", Checksum: "a"}},
+			pending: []string{"V2_b"},
+		},
+		"checksum drifted": {
+			applied: []AppliedMigration{{Version: 1, Name: "V1_a", Checksum: "changed"}},
+			err:     ErrChecksumMismatch,
+		},
+		"schema is ahead": {
+			applied: []AppliedMigration{{Version: 3, Name: "V3_c", Checksum: "c"}},
+			err:     ErrSchemaAhead,
+		},
+		"unknown migration": {
+			applied: []AppliedMigration{{Version: 1, Name: "V1_renamed", Checksum: "a"}},
+			err:     ErrUnknownMigration,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			pending, err := plan(known, tc.applied)
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err))
+				return
+			}
+
+			require.NoError(t, err)
+			names := make([]string, 0, len(pending))
+			for _, m := range pending {
+				names = append(names, m.Name)
+			}
+			assert.Equal(t, tc.pending, names)
+		})
+	}
+}
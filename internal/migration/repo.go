@@ -0,0 +1,100 @@
+package migration
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// advisoryLockID protects from applying migrations by several instances at the same time
+const advisoryLockID = 7_262_019
+
+type Repo struct {
+	db *gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// CallInLock pins one connection from the pool and holds the advisory lock on it while cb is running.
+// Pinning is required for statements which have to be executed outside the transaction.
+func (r *Repo) CallInLock(cb func(conn *gorm.DB) error) error {
+	return r.db.Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec("select pg_advisory_lock(?)", advisoryLockID).Error; err != nil {
+			return fmt.Errorf("acquire lock: %w", err)
+		}
+		defer conn.Exec("select pg_advisory_unlock(?)", advisoryLockID)
+
+		return cb(conn)
+	})
+}
+
+func (r *Repo) CreateTable(conn *gorm.DB) error {
+	var (
+		dummy AppliedMigration
+		_     = dummy.Name
+		_     = dummy.Version
+		_     = dummy.Checksum
+		_     = dummy.AppliedAt
+	)
+
+	return conn.Exec(`
+		create table if not exists schema_migrations
+		(
+			name       text                     not null primary key,
+			version    integer                  not null,
+			checksum   text                     not null,
+			applied_at timestamp with time zone not null default now()
+		)`).Error
+}
+
+func (r *Repo) GetApplied(conn *gorm.DB) ([]AppliedMigration, error) {
+	var list []AppliedMigration
+	if err := conn.Order("version, name").Find(&list).Error; err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (r *Repo) MarkApplied(conn *gorm.DB, m Migration) error {
+	return conn.Create(&AppliedMigration{
+		Name:      m.Name,
+		Version:   m.Version,
+		Checksum:  m.Checksum,
+		AppliedAt: time.Now(),
+	}).Error
+}
+
+// Apply executes the migration statements and stores the migration as applied.
+// Non-transactional migrations are executed statement by statement on the pinned connection,
+// so the failed one has to be fixed manually.
+func (r *Repo) Apply(conn *gorm.DB, m Migration) error {
+	if !m.Transactional {
+		if err := execStatements(conn, m.Statements); err != nil {
+			return err
+		}
+
+		return r.MarkApplied(conn, m)
+	}
+
+	return conn.Transaction(func(tx *gorm.DB) error {
+		if err := execStatements(tx, m.Statements); err != nil {
+			return err
+		}
+
+		return r.MarkApplied(tx, m)
+	})
+}
+
+func execStatements(conn *gorm.DB, statements []string) error {
+	for i, st := range statements {
+		if err := conn.Exec(st).Error; err != nil {
+			return fmt.Errorf("statement #%d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
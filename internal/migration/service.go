@@ -0,0 +1,178 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrSchemaAhead      = errors.New("database schema is ahead of the application")
+	ErrUnknownMigration = errors.New("applied migration is not found in the application")
+	ErrChecksumMismatch = errors.New("applied migration checksum mismatch")
+)
+
+type Service struct {
+	repo       *Repo
+	migrations []Migration
+}
+
+func NewService(repo *Repo, fsys fs.FS) (*Service, error) {
+	list, err := Load(fsys)
+	if err != nil {
+		return nil, fmt.Errorf("load migrations: %w", err)
+	}
+
+	return &Service{
+		repo:       repo,
+		migrations: list,
+	}, nil
+}
+
+// Status validates applied migrations against the known ones and returns the pending list
+func (s *Service) Status(ctx context.Context) (Status, error) {
+	var status Status
+	err := s.repo.CallInLock(func(conn *gorm.DB) error {
+		var err error
+		status, err = s.status(conn.WithContext(ctx))
+
+		return err
+	})
+
+	return status, err
+}
+
+// Check returns an error if the database schema can't be used by the application.
+// Pending migrations are only reported because they could be applied by the migrate command.
+func (s *Service) Check(ctx context.Context) error {
+	status, err := s.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range status.Pending {
+		log.Warn().Str("migration", m.Name).Msg("migration is not applied")
+	}
+
+	return nil
+}
+
+// Up applies all pending migrations in order and returns the number of applied ones
+func (s *Service) Up(ctx context.Context) (int, error) {
+	applied := 0
+	err := s.repo.CallInLock(func(conn *gorm.DB) error {
+		conn = conn.WithContext(ctx)
+
+		status, err := s.status(conn)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range status.Pending {
+			log.Info().Str("migration", m.Name).Msg("applying migration")
+
+			if err := s.repo.Apply(conn, m); err != nil {
+				return fmt.Errorf("apply %s: %w", m.Name, err)
+			}
+
+			applied++
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Baseline marks migrations up to the version (inclusive) as applied without executing them.
+// It is used for databases where migrations were applied by hand and does nothing
+// if any migration is already recorded.
+func (s *Service) Baseline(ctx context.Context, version int) error {
+	return s.repo.CallInLock(func(conn *gorm.DB) error {
+		conn = conn.WithContext(ctx)
+
+		status, err := s.status(conn)
+		if err != nil {
+			return err
+		}
+
+		if len(status.Applied) > 0 {
+			return nil
+		}
+
+		return conn.Transaction(func(tx *gorm.DB) error {
+			for _, m := range status.Pending {
+				if m.Version > version {
+					break
+				}
+
+				if err := s.repo.MarkApplied(tx, m); err != nil {
+					return fmt.Errorf("mark %s: %w", m.Name, err)
+				}
+
+				log.Info().Str("migration", m.Name).Msg("migration marked as applied")
+			}
+
+			return nil
+		})
+	})
+}
+
+func (s *Service) status(conn *gorm.DB) (Status, error) {
+	if err := s.repo.CreateTable(conn); err != nil {
+		return Status{}, fmt.Errorf("s.repo.CreateTable: %w", err)
+	}
+
+	applied, err := s.repo.GetApplied(conn)
+	if err != nil {
+		return Status{}, fmt.Errorf("s.repo.GetApplied: %w", err)
+	}
+
+	pending, err := plan(s.migrations, applied)
+	if err != nil {
+		return Status{}, err
+	}
+
+	return Status{
+		Applied: applied,
+		Pending: pending,
+	}, nil
+}
+
+// plan compares known migrations with applied ones and returns the pending list in applying order
+func plan(known []Migration, applied []AppliedMigration) ([]Migration, error) {
+	latest := 0
+	byName := make(map[string]Migration, len(known))
+	for _, m := range known {
+		byName[m.Name] = m
+		latest = max(latest, m.Version)
+	}
+
+	done := make(map[string]struct{}, len(applied))
+	for _, a := range applied {
+		m, ok := byName[a.Name]
+		switch {
+		case !ok && a.Version > latest:
+			return nil, fmt.Errorf("%w: %s applied, latest known version is %d", ErrSchemaAhead, a.Name, latest)
+		case !ok:
+			return nil, fmt.Errorf("%w: %s", ErrUnknownMigration, a.Name)
+		case m.Checksum != a.Checksum:
+			return nil, fmt.Errorf("%w: %s", ErrChecksumMismatch, a.Name)
+		}
+
+		done[a.Name] = struct{}{}
+	}
+
+	pending := make([]Migration, 0, len(known)-len(done))
+	for _, m := range known {
+		if _, ok := done[m.Name]; !ok {
+			pending = append(pending, m)
+		}
+	}
+
+	return pending, nil
+}
@@ -0,0 +1,17 @@
+package notifier
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/metrics"
+)
+
+var metricNotificationsCounter = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "notifier",
+		Name:      "notifications_total",
+		Help:      "Number of sent notifications by kinds, backends and results",
+	}, []string{"kind", "backend", "result"},
+)
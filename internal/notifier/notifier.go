@@ -0,0 +1,167 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Kinds of notifications, they are used in routes and as names of templates
+const (
+	KindTokenFound           = "token_found"
+	KindVerificationDecision = "verification_decision"
+	KindWorkerFailure        = "worker_failure"
+)
+
+// Backend names which are used in routes
+const (
+	BackendDiscord              = "discord"
+	BackendDiscordVerification  = "discord_verification"
+	BackendDiscordWorkerFailure = "discord_worker_failure"
+	BackendSlack                = "slack"
+	BackendWebhook              = "webhook"
+)
+
+// templateExt is the extension of template files which override default templates
+const templateExt = ".tmpl"
+
+// Message is the rendered notification
+type Message struct {
+	Kind string
+	Text string
+	// Data is the source of the text, it's passed as is to backends which send structured payloads
+	Data any
+}
+
+// Backend delivers rendered messages to the destination
+type Backend interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+type Config struct {
+	// Routes is the list of kind:backend pairs, the kind can be routed to several backends
+	Routes []string
+	// TemplatesDir contains <kind>.tmpl files which override default templates, it's optional
+	TemplatesDir string
+}
+
+// Notifier renders notifications by templates and sends them to backends by routes of the kind
+type Notifier struct {
+	templates *template.Template
+	routes    map[string][]namedBackend
+}
+
+type namedBackend struct {
+	name    string
+	backend Backend
+}
+
+// New creates the notifier, routes to backends which are not passed are skipped as not configured ones
+func New(cfg Config, backends map[string]Backend) (*Notifier, error) {
+	templates, err := parseTemplates(cfg.TemplatesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	n := &Notifier{
+		templates: templates,
+		routes:    make(map[string][]namedBackend),
+	}
+
+	for _, route := range cfg.Routes {
+		kind, name, ok := strings.Cut(strings.TrimSpace(route), ":")
+		if !ok || kind == "" || name == "" {
+			return nil, fmt.Errorf("invalid route %q", route)
+		}
+
+		if _, known := defaultTemplates[kind]; !known {
+			return nil, fmt.Errorf("route %q: unknown kind %s", route, kind)
+		}
+
+		backend, ok := backends[name]
+		if !ok {
+			log.Warn().Str("kind", kind).Str("backend", name).Msg("notification backend is not configured, route is skipped")
+			continue
+		}
+
+		n.routes[kind] = append(n.routes[kind], namedBackend{name: name, backend: backend})
+	}
+
+	return n, nil
+}
+
+// Notify renders the data by the template of the kind and sends it to all routed backends.
+// Kinds without routes are skipped silently.
+func (n *Notifier) Notify(ctx context.Context, kind string, data any) error {
+	routes := n.routes[kind]
+	if len(routes) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := n.templates.ExecuteTemplate(&buf, kind, data); err != nil {
+		return fmt.Errorf("render %s: %w", kind, err)
+	}
+
+	msg := Message{
+		Kind: kind,
+		Text: strings.TrimSpace(buf.String()),
+		Data: data,
+	}
+
+	var errs []error
+	for _, route := range routes {
+		err := route.backend.Send(ctx, msg)
+		metricNotificationsCounter.WithLabelValues(kind, route.name, resultLabel(err)).Inc()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", route.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// parseTemplates parses default templates and overrides them by files from the dir
+func parseTemplates(dir string) (*template.Template, error) {
+	root := template.New("").Funcs(templateFuncs)
+	for kind, text := range defaultTemplates {
+		if _, err := root.New(kind).Parse(text); err != nil {
+			return nil, fmt.Errorf("parse default template %s: %w", kind, err)
+		}
+	}
+
+	if dir == "" {
+		return root, nil
+	}
+
+	for kind := range defaultTemplates {
+		text, err := os.ReadFile(filepath.Join(dir, kind+templateExt))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read template %s: %w", kind, err)
+		}
+
+		if _, err = root.New(kind).Parse(string(text)); err != nil {
+			return nil, fmt.Errorf("parse template %s: %w", kind, err)
+		}
+	}
+
+	return root, nil
+}
+
+func resultLabel(err error) string {
+	if err != nil {
+		return "error"
+	}
+
+	return "ok"
+}
@@ -0,0 +1,120 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type backendStub struct {
+	sent []Message
+	err  error
+}
+
+func (b *backendStub) Send(_ context.Context, msg Message) error {
+	b.sent = append(b.sent, msg)
+
+	return b.err
+}
+
+func TestUnitNotify(t *testing.T) {
+	discord := &backendStub{}
+	slack := &backendStub{err: errors.New("unavailable")}
+
+	n, err := New(Config{Routes: []string{
+		"token_found:discord",
+		"worker_failure:discord",
+		"worker_failure:slack",
+		"verification_decision:webhook",
+	}}, map[string]Backend{BackendDiscord: discord, BackendSlack: slack})
+	require.NoError(t, err)
+
+	err = n.Notify(context.Background(), KindTokenFound, TokenFound{
+		DaoAlias:    "dao.eth",
+		CreatedAt:   time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+		FungibleID:  "token",
+		TokenSymbol: "TKN",
+		Price:       1.5,
+		FDV:         1234567,
+		VotingPower: 1000,
+	})
+	require.NoError(t, err)
+	require.Len(t, discord.sent, 1)
+	require.Equal(t, "DAO: https://gl.app/dao/dao.eth\nCreated: 2024-03-01\nFungibleId: token\nToken TKN: Price - 1.5, FDV - 1,234,567\nLast Proposal VP: 1,000", discord.sent[0].Text)
+
+	// routes to not configured backends are skipped
+	require.NoError(t, n.Notify(context.Background(), KindVerificationDecision, VerificationDecision{}))
+
+	err = n.Notify(context.Background(), KindWorkerFailure, WorkerFailure{Worker: "token-price", Error: "boom"})
+	require.Error(t, err)
+	require.Len(t, discord.sent, 2)
+	require.Len(t, slack.sent, 1)
+	require.Equal(t, "Worker token-price failed: boom", slack.sent[0].Text)
+
+	_, err = New(Config{Routes: []string{"unknown:discord"}}, nil)
+	require.Error(t, err)
+
+	_, err = New(Config{Routes: []string{"token_found"}}, nil)
+	require.Error(t, err)
+}
+
+func TestUnitTemplatesDir(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, KindWorkerFailure+templateExt), []byte(`{{ .Worker }} is down`), 0o600)
+	require.NoError(t, err)
+
+	backend := &backendStub{}
+	n, err := New(Config{Routes: []string{"worker_failure:webhook"}, TemplatesDir: dir}, map[string]Backend{BackendWebhook: backend})
+	require.NoError(t, err)
+
+	require.NoError(t, n.Notify(context.Background(), KindWorkerFailure, WorkerFailure{Worker: "calc-totals"}))
+	require.Equal(t, "calc-totals is down", backend.sent[0].Text)
+}
+
+func TestUnitWebhookRetries(t *testing.T) {
+	for name, tc := range map[string]struct {
+		statuses []int
+		requests int
+		err      bool
+	}{
+		"success":                 {statuses: []int{http.StatusNoContent}, requests: 1},
+		"retry rate limited":      {statuses: []int{http.StatusTooManyRequests, http.StatusOK}, requests: 2},
+		"retry server errors":     {statuses: []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusOK}, requests: 3},
+		"give up after retries":   {statuses: []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway}, requests: 3, err: true},
+		"no retry of bad request": {statuses: []int{http.StatusBadRequest, http.StatusOK}, requests: 1, err: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var requests int
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var payload struct {
+					Content string `json:"content"`
+				}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+				require.Equal(t, "text", payload.Content)
+
+				w.WriteHeader(tc.statuses[requests])
+				requests++
+			}))
+			defer srv.Close()
+
+			d := NewDiscord(srv.URL, WithRetries(2), WithInterval(0), WithHTTPClient(srv.Client()))
+			d.poster.retryDelay = time.Millisecond
+
+			err := d.Send(context.Background(), Message{Text: "text"})
+			if tc.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.requests, requests)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package notifier
+
+import (
+	"text/template"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// TokenFound is the data of the KindTokenFound notification, the dao is sent to the token verification
+type TokenFound struct {
+	DaoAlias    string
+	CreatedAt   time.Time
+	FungibleID  string
+	TokenSymbol string
+	Price       float64
+	FDV         float64
+	// VotingPower is the total voting power of the latest proposal
+	VotingPower float32
+}
+
+// VerificationDecision is the data of the KindVerificationDecision notification
+type VerificationDecision struct {
+	DaoAlias          string
+	Status            string
+	Comment           string
+	Moderator         string
+	FungibleID        string
+	FungibleIDCleared bool
+}
+
+// WorkerFailure is the data of the KindWorkerFailure notification
+type WorkerFailure struct {
+	Worker string
+	Error  string
+}
+
+var printer = message.NewPrinter(language.English)
+
+var templateFuncs = template.FuncMap{
+	// number formats numbers with thousands separators
+	"number": func(v any) string {
+		return printer.Sprint(number.Decimal(v, number.MaxFractionDigits(6)))
+	},
+	"date": func(t time.Time) string {
+		return t.Format(time.DateOnly)
+	},
+}
+
+// defaultTemplates are text/template sources by kinds, the data of the kind is passed as the dot
+var defaultTemplates = map[string]string{
+	KindTokenFound: `DAO: https://gl.app/dao/{{ .DaoAlias }}
+Created: {{ date .CreatedAt }}
+FungibleId: {{ .FungibleID }}
+Token {{ .TokenSymbol }}: Price - {{ number .Price }}, FDV - {{ number .FDV }}
+Last Proposal VP: {{ number .VotingPower }}`,
+
+	KindVerificationDecision: `DAO: https://gl.app/dao/{{ .DaoAlias }}
+Token verification {{ .Status }} by {{ .Moderator }}
+FungibleId: {{ .FungibleID }}{{ if .FungibleIDCleared }} (cleared){{ end }}
+{{- if .Comment }}
+Comment: {{ .Comment }}{{ end }}`,
+
+	KindWorkerFailure: `Worker {{ .Worker }} failed: {{ .Error }}`,
+}
@@ -0,0 +1,245 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRetries    = 3
+	defaultRetryDelay = time.Second
+	defaultInterval   = time.Second
+	maxRetryDelay     = time.Minute
+
+	// discordMaxContentLen is the limit of the discord message content
+	discordMaxContentLen = 2000
+)
+
+type WebhookOption func(p *poster)
+
+// WithRetries sets the number of retries of rate limited and failed requests, the delay is doubled on each retry
+func WithRetries(retries int) WebhookOption {
+	return func(p *poster) {
+		p.retries = retries
+	}
+}
+
+// WithInterval sets the min interval between requests to the webhook
+func WithInterval(interval time.Duration) WebhookOption {
+	return func(p *poster) {
+		p.throttle.interval = interval
+	}
+}
+
+func WithHTTPClient(client *http.Client) WebhookOption {
+	return func(p *poster) {
+		p.client = client
+	}
+}
+
+// Discord sends messages to the discord channel webhook
+type Discord struct {
+	poster *poster
+}
+
+func NewDiscord(url string, opts ...WebhookOption) *Discord {
+	return &Discord{poster: newPoster(url, opts...)}
+}
+
+func (d *Discord) Send(ctx context.Context, msg Message) error {
+	content := []rune(msg.Text)
+	if len(content) > discordMaxContentLen {
+		content = content[:discordMaxContentLen]
+	}
+
+	return d.poster.post(ctx, struct {
+		Content string `json:"content"`
+	}{
+		Content: string(content),
+	})
+}
+
+// Slack sends messages to the slack incoming webhook
+type Slack struct {
+	poster *poster
+}
+
+func NewSlack(url string, opts ...WebhookOption) *Slack {
+	return &Slack{poster: newPoster(url, opts...)}
+}
+
+func (s *Slack) Send(ctx context.Context, msg Message) error {
+	return s.poster.post(ctx, struct {
+		Text string `json:"text"`
+	}{
+		Text: msg.Text,
+	})
+}
+
+// Webhook sends the kind, the rendered text and the source data of messages as json
+type Webhook struct {
+	poster *poster
+}
+
+func NewWebhook(url string, opts ...WebhookOption) *Webhook {
+	return &Webhook{poster: newPoster(url, opts...)}
+}
+
+func (w *Webhook) Send(ctx context.Context, msg Message) error {
+	return w.poster.post(ctx, struct {
+		Kind string `json:"kind"`
+		Text string `json:"text"`
+		Data any    `json:"data"`
+	}{
+		Kind: msg.Kind,
+		Text: msg.Text,
+		Data: msg.Data,
+	})
+}
+
+// StatusError is returned for non 2xx responses of webhooks
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d", e.StatusCode)
+}
+
+func (e *StatusError) retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// poster posts json payloads to the url with throttling and retries
+type poster struct {
+	client     *http.Client
+	url        string
+	retries    int
+	retryDelay time.Duration
+	throttle   *throttle
+}
+
+func newPoster(url string, opts ...WebhookOption) *poster {
+	p := &poster{
+		client:     http.DefaultClient,
+		url:        url,
+		retries:    defaultRetries,
+		retryDelay: defaultRetryDelay,
+		throttle:   &throttle{interval: defaultInterval},
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+func (p *poster) post(ctx context.Context, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+
+	for attempt := 0; ; attempt++ {
+		retryAfter, err := p.do(ctx, body)
+		if err == nil {
+			return nil
+		}
+
+		if attempt >= p.retries || ctx.Err() != nil {
+			return err
+		}
+
+		// network errors are retried as well as rate limited and failed requests
+		var se *StatusError
+		if errors.As(err, &se) && !se.retryable() {
+			return err
+		}
+
+		delay := p.retryDelay << attempt
+		if retryAfter > 0 {
+			delay = retryAfter
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(min(delay, maxRetryDelay)):
+		}
+	}
+}
+
+// do sends the request and returns the delay requested by the webhook with the error
+func (p *poster) do(ctx context.Context, body []byte) (time.Duration, error) {
+	if err := p.throttle.wait(ctx); err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("request do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return parseRetryAfter(resp.Header.Get("Retry-After")), &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	return 0, nil
+}
+
+// throttle keeps the min interval between calls
+type throttle struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func (t *throttle) wait(ctx context.Context) error {
+	t.mu.Lock()
+	now := time.Now()
+	at := t.next
+	if at.Before(now) {
+		at = now
+	}
+	t.next = at.Add(t.interval)
+	t.mu.Unlock()
+
+	if at.Equal(now) {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(at.Sub(now)):
+		return nil
+	}
+}
+
+// parseRetryAfter supports seconds of the Retry-After header, discord sends fractional ones
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+
+	return time.Duration(seconds * float64(time.Second))
+}
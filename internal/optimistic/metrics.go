@@ -0,0 +1,17 @@
+package optimistic
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/metrics"
+)
+
+var metricConflictsCounter = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "optimistic",
+		Name:      "conflicts_total",
+		Help:      "Number of version conflicts by operations",
+	}, []string{"operation"},
+)
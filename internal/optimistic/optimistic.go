@@ -0,0 +1,58 @@
+package optimistic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	maxAttempts = 5
+	retryDelay  = 20 * time.Millisecond
+)
+
+// ErrConflict means the row was changed by another writer after it was read
+var ErrConflict = errors.New("version conflict")
+
+// Update saves the model by db if the stored version is equal to the model one, the model version is incremented.
+// Columns are selected by the caller, the version column has to be selected as well.
+func Update(db *gorm.DB, model any, version *int) error {
+	expected := *version
+	*version = expected + 1
+
+	res := db.Model(model).Where("version = ?", expected).Updates(model)
+	if res.Error != nil {
+		*version = expected
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		*version = expected
+		return ErrConflict
+	}
+
+	return nil
+}
+
+// Retry calls cb while it fails with the conflict, cb has to read the fresh state on every call
+func Retry(ctx context.Context, name string, cb func(ctx context.Context) error) error {
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err = cb(ctx); !errors.Is(err, ErrConflict) {
+			return err
+		}
+
+		metricConflictsCounter.WithLabelValues(name).Inc()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt) * retryDelay):
+		}
+	}
+
+	return fmt.Errorf("%s: %d attempts: %w", name, maxAttempts, err)
+}
@@ -0,0 +1,53 @@
+package optimistic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitRetry(t *testing.T) {
+	for name, tc := range map[string]struct {
+		errs     []error
+		calls    int
+		expected error
+	}{
+		"success": {
+			errs:  []error{nil},
+			calls: 1,
+		},
+		"success after conflicts": {
+			errs:  []error{ErrConflict, fmt.Errorf("update: %w", ErrConflict), nil},
+			calls: 3,
+		},
+		"other errors are not retried": {
+			errs:     []error{errors.New("unexpected error")},
+			calls:    1,
+			expected: errors.New("unexpected error"),
+		},
+		"attempts are limited": {
+			errs:     []error{ErrConflict, ErrConflict, ErrConflict, ErrConflict, ErrConflict, nil},
+			calls:    maxAttempts,
+			expected: ErrConflict,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			err := Retry(context.Background(), "test", func(context.Context) error {
+				err := tc.errs[calls]
+				calls++
+				return err
+			})
+
+			require.Equal(t, tc.calls, calls)
+			if tc.expected == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expected.Error())
+		})
+	}
+}
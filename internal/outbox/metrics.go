@@ -0,0 +1,38 @@
+package outbox
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/metrics"
+)
+
+const subsystem = "outbox"
+
+var metricPublishHistogram = promauto.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: subsystem,
+		Name:      "publish_duration_seconds",
+		Help:      "Relay outbox message duration seconds",
+		Buckets:   []float64{.001, .005, .01, .025, .05, .1, .5, 1, 2.5, 5, 10},
+	}, []string{"subject", metrics.ErrLabel},
+)
+
+var metricBacklogGauge = promauto.NewGauge(
+	prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: subsystem,
+		Name:      "backlog_size",
+		Help:      "Number of messages waiting for publishing",
+	},
+)
+
+var metricBacklogAgeGauge = promauto.NewGauge(
+	prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: subsystem,
+		Name:      "backlog_age_seconds",
+		Help:      "Age of the oldest message waiting for publishing",
+	},
+)
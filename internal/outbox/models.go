@@ -0,0 +1,26 @@
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type Message struct {
+	ID            uint64 `gorm:"primary_key"`
+	CreatedAt     time.Time
+	EntityKey     string
+	Subject       string
+	Payload       json.RawMessage `gorm:"type:jsonb"`
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+}
+
+func (Message) TableName() string {
+	return "outbox_messages"
+}
+
+type Stats struct {
+	Count    int64
+	OldestAt *time.Time
+}
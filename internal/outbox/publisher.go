@@ -0,0 +1,39 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Publisher stores messages in the outbox table instead of sending them to the broker.
+// Messages are written in the transaction from the context if it exists and sent by RelayWorker.
+type Publisher struct {
+	repo *Repo
+}
+
+func NewPublisher(repo *Repo) *Publisher {
+	return &Publisher{repo: repo}
+}
+
+func (p *Publisher) PublishJSON(ctx context.Context, subject string, obj any) error {
+	payload, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("marshal %s payload: %w", subject, err)
+	}
+
+	now := time.Now()
+	err = p.repo.Create(ctx, Message{
+		CreatedAt:     now,
+		EntityKey:     keyFromContext(ctx),
+		Subject:       subject,
+		Payload:       payload,
+		NextAttemptAt: now,
+	})
+	if err != nil {
+		return fmt.Errorf("store %s message: %w", subject, err)
+	}
+
+	return nil
+}
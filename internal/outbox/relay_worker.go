@@ -0,0 +1,144 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/metrics"
+)
+
+const (
+	relayDelay     = time.Second
+	relayBatchSize = 100
+	minRetryDelay  = time.Second
+	maxRetryDelay  = 5 * time.Minute
+)
+
+type BrokerPublisher interface {
+	PublishJSON(ctx context.Context, subject string, obj any) error
+}
+
+type DataProvider interface {
+	CallInTx(ctx context.Context, cb func(ctx context.Context) error) error
+	GetHeads(ctx context.Context, limit int) ([]Message, error)
+	Delete(ctx context.Context, id uint64) error
+	Postpone(ctx context.Context, msg Message, nextAttemptAt time.Time, reason string) error
+	GetStats() (Stats, error)
+}
+
+// RelayWorker sends stored messages to the broker. Failed messages are retried with exponential backoff,
+// the next messages of the same entity are waiting until the failed one is sent.
+//
+// The delivery is at least once: sent messages are deleted when the batch transaction is committed,
+// so the whole batch is sent again if the commit fails or the relay stops before it.
+type RelayWorker struct {
+	repo      DataProvider
+	publisher BrokerPublisher
+}
+
+func NewRelayWorker(repo DataProvider, publisher BrokerPublisher) *RelayWorker {
+	return &RelayWorker{
+		repo:      repo,
+		publisher: publisher,
+	}
+}
+
+func (w *RelayWorker) Start(ctx context.Context) error {
+	for {
+		sent, err := w.relay(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("relay outbox messages")
+		}
+
+		w.collectStats()
+
+		if sent == relayBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(relayDelay):
+		}
+	}
+}
+
+func (w *RelayWorker) relay(ctx context.Context) (int, error) {
+	sent := 0
+	err := w.repo.CallInTx(ctx, func(ctx context.Context) error {
+		list, err := w.repo.GetHeads(ctx, relayBatchSize)
+		if err != nil {
+			return err
+		}
+
+		// later messages of the failed entity are not sent in this batch even if they are returned
+		blocked := make(map[string]struct{})
+		for _, msg := range list {
+			if _, ok := blocked[msg.EntityKey]; ok {
+				continue
+			}
+
+			if err = w.publish(ctx, msg); err != nil {
+				log.Warn().Err(err).Uint64("id", msg.ID).Str("subject", msg.Subject).Msg("publish outbox message")
+
+				blocked[msg.EntityKey] = struct{}{}
+
+				if err = w.repo.Postpone(ctx, msg, time.Now().Add(retryDelay(msg.Attempts)), err.Error()); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if err = w.repo.Delete(ctx, msg.ID); err != nil {
+				return err
+			}
+
+			sent++
+		}
+
+		return nil
+	})
+
+	return sent, err
+}
+
+func (w *RelayWorker) publish(ctx context.Context, msg Message) (err error) {
+	defer func(start time.Time) {
+		metricPublishHistogram.
+			WithLabelValues(msg.Subject, metrics.ErrLabelValue(err)).
+			Observe(time.Since(start).Seconds())
+	}(time.Now())
+
+	return w.publisher.PublishJSON(ctx, msg.Subject, json.RawMessage(msg.Payload))
+}
+
+func (w *RelayWorker) collectStats() {
+	stats, err := w.repo.GetStats()
+	if err != nil {
+		log.Error().Err(err).Msg("get outbox stats")
+
+		return
+	}
+
+	metricBacklogGauge.Set(float64(stats.Count))
+
+	age := 0.0
+	if stats.OldestAt != nil {
+		age = time.Since(*stats.OldestAt).Seconds()
+	}
+	metricBacklogAgeGauge.Set(age)
+}
+
+func retryDelay(attempts int) time.Duration {
+	delay := minRetryDelay
+	for i := 0; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxRetryDelay)
+}
@@ -0,0 +1,122 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitRetryDelay(t *testing.T) {
+	for name, tc := range map[string]struct {
+		attempts int
+		expected time.Duration
+	}{
+		"first attempt": {
+			attempts: 0,
+			expected: minRetryDelay,
+		},
+		"doubled on every attempt": {
+			attempts: 3,
+			expected: 8 * time.Second,
+		},
+		"last attempt below the limit": {
+			attempts: 8,
+			expected: 256 * time.Second,
+		},
+		"limited by the max delay": {
+			attempts: 9,
+			expected: maxRetryDelay,
+		},
+		"many attempts": {
+			attempts: 1000,
+			expected: maxRetryDelay,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, retryDelay(tc.attempts))
+		})
+	}
+}
+
+func TestUnitRelay(t *testing.T) {
+	var (
+		first  = Message{ID: 1, EntityKey: "a", Subject: "first", Payload: []byte(`{}`), Attempts: 2}
+		second = Message{ID: 2, EntityKey: "a", Subject: "second", Payload: []byte(`{}`)}
+		other  = Message{ID: 3, EntityKey: "b", Subject: "other", Payload: []byte(`{}`)}
+	)
+
+	for name, tc := range map[string]struct {
+		heads     []Message
+		failed    map[string]bool
+		published []string
+		deleted   []uint64
+		postponed []uint64
+	}{
+		"successful publish deletes the message": {
+			heads:     []Message{first, other},
+			published: []string{"first", "other"},
+			deleted:   []uint64{1, 3},
+		},
+		"failed head postpones the message": {
+			heads:     []Message{first, other},
+			failed:    map[string]bool{"first": true},
+			published: []string{"first", "other"},
+			deleted:   []uint64{3},
+			postponed: []uint64{1},
+		},
+		"failed head blocks later messages of the entity": {
+			heads:     []Message{first, second, other},
+			failed:    map[string]bool{"first": true},
+			published: []string{"first", "other"},
+			deleted:   []uint64{3},
+			postponed: []uint64{1},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var published []string
+			p := NewMockBrokerPublisher(ctrl)
+			p.EXPECT().PublishJSON(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().
+				DoAndReturn(func(_ context.Context, subject string, _ any) error {
+					published = append(published, subject)
+					if tc.failed[subject] {
+						return errors.New("unavailable")
+					}
+
+					return nil
+				})
+
+			var deleted, postponed []uint64
+			repo := NewMockDataProvider(ctrl)
+			repo.EXPECT().CallInTx(gomock.Any(), gomock.Any()).Times(1).
+				DoAndReturn(func(ctx context.Context, cb func(context.Context) error) error {
+					return cb(ctx)
+				})
+			repo.EXPECT().GetHeads(gomock.Any(), relayBatchSize).Times(1).Return(tc.heads, nil)
+			repo.EXPECT().Delete(gomock.Any(), gomock.Any()).AnyTimes().
+				DoAndReturn(func(_ context.Context, id uint64) error {
+					deleted = append(deleted, id)
+					return nil
+				})
+			repo.EXPECT().Postpone(gomock.Any(), gomock.Any(), gomock.Any(), "unavailable").AnyTimes().
+				DoAndReturn(func(_ context.Context, msg Message, nextAttemptAt time.Time, _ string) error {
+					require.WithinDuration(t, time.Now().Add(retryDelay(msg.Attempts)), nextAttemptAt, time.Second)
+					postponed = append(postponed, msg.ID)
+					return nil
+				})
+
+			sent, err := NewRelayWorker(repo, p).relay(context.TODO())
+			require.NoError(t, err)
+			require.Equal(t, len(tc.deleted), sent)
+			require.Equal(t, tc.published, published)
+			require.Equal(t, tc.deleted, deleted)
+			require.Equal(t, tc.postponed, postponed)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Repo struct {
+	db *gorm.DB
+}
+
+func NewRepo(db *gorm.DB) *Repo {
+	return &Repo{db: db}
+}
+
+func (r *Repo) CallInTx(ctx context.Context, cb func(ctx context.Context) error) error {
+	return CallInTx(ctx, r.db, cb)
+}
+
+func (r *Repo) Create(ctx context.Context, msg Message) error {
+	return Conn(ctx, r.db).Create(&msg).Error
+}
+
+// GetHeads returns the first unpublished message of each entity which is ready for publishing.
+// Returned rows are locked until the end of the transaction, so several relays don't publish the same message.
+func (r *Repo) GetHeads(ctx context.Context, limit int) ([]Message, error) {
+	var (
+		dummy Message
+		_     = dummy.ID
+		_     = dummy.EntityKey
+		_     = dummy.NextAttemptAt
+	)
+
+	var list []Message
+	err := Conn(ctx, r.db).
+		Raw(`
+			select *
+			from outbox_messages m
+			where m.next_attempt_at <= now()
+			  and not exists (select 1
+			                  from outbox_messages p
+			                  where p.entity_key = m.entity_key
+			                    and p.id < m.id)
+			order by m.id
+			limit ? for update skip locked`,
+			limit,
+		).
+		Scan(&list).
+		Error
+
+	return list, err
+}
+
+func (r *Repo) Delete(ctx context.Context, id uint64) error {
+	return Conn(ctx, r.db).Delete(&Message{ID: id}).Error
+}
+
+func (r *Repo) Postpone(ctx context.Context, msg Message, nextAttemptAt time.Time, reason string) error {
+	return Conn(ctx, r.db).
+		Model(&Message{ID: msg.ID}).
+		Updates(map[string]any{
+			"attempts":        msg.Attempts + 1,
+			"next_attempt_at": nextAttemptAt,
+			"last_error":      reason,
+		}).
+		Error
+}
+
+func (r *Repo) GetStats() (Stats, error) {
+	var stats Stats
+	err := r.db.
+		Model(&Message{}).
+		Select("count(*) as count, min(created_at) as oldest_at").
+		Scan(&stats).
+		Error
+
+	return stats, err
+}
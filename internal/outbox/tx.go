@@ -0,0 +1,56 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type (
+	txCtxKey  struct{}
+	keyCtxKey struct{}
+)
+
+// CallInTx runs cb inside the transaction which is passed to nested calls through the context,
+// so entity changes and outbox messages are committed together. Nested calls reuse the outer transaction.
+func CallInTx(ctx context.Context, db *gorm.DB, cb func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txCtxKey{}).(*gorm.DB); ok {
+		return cb(ctx)
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		return cb(WithTx(ctx, tx))
+	})
+}
+
+// WithTx stores the transaction in the context
+func WithTx(ctx context.Context, tx *gorm.DB) context.Context {
+	return context.WithValue(ctx, txCtxKey{}, tx)
+}
+
+// Conn returns the transaction from the context or db if there is no transaction.
+// Repository methods which take the context use it to join the transaction started by CallInTx,
+// so their writes are committed or rolled back together with published messages.
+func Conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txCtxKey{}).(*gorm.DB); ok {
+		return tx
+	}
+
+	return db
+}
+
+// WithKey sets the entity key for messages published with the context.
+// Messages with the same key are relayed in the order they were published,
+// messages without the key get the unique one and are relayed independently.
+func WithKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, keyCtxKey{}, key)
+}
+
+func keyFromContext(ctx context.Context) string {
+	if key, ok := ctx.Value(keyCtxKey{}).(string); ok && key != "" {
+		return key
+	}
+
+	return uuid.NewString()
+}
@@ -0,0 +1,162 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Key is the column of the sort order, the last key should be unique to make the order stable
+type Key struct {
+	Column string
+	Desc   bool
+}
+
+// Keyset orders rows by the keys and filters rows located after the cursor values.
+// Unlike offsets it keeps pages stable while new rows are added.
+type Keyset struct {
+	Keys []Key
+	// After contains values of the last row from the previous page, nil is for the first page.
+	// Empty slice means the next page without restrictions by values.
+	After []any
+}
+
+type cursor struct {
+	Sort   string `json:"s"`
+	Values []any  `json:"v"`
+}
+
+func NewKeyset(keys ...Key) Keyset {
+	return Keyset{Keys: keys}
+}
+
+// Continued checks if the keyset is created from the cursor of the previous page
+func (k Keyset) Continued() bool {
+	return k.After != nil
+}
+
+func (k Keyset) Apply(db *gorm.DB) *gorm.DB {
+	if len(k.After) != 0 {
+		query, args := k.condition()
+		db = db.Where(query, args...)
+	}
+
+	order := make([]string, 0, len(k.Keys))
+	for _, key := range k.Keys {
+		order = append(order, fmt.Sprintf("%s %s", key.Column, direction(key)))
+	}
+
+	return db.Order(strings.Join(order, ","))
+}
+
+// condition builds (k1 > v1) or (k1 = v1 and k2 > v2) or ... with the comparison based on the key direction
+func (k Keyset) condition() (string, []any) {
+	var (
+		parts = make([]string, 0, len(k.Keys))
+		args  = make([]any, 0, len(k.Keys)*(len(k.Keys)+1)/2)
+	)
+
+	for i, key := range k.Keys {
+		conds := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			conds = append(conds, fmt.Sprintf("%s = ?", k.Keys[j].Column))
+			args = append(args, k.After[j])
+		}
+
+		op := ">"
+		if key.Desc {
+			op = "<"
+		}
+		conds = append(conds, fmt.Sprintf("%s %s ?", key.Column, op))
+		args = append(args, k.After[i])
+
+		parts = append(parts, "("+strings.Join(conds, " and ")+")")
+	}
+
+	return "(" + strings.Join(parts, " or ") + ")", args
+}
+
+// Encode returns the opaque cursor for the page which starts after the row with the values
+func (k Keyset) Encode(values ...any) (string, error) {
+	if len(values) != 0 && len(values) != len(k.Keys) {
+		return "", fmt.Errorf("expected %d values, got %d", len(k.Keys), len(values))
+	}
+
+	if values == nil {
+		values = []any{}
+	}
+
+	data, err := json.Marshal(cursor{Sort: k.signature(), Values: values})
+	if err != nil {
+		return "", fmt.Errorf("marshal cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Decode returns the keyset with values from the cursor, the cursor must be created for the same keys
+func (k Keyset) Decode(token string) (Keyset, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Keyset{}, ErrInvalidCursor
+	}
+
+	dec := json.NewDecoder(strings.NewReader(string(data)))
+	dec.UseNumber()
+
+	var c cursor
+	if err = dec.Decode(&c); err != nil {
+		return Keyset{}, ErrInvalidCursor
+	}
+
+	if c.Sort != k.signature() || (len(c.Values) != 0 && len(c.Values) != len(k.Keys)) {
+		return Keyset{}, ErrInvalidCursor
+	}
+
+	after := make([]any, len(c.Values))
+	for i, v := range c.Values {
+		after[i] = normalize(v)
+	}
+
+	return Keyset{Keys: k.Keys, After: after}, nil
+}
+
+// signature binds cursors to the sort order, so they can't be reused with another one
+func (k Keyset) signature() string {
+	h := fnv.New32a()
+	for _, key := range k.Keys {
+		_, _ = h.Write([]byte(key.Column + " " + direction(key) + ";"))
+	}
+
+	return fmt.Sprintf("%x", h.Sum32())
+}
+
+func normalize(v any) any {
+	num, ok := v.(json.Number)
+	if !ok {
+		return v
+	}
+
+	if i, err := num.Int64(); err == nil {
+		return i
+	}
+
+	f, _ := num.Float64()
+
+	return f
+}
+
+func direction(key Key) string {
+	if key.Desc {
+		return "desc"
+	}
+
+	return "asc"
+}
@@ -0,0 +1,72 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitKeysetCondition(t *testing.T) {
+	for name, tc := range map[string]struct {
+		keyset Keyset
+		query  string
+		args   []any
+	}{
+		"single key": {
+			keyset: Keyset{Keys: []Key{{Column: "id"}}, After: []any{"a"}},
+			query:  "((id > ?))",
+			args:   []any{"a"},
+		},
+		"mixed directions": {
+			keyset: Keyset{
+				Keys:  []Key{{Column: "votes", Desc: true}, {Column: "created"}, {Column: "id"}},
+				After: []any{int64(10), int64(100), "id-1"},
+			},
+			query: "((votes < ?) or (votes = ? and created > ?) or (votes = ? and created = ? and id > ?))",
+			args:  []any{int64(10), int64(10), int64(100), int64(10), int64(100), "id-1"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			query, args := tc.keyset.condition()
+			require.Equal(t, tc.query, query)
+			require.Equal(t, tc.args, args)
+		})
+	}
+}
+
+func TestUnitKeysetCursor(t *testing.T) {
+	keyset := NewKeyset(Key{Column: "popularity_index", Desc: true}, Key{Column: "id"})
+
+	token, err := keyset.Encode(12.5, "3f1b4c1e-8c1a-4c1e-9a7e-2c1b4c1e8c1a")
+	require.NoError(t, err)
+
+	decoded, err := keyset.Decode(token)
+	require.NoError(t, err)
+	require.True(t, decoded.Continued())
+	require.Equal(t, []any{12.5, "3f1b4c1e-8c1a-4c1e-9a7e-2c1b4c1e8c1a"}, decoded.After)
+
+	token, err = keyset.Encode(int64(7), "id")
+	require.NoError(t, err)
+	decoded, err = keyset.Decode(token)
+	require.NoError(t, err)
+	require.Equal(t, []any{int64(7), "id"}, decoded.After)
+
+	token, err = keyset.Encode()
+	require.NoError(t, err)
+	decoded, err = keyset.Decode(token)
+	require.NoError(t, err)
+	require.True(t, decoded.Continued())
+	require.Empty(t, decoded.After)
+
+	_, err = keyset.Encode(1)
+	require.Error(t, err)
+
+	_, err = keyset.Decode("not a cursor")
+	require.ErrorIs(t, err, ErrInvalidCursor)
+
+	other := NewKeyset(Key{Column: "created", Desc: true}, Key{Column: "id"})
+	token, err = other.Encode(int64(1), "id")
+	require.NoError(t, err)
+	_, err = keyset.Decode(token)
+	require.ErrorIs(t, err, ErrInvalidCursor)
+}
@@ -0,0 +1,17 @@
+package pagination
+
+// Page trims items to the limit and returns the cursor of the next page, the cursor is empty for the last page.
+// Items are expected to be requested with one more item than the limit to detect the next page.
+func Page[T any](items []T, limit int, cursorFn func(page []T) (string, error)) ([]T, string, error) {
+	if len(items) <= limit {
+		return items, "", nil
+	}
+
+	items = items[:limit]
+	cursor, err := cursorFn(items)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return items, cursor, nil
+}
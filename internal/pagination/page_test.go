@@ -0,0 +1,47 @@
+package pagination
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitPage(t *testing.T) {
+	lastCursor := func(page []int) (string, error) {
+		return strconv.Itoa(page[len(page)-1]), nil
+	}
+
+	for name, tc := range map[string]struct {
+		items  []int
+		page   []int
+		cursor string
+	}{
+		"empty": {
+			items: nil,
+			page:  nil,
+		},
+		"last page": {
+			items: []int{1, 2},
+			page:  []int{1, 2},
+		},
+		"next page": {
+			items:  []int{1, 2, 3},
+			page:   []int{1, 2},
+			cursor: "2",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			page, cursor, err := Page(tc.items, 2, lastCursor)
+			require.NoError(t, err)
+			require.Equal(t, tc.page, page)
+			require.Equal(t, tc.cursor, cursor)
+		})
+	}
+
+	_, _, err := Page([]int{1, 2, 3}, 2, func([]int) (string, error) {
+		return "", errors.New("unexpected error")
+	})
+	require.Error(t, err)
+}
@@ -2,9 +2,12 @@ package proposal
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"gorm.io/gorm"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/pagination"
 )
 
 type Filter interface {
@@ -20,6 +23,16 @@ func (f PageFilter) Apply(db *gorm.DB) *gorm.DB {
 	return db.Offset(f.Offset).Limit(f.Limit)
 }
 
+// isPageFilter checks if the filter selects the page and should be skipped on counting
+func isPageFilter(f Filter) bool {
+	switch f.(type) {
+	case PageFilter, pagination.Keyset:
+		return true
+	default:
+		return false
+	}
+}
+
 type DaoIDsFilter struct {
 	DaoIDs []string
 }
@@ -81,13 +94,16 @@ type OrderFilter struct {
 	Orders []Order
 }
 
+// statesOrder is the order of states in search results
+var statesOrder = []string{StateActive, StatePending, StateSucceeded, StateFailed, StateDefeated, StateCancelled}
+
 var (
 	OrderByVotes = Order{
 		Field:     "votes",
 		Direction: DirectionDesc,
 	}
 	OrderByStates = Order{
-		Field:     "array_position(array ['active','pending','succeeded','failed','defeated','canceled'], state)",
+		Field:     fmt.Sprintf("array_position(array ['%s'], state)", strings.Join(statesOrder, "','")),
 		Direction: DirectionAsc,
 	}
 	OrderByCreated = Order{
@@ -96,6 +112,27 @@ var (
 	}
 )
 
+var (
+	// defaultKeyset repeats OrderByVotes and OrderByCreated with the id as the tie-breaker for cursor pagination
+	defaultKeyset = pagination.NewKeyset(
+		pagination.Key{Column: "proposals.votes", Desc: true},
+		pagination.Key{Column: "proposals.created"},
+		pagination.Key{Column: "proposals.id"},
+	)
+	// searchKeyset orders search results by states first like OrderByStates
+	searchKeyset = pagination.NewKeyset(
+		pagination.Key{Column: OrderByStates.Field},
+		pagination.Key{Column: "proposals.votes", Desc: true},
+		pagination.Key{Column: "proposals.created"},
+		pagination.Key{Column: "proposals.id"},
+	)
+)
+
+// stateRank returns the position of the state in OrderByStates, it's the same as array_position in sql
+func stateRank(state State) int {
+	return slices.Index(statesOrder, string(state)) + 1
+}
+
 func (f OrderFilter) Apply(db *gorm.DB) *gorm.DB {
 	var ordering []string
 	for i := range f.Orders {
@@ -153,7 +190,9 @@ func (f ShortInfoFilter) Apply(db *gorm.DB) *gorm.DB {
 		_     = dummy.Title
 		_     = dummy.Created
 		_     = dummy.State
+		_     = dummy.Votes
 	)
 
-	return db.Select("proposals.id", "proposals.title", "proposals.created", "proposals.state")
+	// votes are used in the cursor
+	return db.Select("proposals.id", "proposals.title", "proposals.created", "proposals.state", "proposals.votes")
 }
@@ -11,6 +11,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
+	pagination "github.com/goverland-labs/goverland-core-storage/internal/pagination"
 )
 
 // MockDataProvider is a mock of DataProvider interface.
@@ -36,18 +37,46 @@ func (m *MockDataProvider) EXPECT() *MockDataProviderMockRecorder {
 	return m.recorder
 }
 
+// CallInTx mocks base method.
+func (m *MockDataProvider) CallInTx(arg0 context.Context, arg1 func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallInTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CallInTx indicates an expected call of CallInTx.
+func (mr *MockDataProviderMockRecorder) CallInTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallInTx", reflect.TypeOf((*MockDataProvider)(nil).CallInTx), arg0, arg1)
+}
+
 // Create mocks base method.
-func (m *MockDataProvider) Create(arg0 Proposal) error {
+func (m *MockDataProvider) Create(arg0 context.Context, arg1 Proposal) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockDataProviderMockRecorder) Create(arg0 interface{}) *gomock.Call {
+func (mr *MockDataProviderMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataProvider)(nil).Create), arg0, arg1)
+}
+
+// CreateRevision mocks base method.
+func (m *MockDataProvider) CreateRevision(arg0 context.Context, arg1 Revision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRevision", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRevision indicates an expected call of CreateRevision.
+func (mr *MockDataProviderMockRecorder) CreateRevision(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataProvider)(nil).Create), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRevision", reflect.TypeOf((*MockDataProvider)(nil).CreateRevision), arg0, arg1)
 }
 
 // GetAvailableForVoting mocks base method.
@@ -66,18 +95,18 @@ func (mr *MockDataProviderMockRecorder) GetAvailableForVoting(arg0 interface{})
 }
 
 // GetByFilters mocks base method.
-func (m *MockDataProvider) GetByFilters(arg0 []Filter) (ProposalList, error) {
+func (m *MockDataProvider) GetByFilters(arg0 []Filter, arg1 bool) (ProposalList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByFilters", arg0)
+	ret := m.ctrl.Call(m, "GetByFilters", arg0, arg1)
 	ret0, _ := ret[0].(ProposalList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByFilters indicates an expected call of GetByFilters.
-func (mr *MockDataProviderMockRecorder) GetByFilters(arg0 interface{}) *gomock.Call {
+func (mr *MockDataProviderMockRecorder) GetByFilters(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByFilters", reflect.TypeOf((*MockDataProvider)(nil).GetByFilters), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByFilters", reflect.TypeOf((*MockDataProvider)(nil).GetByFilters), arg0, arg1)
 }
 
 // GetByID mocks base method.
@@ -95,6 +124,36 @@ func (mr *MockDataProviderMockRecorder) GetByID(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockDataProvider)(nil).GetByID), arg0)
 }
 
+// GetOutcomeSettings mocks base method.
+func (m *MockDataProvider) GetOutcomeSettings(arg0 uuid.UUID) (OutcomeSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutcomeSettings", arg0)
+	ret0, _ := ret[0].(OutcomeSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutcomeSettings indicates an expected call of GetOutcomeSettings.
+func (mr *MockDataProviderMockRecorder) GetOutcomeSettings(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutcomeSettings", reflect.TypeOf((*MockDataProvider)(nil).GetOutcomeSettings), arg0)
+}
+
+// GetRevisions mocks base method.
+func (m *MockDataProvider) GetRevisions(arg0 string, arg1 pagination.Keyset, arg2 int) ([]Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockDataProviderMockRecorder) GetRevisions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockDataProvider)(nil).GetRevisions), arg0, arg1, arg2)
+}
+
 // GetTop mocks base method.
 func (m *MockDataProvider) GetTop(arg0 []Filter) (ProposalList, error) {
 	m.ctrl.T.Helper()
@@ -111,45 +170,59 @@ func (mr *MockDataProviderMockRecorder) GetTop(arg0 interface{}) *gomock.Call {
 }
 
 // Update mocks base method.
-func (m *MockDataProvider) Update(arg0 Proposal) error {
+func (m *MockDataProvider) Update(arg0 context.Context, arg1 Proposal) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockDataProviderMockRecorder) Update(arg0 interface{}) *gomock.Call {
+func (mr *MockDataProviderMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataProvider)(nil).Update), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataProvider)(nil).Update), arg0, arg1)
 }
 
-// UpdateVotes mocks base method.
-func (m *MockDataProvider) UpdateVotes(arg0 []ResolvedAddress) error {
+// UpdateState mocks base method.
+func (m *MockDataProvider) UpdateState(arg0 context.Context, arg1 Proposal) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateVotes", arg0)
+	ret := m.ctrl.Call(m, "UpdateState", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateVotes indicates an expected call of UpdateVotes.
-func (mr *MockDataProviderMockRecorder) UpdateVotes(arg0 interface{}) *gomock.Call {
+// UpdateState indicates an expected call of UpdateState.
+func (mr *MockDataProviderMockRecorder) UpdateState(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVotes", reflect.TypeOf((*MockDataProvider)(nil).UpdateVotes), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateState", reflect.TypeOf((*MockDataProvider)(nil).UpdateState), arg0, arg1)
+}
+
+// UpdateTimeline mocks base method.
+func (m *MockDataProvider) UpdateTimeline(arg0 context.Context, arg1 Proposal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimeline", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTimeline indicates an expected call of UpdateTimeline.
+func (mr *MockDataProviderMockRecorder) UpdateTimeline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimeline", reflect.TypeOf((*MockDataProvider)(nil).UpdateTimeline), arg0, arg1)
 }
 
-// GetSucceededChoices mocks base method.
-func (m *MockDataProvider) GetSucceededChoices(arg0 uuid.UUID) []string {
+// UpdateVotes mocks base method.
+func (m *MockDataProvider) UpdateVotes(arg0 []ResolvedAddress) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSucceededChoices", arg0)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "UpdateVotes", arg0)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GetSucceededChoices indicates an expected call of GetSucceededChoices.
-func (mr *MockDataProviderMockRecorder) GetSucceededChoices(arg0 interface{}) *gomock.Call {
+// UpdateVotes indicates an expected call of UpdateVotes.
+func (mr *MockDataProviderMockRecorder) UpdateVotes(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSucceededChoices", reflect.TypeOf((*MockDataProvider)(nil).GetSucceededChoices), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVotes", reflect.TypeOf((*MockDataProvider)(nil).UpdateVotes), arg0)
 }
 
 // MockPublisher is a mock of Publisher interface.
@@ -189,20 +262,6 @@ func (mr *MockPublisherMockRecorder) PublishJSON(arg0, arg1, arg2 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishJSON", reflect.TypeOf((*MockPublisher)(nil).PublishJSON), arg0, arg1, arg2)
 }
 
-// GetTokenPrice mocks base method.
-func (m *MockDaoProvider) GetTokenPrice(arg0 uuid.UUID, arg1 int) float64 {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTokenPrice", arg0, arg1)
-	ret0, _ := ret[0].(float64)
-	return ret0
-}
-
-// GetTokenPrice indicates an expected call of GetTokenPrice.
-func (mr *MockDaoProviderMockRecorder) GetTokenPrice(arg0, arg1 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenPrice", reflect.TypeOf((*MockDaoProvider)(nil).GetTokenPrice), arg0, arg1)
-}
-
 // MockEventRegistered is a mock of EventRegistered interface.
 type MockEventRegistered struct {
 	ctrl     *gomock.Controller
@@ -242,11 +301,12 @@ func (mr *MockEventRegisteredMockRecorder) EventExist(arg0, arg1, arg2, arg3 int
 }
 
 // RegisterEvent mocks base method.
-func (m *MockEventRegistered) RegisterEvent(arg0 context.Context, arg1, arg2, arg3 string) error {
+func (m *MockEventRegistered) RegisterEvent(arg0 context.Context, arg1, arg2, arg3 string) (bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RegisterEvent", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // RegisterEvent indicates an expected call of RegisterEvent.
@@ -293,6 +353,20 @@ func (mr *MockDaoProviderMockRecorder) GetIDByOriginalID(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIDByOriginalID", reflect.TypeOf((*MockDaoProvider)(nil).GetIDByOriginalID), arg0)
 }
 
+// GetTokenPrice mocks base method.
+func (m *MockDaoProvider) GetTokenPrice(arg0 context.Context, arg1 uuid.UUID, arg2 int) float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenPrice", arg0, arg1, arg2)
+	ret0, _ := ret[0].(float64)
+	return ret0
+}
+
+// GetTokenPrice indicates an expected call of GetTokenPrice.
+func (mr *MockDaoProviderMockRecorder) GetTokenPrice(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenPrice", reflect.TypeOf((*MockDaoProvider)(nil).GetTokenPrice), arg0, arg1, arg2)
+}
+
 // MockEnsResolver is a mock of EnsResolver interface.
 type MockEnsResolver struct {
 	ctrl     *gomock.Controller
@@ -1,15 +1,13 @@
 package proposal
 
 import (
-	"github.com/lib/pq"
-	"slices"
-	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	aggevents "github.com/goverland-labs/goverland-platform-events/events/aggregator"
 	coreevents "github.com/goverland-labs/goverland-platform-events/events/core"
 	events "github.com/goverland-labs/goverland-platform-events/events/core"
+	"github.com/lib/pq"
 )
 
 const (
@@ -73,6 +71,7 @@ type Proposal struct {
 	Privacy           string
 	Snapshot          string
 	State             State
+	WinningChoices    []int `gorm:"serializer:json"`
 	OriginalState     string
 	Link              string
 	App               string
@@ -80,12 +79,15 @@ type Proposal struct {
 	ScoresState       string
 	ScoresTotal       float32
 	ScoresUpdated     int
+	ScoresMismatch    bool `gorm:"->"`
 	Votes             int
 	Timeline          Timeline `gorm:"serializer:json"`
 	EnsName           string
 	Spam              bool
-	SucceededChoices  Choices `gorm:"-"`
+	Settings          OutcomeSettings `gorm:"-"`
 	InitialTokenPrice float64
+	// Version is incremented on every update, updates are applied only to the version they were based on
+	Version int
 }
 
 type DaoSucceededChoices struct {
@@ -204,35 +206,6 @@ func convertToInternalStrategies(s []aggevents.StrategyPayload) Strategies {
 	return res
 }
 
-func (p *Proposal) CalculateState() State {
-	if p.Deleted() {
-		return StateCancelled
-	}
-
-	if p.Pending() {
-		return StatePending
-	}
-
-	if p.InProgress() {
-		return StateActive
-	}
-
-	if p.Votes == 0 ||
-		(p.QuorumSpecified() && !p.QuorumReached()) {
-		return StateFailed
-	}
-
-	if p.IsVotedAgainst() {
-		return StateDefeated
-	}
-
-	if p.IsNotSucceededChoice() {
-		return StateDefeated
-	}
-
-	return StateSucceeded
-}
-
 func (p *Proposal) InProgress() bool {
 	startsAt := time.Unix(int64(p.Start), 0)
 	endsAt := time.Unix(int64(p.End), 0)
@@ -263,46 +236,5 @@ func (p *Proposal) QuorumReached() bool {
 }
 
 func (p *Proposal) IsBasic() bool {
-	return p.Type == "basic"
-}
-
-func (p *Proposal) IsSingleChoice() bool {
-	return p.Type == "single-choice"
-}
-
-func (p *Proposal) IsVotedAgainst() bool {
-	if !p.IsBasic() {
-		return false
-	}
-
-	// invalid data, should collect votes for
-	// 0 - For
-	// 1 - Against
-	// 2 - Abstain
-	if len(p.Scores) != 3 {
-		return false
-	}
-
-	forVotes := p.Scores[0]
-	againstVotes := p.Scores[1]
-
-	return againstVotes > forVotes
-}
-
-func (p *Proposal) IsNotSucceededChoice() bool {
-	if !p.IsSingleChoice() || p.SucceededChoices == nil {
-		return false
-	}
-
-	maxScore := slices.Max(p.Scores)
-	succeededChoiceExist := false
-	for i, c := range p.Choices {
-		if slices.Contains(p.SucceededChoices, strings.ToLower(c)) {
-			if p.Scores[i] == maxScore {
-				return false
-			}
-			succeededChoiceExist = true
-		}
-	}
-	return succeededChoiceExist
+	return p.Type == VotingBasic
 }
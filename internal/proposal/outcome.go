@@ -0,0 +1,259 @@
+package proposal
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Voting types of the proposals
+const (
+	VotingBasic        = "basic"
+	VotingSingleChoice = "single-choice"
+	VotingApproval     = "approval"
+	VotingRankedChoice = "ranked-choice"
+	VotingQuadratic    = "quadratic"
+	VotingWeighted     = "weighted"
+)
+
+// Choices of the basic voting, the abstain choice is the last one
+const (
+	basicChoiceFor = iota
+	basicChoiceAgainst
+	basicChoiceAbstain
+	basicChoicesCount
+)
+
+// Quorum types of the dao, the rejection quorum is reached by against votes and defeats basic proposals
+const (
+	QuorumTypeDefault   = "default"
+	QuorumTypeRejection = "rejection"
+)
+
+// OutcomeSettings are the dao settings applied on the outcome calculation
+type OutcomeSettings struct {
+	// SucceededChoices are the lower-cased choices which mean success, the proposal is defeated if another choice wins
+	SucceededChoices Choices
+	HideAbstain      bool
+	QuorumType       string
+}
+
+// outcomeVoting is the part of the dao voting settings used by outcomes, keys match the stored dao voting
+type outcomeVoting struct {
+	HideAbstain bool
+	QuorumType  string
+}
+
+// NewOutcomeSettings returns outcome settings from the dao voting settings as they are stored with the dao
+func NewOutcomeSettings(voting json.RawMessage, succeededChoices Choices) (OutcomeSettings, error) {
+	var v outcomeVoting
+	if len(voting) != 0 {
+		if err := json.Unmarshal(voting, &v); err != nil {
+			return OutcomeSettings{}, fmt.Errorf("unmarshal dao voting: %w", err)
+		}
+	}
+
+	return OutcomeSettings{
+		SucceededChoices: succeededChoices,
+		HideAbstain:      v.HideAbstain,
+		QuorumType:       v.QuorumType,
+	}, nil
+}
+
+// Outcome is the result of the finished voting, Winners are the indexes of the leading choices
+type Outcome struct {
+	State   State
+	Winners []int
+}
+
+// OutcomeCalculator decides the outcome of the finished proposal with votes
+type OutcomeCalculator interface {
+	Calculate(p *Proposal) Outcome
+}
+
+// outcomeCalculators are registered by voting types, proposals of other types succeed once the quorum is reached
+var outcomeCalculators = map[string]OutcomeCalculator{
+	VotingBasic:        basicCalculator{},
+	VotingSingleChoice: pluralityCalculator{},
+	VotingApproval:     pluralityCalculator{},
+	VotingQuadratic:    pluralityCalculator{},
+	VotingWeighted:     pluralityCalculator{},
+	VotingRankedChoice: rankedChoiceCalculator{},
+}
+
+// basicCalculator decides for/against/abstain proposals, the abstain choice is missed if the dao hides it
+type basicCalculator struct{}
+
+func (basicCalculator) Calculate(p *Proposal) Outcome {
+	// invalid data, should collect votes for and against
+	if len(p.Scores) <= basicChoiceAgainst || len(p.Scores) > basicChoicesCount {
+		return quorumOutcome(p, p.quorumScore())
+	}
+
+	forVotes := p.Scores[basicChoiceFor]
+	againstVotes := p.Scores[basicChoiceAgainst]
+
+	if p.Settings.QuorumType == QuorumTypeRejection {
+		if p.QuorumSpecified() && float64(againstVotes) >= p.Quorum {
+			return Outcome{State: StateDefeated, Winners: []int{basicChoiceAgainst}}
+		}
+	} else if p.QuorumSpecified() && p.quorumScore() < p.Quorum {
+		return Outcome{State: StateFailed}
+	}
+
+	if againstVotes > forVotes {
+		return Outcome{State: StateDefeated, Winners: []int{basicChoiceAgainst}}
+	}
+
+	return Outcome{State: StateSucceeded, Winners: []int{basicChoiceFor}}
+}
+
+// pluralityCalculator wins the only choice with the max score, the scores of approval, quadratic and weighted
+// votings are already summarized by the source
+type pluralityCalculator struct{}
+
+func (pluralityCalculator) Calculate(p *Proposal) Outcome {
+	outcome := quorumOutcome(p, p.quorumScore())
+	if outcome.State == StateFailed {
+		return outcome
+	}
+
+	// there is no winner if all scores are zero or the leaders are tied
+	winners := leaders(p.Scores)
+	if len(winners) != 1 {
+		return Outcome{State: StateDefeated}
+	}
+
+	outcome.Winners = winners
+	if p.isNotSucceededChoice(outcome.Winners) {
+		outcome.State = StateDefeated
+	}
+
+	return outcome
+}
+
+// rankedChoiceCalculator requires the majority of the final round, the source sends the scores of the last round
+type rankedChoiceCalculator struct{}
+
+func (rankedChoiceCalculator) Calculate(p *Proposal) Outcome {
+	outcome := pluralityCalculator{}.Calculate(p)
+	if outcome.State != StateSucceeded {
+		return outcome
+	}
+
+	var total float32
+	for _, score := range p.Scores {
+		total += score
+	}
+
+	if p.Scores[outcome.Winners[0]]*2 <= total {
+		outcome.State = StateDefeated
+	}
+
+	return outcome
+}
+
+// CalculateOutcome decides the state of the proposal and the winning choices of the finished voting
+func (p *Proposal) CalculateOutcome() Outcome {
+	if p.Deleted() {
+		return Outcome{State: StateCancelled}
+	}
+
+	if p.Pending() {
+		return Outcome{State: StatePending}
+	}
+
+	if p.InProgress() {
+		return Outcome{State: StateActive}
+	}
+
+	if p.Votes == 0 {
+		return Outcome{State: StateFailed}
+	}
+
+	calculator, ok := outcomeCalculators[p.Type]
+	if !ok {
+		return quorumOutcome(p, float64(p.ScoresTotal))
+	}
+
+	return calculator.Calculate(p)
+}
+
+// outcomeChanged checks that the outcome differs from the stored state and winning choices
+func (p *Proposal) outcomeChanged(outcome Outcome) bool {
+	return outcome.State != p.State || !slices.Equal(outcome.Winners, p.WinningChoices)
+}
+
+// applyOutcome sets the calculated state and winning choices, it returns false if nothing was changed
+func (p *Proposal) applyOutcome() bool {
+	outcome := p.CalculateOutcome()
+	if !p.outcomeChanged(outcome) {
+		return false
+	}
+
+	p.State = outcome.State
+	p.WinningChoices = outcome.Winners
+
+	return true
+}
+
+// quorumOutcome fails the proposal if the quorum is not reached by the score and succeeds otherwise
+func quorumOutcome(p *Proposal, score float64) Outcome {
+	if p.QuorumSpecified() && score < p.Quorum {
+		return Outcome{State: StateFailed}
+	}
+
+	return Outcome{State: StateSucceeded}
+}
+
+// quorumScore is the score counted to the quorum, hidden abstain votes of basic proposals are not counted
+func (p *Proposal) quorumScore() float64 {
+	if p.IsBasic() && p.Settings.HideAbstain && len(p.Scores) == basicChoicesCount {
+		return float64(p.ScoresTotal - p.Scores[basicChoiceAbstain])
+	}
+
+	return float64(p.ScoresTotal)
+}
+
+// isNotSucceededChoice checks that the dao has succeeded choices among the proposal choices and none of them wins
+func (p *Proposal) isNotSucceededChoice(winners []int) bool {
+	if len(p.Settings.SucceededChoices) == 0 {
+		return false
+	}
+
+	succeededChoiceExist := false
+	for i, c := range p.Choices {
+		if !slices.Contains(p.Settings.SucceededChoices, strings.ToLower(c)) {
+			continue
+		}
+
+		if slices.Contains(winners, i) {
+			return false
+		}
+		succeededChoiceExist = true
+	}
+
+	return succeededChoiceExist
+}
+
+// leaders returns the indexes of the choices with the max positive score
+func leaders(scores Scores) []int {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	maxScore := slices.Max(scores)
+	if maxScore <= 0 {
+		return nil
+	}
+
+	var res []int
+	for i, score := range scores {
+		if score == maxScore {
+			res = append(res, i)
+		}
+	}
+
+	return res
+}
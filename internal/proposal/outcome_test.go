@@ -0,0 +1,150 @@
+package proposal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitCalculateOutcome(t *testing.T) {
+	var (
+		start = int(time.Now().Add(-48 * time.Hour).Unix())
+		end   = int(time.Now().Add(-24 * time.Hour).Unix())
+		ended = func(p Proposal) Proposal {
+			p.Start, p.End = start, end
+			if p.Votes == 0 {
+				p.Votes = 10
+			}
+			for _, score := range p.Scores {
+				p.ScoresTotal += score
+			}
+
+			return p
+		}
+	)
+
+	for name, tc := range map[string]struct {
+		proposal Proposal
+		expected Outcome
+	}{
+		"pending": {
+			proposal: Proposal{Start: int(time.Now().Add(time.Hour).Unix()), End: int(time.Now().Add(2 * time.Hour).Unix())},
+			expected: Outcome{State: StatePending},
+		},
+		"active": {
+			proposal: Proposal{Start: start, End: int(time.Now().Add(time.Hour).Unix())},
+			expected: Outcome{State: StateActive},
+		},
+		"canceled": {
+			proposal: ended(Proposal{State: StateCancelled}),
+			expected: Outcome{State: StateCancelled},
+		},
+		"no votes": {
+			proposal: Proposal{Start: start, End: end, Type: VotingBasic},
+			expected: Outcome{State: StateFailed},
+		},
+		"basic succeeded": {
+			proposal: ended(Proposal{Type: VotingBasic, Scores: Scores{10, 5, 1}}),
+			expected: Outcome{State: StateSucceeded, Winners: []int{0}},
+		},
+		"basic defeated": {
+			proposal: ended(Proposal{Type: VotingBasic, Scores: Scores{5, 10, 1}}),
+			expected: Outcome{State: StateDefeated, Winners: []int{1}},
+		},
+		"basic without abstain": {
+			proposal: ended(Proposal{Type: VotingBasic, Scores: Scores{5, 10}}),
+			expected: Outcome{State: StateDefeated, Winners: []int{1}},
+		},
+		"basic quorum is not reached": {
+			proposal: ended(Proposal{Type: VotingBasic, Scores: Scores{10, 5, 1}, Quorum: 20}),
+			expected: Outcome{State: StateFailed},
+		},
+		"basic quorum is reached with abstain": {
+			proposal: ended(Proposal{Type: VotingBasic, Scores: Scores{10, 5, 5}, Quorum: 20}),
+			expected: Outcome{State: StateSucceeded, Winners: []int{0}},
+		},
+		"basic hidden abstain is not counted to quorum": {
+			proposal: ended(Proposal{Type: VotingBasic, Scores: Scores{10, 5, 5}, Quorum: 20, Settings: OutcomeSettings{HideAbstain: true}}),
+			expected: Outcome{State: StateFailed},
+		},
+		"basic rejection quorum is not reached": {
+			proposal: ended(Proposal{Type: VotingBasic, Scores: Scores{10, 5, 0}, Quorum: 20, Settings: OutcomeSettings{QuorumType: QuorumTypeRejection}}),
+			expected: Outcome{State: StateSucceeded, Winners: []int{0}},
+		},
+		"basic rejection quorum is reached": {
+			proposal: ended(Proposal{Type: VotingBasic, Scores: Scores{30, 20, 0}, Quorum: 20, Settings: OutcomeSettings{QuorumType: QuorumTypeRejection}}),
+			expected: Outcome{State: StateDefeated, Winners: []int{1}},
+		},
+		"single choice": {
+			proposal: ended(Proposal{Type: VotingSingleChoice, Choices: Choices{"a", "b", "c"}, Scores: Scores{1, 7, 3}}),
+			expected: Outcome{State: StateSucceeded, Winners: []int{1}},
+		},
+		"single choice tie": {
+			proposal: ended(Proposal{Type: VotingSingleChoice, Choices: Choices{"a", "b", "c"}, Scores: Scores{7, 7, 3}}),
+			expected: Outcome{State: StateDefeated},
+		},
+		"single choice with zero scores": {
+			proposal: ended(Proposal{Type: VotingSingleChoice, Choices: Choices{"a", "b"}, Scores: Scores{0, 0}}),
+			expected: Outcome{State: StateDefeated},
+		},
+		"single choice with succeeded choice": {
+			proposal: ended(Proposal{Type: VotingSingleChoice, Choices: Choices{"Yes", "No"}, Scores: Scores{7, 3}, Settings: OutcomeSettings{SucceededChoices: Choices{"yes"}}}),
+			expected: Outcome{State: StateSucceeded, Winners: []int{0}},
+		},
+		"single choice with not succeeded choice": {
+			proposal: ended(Proposal{Type: VotingSingleChoice, Choices: Choices{"Yes", "No"}, Scores: Scores{3, 7}, Settings: OutcomeSettings{SucceededChoices: Choices{"yes"}}}),
+			expected: Outcome{State: StateDefeated, Winners: []int{1}},
+		},
+		"single choice without succeeded choices in the proposal": {
+			proposal: ended(Proposal{Type: VotingSingleChoice, Choices: Choices{"a", "b"}, Scores: Scores{3, 7}, Settings: OutcomeSettings{SucceededChoices: Choices{"yes"}}}),
+			expected: Outcome{State: StateSucceeded, Winners: []int{1}},
+		},
+		"approval with not succeeded choice": {
+			proposal: ended(Proposal{Type: VotingApproval, Choices: Choices{"For", "Against"}, Scores: Scores{3, 7}, Settings: OutcomeSettings{SucceededChoices: Choices{"for"}}}),
+			expected: Outcome{State: StateDefeated, Winners: []int{1}},
+		},
+		"quadratic quorum is not reached": {
+			proposal: ended(Proposal{Type: VotingQuadratic, Choices: Choices{"a", "b"}, Scores: Scores{3, 7}, Quorum: 20}),
+			expected: Outcome{State: StateFailed},
+		},
+		"weighted": {
+			proposal: ended(Proposal{Type: VotingWeighted, Choices: Choices{"a", "b"}, Scores: Scores{3.5, 6.5}}),
+			expected: Outcome{State: StateSucceeded, Winners: []int{1}},
+		},
+		"ranked choice majority": {
+			proposal: ended(Proposal{Type: VotingRankedChoice, Choices: Choices{"a", "b", "c"}, Scores: Scores{6, 4, 0}}),
+			expected: Outcome{State: StateSucceeded, Winners: []int{0}},
+		},
+		"ranked choice without majority": {
+			proposal: ended(Proposal{Type: VotingRankedChoice, Choices: Choices{"a", "b", "c"}, Scores: Scores{5, 4, 1}}),
+			expected: Outcome{State: StateDefeated, Winners: []int{0}},
+		},
+		"unknown type": {
+			proposal: ended(Proposal{Type: "custom", Scores: Scores{3, 7}}),
+			expected: Outcome{State: StateSucceeded},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.proposal.CalculateOutcome())
+		})
+	}
+}
+
+func TestUnitApplyOutcome(t *testing.T) {
+	p := Proposal{
+		Start:       int(time.Now().Add(-48 * time.Hour).Unix()),
+		End:         int(time.Now().Add(-24 * time.Hour).Unix()),
+		Type:        VotingSingleChoice,
+		Choices:     Choices{"a", "b"},
+		Scores:      Scores{3, 7},
+		ScoresTotal: 10,
+		Votes:       2,
+		State:       StateSucceeded,
+	}
+
+	require.True(t, p.applyOutcome())
+	require.EqualValues(t, StateSucceeded, p.State)
+	require.Equal(t, []int{1}, p.WinningChoices)
+	require.False(t, p.applyOutcome())
+}
@@ -1,12 +1,18 @@
 package proposal
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"gorm.io/gorm"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/optimistic"
+	"github.com/goverland-labs/goverland-core-storage/internal/outbox"
+	"github.com/goverland-labs/goverland-core-storage/internal/pagination"
 )
 
 type Repo struct {
@@ -19,14 +25,43 @@ func NewRepo(db *gorm.DB) *Repo {
 
 // Create creates one proposal object
 // todo: check creating error/unique and others
-func (r *Repo) Create(p Proposal) error {
-	return r.db.Create(&p).Error
+func (r *Repo) Create(ctx context.Context, p Proposal) error {
+	return outbox.Conn(ctx, r.db).Create(&p).Error
+}
+
+// Update saves the proposal received from the source, columns maintained by the service are kept as is.
+// It returns optimistic.ErrConflict if the proposal was changed after reading.
+func (r *Repo) Update(ctx context.Context, p Proposal) error {
+	db := outbox.Conn(ctx, r.db).Select("*").Omit(
+		"id",
+		"created_at",
+		"dao_id",
+		"timeline",
+		"ens_name",
+		"initial_token_price",
+	)
+
+	return optimistic.Update(db, &p, &p.Version)
 }
 
-// Update single proposal object in database
-// todo: think about updating fields to default value(boolean, string etc)
-func (r *Repo) Update(p Proposal) error {
-	return r.db.Save(&p).Error
+func (r *Repo) UpdateTimeline(ctx context.Context, p Proposal) error {
+	return r.updateColumns(ctx, p, "timeline")
+}
+
+func (r *Repo) UpdateState(ctx context.Context, p Proposal) error {
+	return r.updateColumns(ctx, p, "state", "winning_choices", "original_state")
+}
+
+// updateColumns saves only the passed columns if the proposal was not changed after reading
+func (r *Repo) updateColumns(ctx context.Context, p Proposal, columns ...string) error {
+	db := outbox.Conn(ctx, r.db).Select(append(columns, "version", "updated_at"))
+
+	return optimistic.Update(db, &p, &p.Version)
+}
+
+// CallInTx runs cb in the transaction, Create and Update calls with the passed context join it
+func (r *Repo) CallInTx(ctx context.Context, cb func(ctx context.Context) error) error {
+	return outbox.CallInTx(ctx, r.db, cb)
 }
 
 func (r *Repo) GetByID(id string) (*Proposal, error) {
@@ -75,20 +110,21 @@ type ProposalList struct {
 	TotalCount int64
 }
 
-// todo: add order by
-func (r *Repo) GetByFilters(filters []Filter) (ProposalList, error) {
+// GetByFilters returns proposals with the total count if count is true
+func (r *Repo) GetByFilters(filters []Filter, count bool) (ProposalList, error) {
 	db := r.db.Model(&Proposal{}).InnerJoins("inner join daos on daos.id = proposals.dao_id")
 	for _, f := range filters {
-		if _, ok := f.(PageFilter); ok {
+		if isPageFilter(f) {
 			continue
 		}
 		db = f.Apply(db)
 	}
 
 	var cnt int64
-	err := db.Count(&cnt).Error
-	if err != nil {
-		return ProposalList{}, err
+	if count {
+		if err := db.Count(&cnt).Error; err != nil {
+			return ProposalList{}, err
+		}
 	}
 
 	return getProposalList(db, filters, cnt)
@@ -100,7 +136,7 @@ func (r *Repo) GetCountByFilters(filters []Filter) (int64, error) {
 		InnerJoins("inner join daos on daos.id = proposals.dao_id")
 
 	for _, f := range filters {
-		if _, ok := f.(PageFilter); ok {
+		if isPageFilter(f) {
 			continue
 		}
 
@@ -176,7 +212,7 @@ func (r *Repo) UpdateVotes(list []ResolvedAddress) error {
 
 func getProposalList(db *gorm.DB, filters []Filter, cnt int64) (ProposalList, error) {
 	for _, f := range filters {
-		if _, ok := f.(PageFilter); ok {
+		if isPageFilter(f) {
 			db = f.Apply(db)
 		}
 	}
@@ -192,11 +228,50 @@ func getProposalList(db *gorm.DB, filters []Filter, cnt int64) (ProposalList, er
 	}, nil
 }
 
-func (r *Repo) GetSucceededChoices(daoId uuid.UUID) []string {
-	var sc DaoSucceededChoices
-	request := r.db.Where(&DaoSucceededChoices{DaoID: daoId}).First(&sc)
-	if err := request.Error; err != nil {
-		return nil
+// CreateRevision stores the edited content
+func (r *Repo) CreateRevision(ctx context.Context, rev Revision) error {
+	return outbox.Conn(ctx, r.db).Create(&rev).Error
+}
+
+// GetRevisions returns revisions of the proposal ordered by the keyset
+func (r *Repo) GetRevisions(proposalID string, keyset pagination.Keyset, limit int) ([]Revision, error) {
+	var (
+		dummy = Revision{}
+		_     = dummy.ProposalID
+	)
+
+	var list []Revision
+	err := keyset.Apply(r.db.Where("proposal_id = ?", proposalID)).Limit(limit).Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("get revisions #%s: %w", proposalID, err)
 	}
-	return sc.Choices
+
+	return list, nil
+}
+
+// GetOutcomeSettings returns the dao settings of the outcome calculation, missed settings are left empty
+func (r *Repo) GetOutcomeSettings(daoID uuid.UUID) (OutcomeSettings, error) {
+	var (
+		dummy = DaoSucceededChoices{}
+		_     = dummy.DaoID
+		_     = dummy.Choices
+	)
+
+	var res struct {
+		SucceededChoices pq.StringArray `gorm:"type:text[]"`
+		Voting           []byte
+	}
+	err := r.db.Raw(`
+select sc.choices as succeeded_choices,
+       d.voting   as voting
+from daos d
+         left join dao_succeeded_choices sc on sc.dao_id = d.id
+where d.id = ?`, daoID).
+		Scan(&res).
+		Error
+	if err != nil {
+		return OutcomeSettings{}, fmt.Errorf("get outcome settings: %w", err)
+	}
+
+	return NewOutcomeSettings(res.Voting, Choices(res.SucceededChoices))
 }
@@ -0,0 +1,90 @@
+package proposal
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/pagination"
+)
+
+// SubjectProposalContentEdited is published when choices of the proposal are changed during the voting
+const SubjectProposalContentEdited = "core.proposal.content.edited"
+
+// revisionKeyset returns the latest revisions first
+var revisionKeyset = pagination.NewKeyset(
+	pagination.Key{Column: "id", Desc: true},
+)
+
+// Change describes the changed content field, the field is named as the column
+type Change struct {
+	Field string          `json:"field"`
+	Old   json.RawMessage `json:"old"`
+	New   json.RawMessage `json:"new"`
+}
+
+type Changes []Change
+
+// Revision is the record of the proposal content edited by the author,
+// the content at any moment is restored from old values of the following revisions
+type Revision struct {
+	ID         uint64 `gorm:"primary_key"`
+	CreatedAt  time.Time
+	ProposalID string
+	Changes    Changes `gorm:"serializer:json"`
+}
+
+func (Revision) TableName() string {
+	return "proposal_revisions"
+}
+
+type ContentEditedPayload struct {
+	ProposalID string    `json:"proposal_id"`
+	DaoID      uuid.UUID `json:"dao_id"`
+	Changes    Changes   `json:"changes"`
+}
+
+// diffContent returns changes of the content fields which are editable by authors
+func diffContent(old, new Proposal) Changes {
+	var changes Changes
+	add := func(field string, o, n any) {
+		changes = append(changes, Change{
+			Field: field,
+			Old:   marshalValue(o),
+			New:   marshalValue(n),
+		})
+	}
+
+	if old.Title != new.Title {
+		add("title", old.Title, new.Title)
+	}
+	if old.Body != new.Body {
+		add("body", old.Body, new.Body)
+	}
+	if !slices.Equal(old.Choices, new.Choices) {
+		add("choices", old.Choices, new.Choices)
+	}
+	if old.Discussion != new.Discussion {
+		add("discussion", old.Discussion, new.Discussion)
+	}
+
+	return changes
+}
+
+// choicesChanged checks that the changes contain choices
+func choicesChanged(changes Changes) bool {
+	return slices.ContainsFunc(changes, func(c Change) bool {
+		return c.Field == "choices"
+	})
+}
+
+func marshalValue(v any) json.RawMessage {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return json.RawMessage("null")
+	}
+
+	return data
+}
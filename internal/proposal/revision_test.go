@@ -0,0 +1,87 @@
+package proposal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitSaveRevision(t *testing.T) {
+	var (
+		active = Proposal{
+			ID:      "id-1",
+			Start:   int(time.Now().Add(-time.Hour).Unix()),
+			End:     int(time.Now().Add(time.Hour).Unix()),
+			Title:   "title",
+			Choices: Choices{"a", "b"},
+		}
+		ended = Proposal{
+			ID:      "id-1",
+			Start:   int(time.Now().Add(-2 * time.Hour).Unix()),
+			End:     int(time.Now().Add(-time.Hour).Unix()),
+			Title:   "title",
+			Choices: Choices{"a", "b"},
+		}
+		edited = func(p Proposal, title string, choices Choices) Proposal {
+			p.Title = title
+			p.Choices = choices
+
+			return p
+		}
+		choices = Change{Field: "choices", Old: []byte(`["a","b"]`), New: []byte(`["a","c"]`)}
+	)
+
+	for name, tc := range map[string]struct {
+		existed  Proposal
+		new      Proposal
+		revision Changes
+		event    bool
+	}{
+		"not edited": {
+			existed: active,
+			new:     edited(active, "title", Choices{"a", "b"}),
+		},
+		"title edited during voting": {
+			existed:  active,
+			new:      edited(active, "new title", Choices{"a", "b"}),
+			revision: Changes{{Field: "title", Old: []byte(`"title"`), New: []byte(`"new title"`)}},
+		},
+		"choices edited during voting": {
+			existed:  active,
+			new:      edited(active, "title", Choices{"a", "c"}),
+			revision: Changes{choices},
+			event:    true,
+		},
+		"choices edited after voting": {
+			existed:  ended,
+			new:      edited(ended, "title", Choices{"a", "c"}),
+			revision: Changes{choices},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dp := NewMockDataProvider(ctrl)
+			if tc.revision != nil {
+				dp.EXPECT().CreateRevision(gomock.Any(), Revision{ProposalID: "id-1", Changes: tc.revision}).Return(nil)
+			}
+
+			p := NewMockPublisher(ctrl)
+			if tc.event {
+				p.EXPECT().PublishJSON(gomock.Any(), SubjectProposalContentEdited, ContentEditedPayload{
+					ProposalID: "id-1",
+					Changes:    tc.revision,
+				}).Return(nil)
+			}
+
+			s, err := NewService(dp, p, NewMockEventRegistered(ctrl), NewMockDaoProvider(ctrl), NewMockEnsResolver(ctrl))
+			require.NoError(t, err)
+
+			require.NoError(t, s.saveRevision(context.Background(), tc.new, tc.existed))
+		})
+	}
+}
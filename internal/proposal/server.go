@@ -12,6 +12,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 
+	"github.com/goverland-labs/goverland-core-storage/internal/pagination"
 	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
 )
 
@@ -24,11 +25,13 @@ type Server struct {
 	storagepb.UnimplementedProposalServer
 
 	sp *Service
+	ts *TallyService
 }
 
-func NewServer(sp *Service) *Server {
+func NewServer(sp *Service, ts *TallyService) *Server {
 	return &Server{
 		sp: sp,
+		ts: ts,
 	}
 }
 
@@ -52,6 +55,67 @@ func (s *Server) GetByID(_ context.Context, req *storagepb.ProposalByIDRequest)
 	}, nil
 }
 
+func (s *Server) GetTally(_ context.Context, req *storagepb.ProposalTallyRequest) (*storagepb.ProposalTallyResponse, error) {
+	if req.GetProposalId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid proposal ID")
+	}
+
+	tally, err := s.ts.GetTally(req.GetProposalId())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "tally not found")
+	}
+
+	if err != nil {
+		log.Error().Err(err).Msgf("get proposal tally: %s", req.GetProposalId())
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return convertTallyToAPI(tally), nil
+}
+
+func (s *Server) GetRevisions(_ context.Context, req *storagepb.ProposalRevisionsRequest) (*storagepb.ProposalRevisionsResponse, error) {
+	if req.GetProposalId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid proposal ID")
+	}
+
+	limit := defaultDaoLimit
+	if req.GetLimit() > 0 {
+		limit = int(req.GetLimit())
+	}
+
+	var err error
+	keyset := revisionKeyset
+	if req.GetCursor() != "" {
+		if keyset, err = revisionKeyset.Decode(req.GetCursor()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+	}
+
+	list, err := s.sp.GetRevisions(req.GetProposalId(), keyset, limit+1)
+	if err != nil {
+		log.Error().Err(err).Msgf("get proposal revisions: %+v", req)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	list, nextCursor, err := pagination.Page(list, limit, func(page []Revision) (string, error) {
+		return keyset.Encode(page[len(page)-1].ID)
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("encode proposal revisions cursor: %+v", req)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	res := &storagepb.ProposalRevisionsResponse{
+		Items:      make([]*storagepb.ProposalRevision, len(list)),
+		NextCursor: nextCursor,
+	}
+	for i, item := range list {
+		res.Items[i] = convertRevisionToAPI(item)
+	}
+
+	return res, nil
+}
+
 func (s *Server) GetByFilter(_ context.Context, req *storagepb.ProposalByFilterRequest) (*storagepb.ProposalByFilterResponse, error) {
 	limit, offset := defaultDaoLimit, defaultOffset
 	if req.GetLimit() > 0 {
@@ -63,7 +127,6 @@ func (s *Server) GetByFilter(_ context.Context, req *storagepb.ProposalByFilterR
 	filters := []Filter{
 		SkipCanceled{},
 		SkipSpamFilter{},
-		PageFilter{Limit: limit, Offset: offset},
 	}
 
 	if req.GetLevel() == storagepb.ProposalInfoLevel_PROPOSAL_INFO_LEVEL_SHORT {
@@ -72,10 +135,25 @@ func (s *Server) GetByFilter(_ context.Context, req *storagepb.ProposalByFilterR
 
 	var list ProposalList
 	var err error
+	var nextCursor string
 
 	if req.GetTop() {
 		list, err = s.sp.GetTop(limit, offset)
 	} else {
+		keyset := defaultKeyset
+		if req.GetTitle() != "" {
+			keyset = searchKeyset
+		}
+
+		if req.GetCursor() != "" {
+			if keyset, err = keyset.Decode(req.GetCursor()); err != nil {
+				return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+			}
+			offset = 0
+		}
+
+		filters = append(filters, PageFilter{Limit: limit + 1, Offset: offset}, keyset)
+
 		if req.GetCategory() != "" {
 			filters = append(filters, CategoriesFilter{Category: req.GetCategory()})
 		}
@@ -90,22 +168,7 @@ func (s *Server) GetByFilter(_ context.Context, req *storagepb.ProposalByFilterR
 		}
 
 		if req.GetTitle() != "" {
-			filters = append(filters,
-				TitleFilter{Title: req.GetTitle()},
-				OrderFilter{
-					Orders: []Order{
-						OrderByStates,
-						OrderByVotes,
-						OrderByCreated,
-					},
-				})
-		} else {
-			filters = append(filters, OrderFilter{
-				Orders: []Order{
-					OrderByVotes,
-					OrderByCreated,
-				},
-			})
+			filters = append(filters, TitleFilter{Title: req.GetTitle()})
 		}
 
 		if len(req.GetProposalIds()) != 0 {
@@ -114,7 +177,12 @@ func (s *Server) GetByFilter(_ context.Context, req *storagepb.ProposalByFilterR
 			})
 		}
 
-		list, err = s.sp.GetByFilters(filters)
+		list, err = s.sp.GetByFilters(filters, !req.GetSkipTotalCount())
+		if err == nil {
+			list.Proposals, nextCursor, err = pagination.Page(list.Proposals, limit, func(page []Proposal) (string, error) {
+				return keyset.Encode(cursorValues(page[len(page)-1], req.GetTitle() != "")...)
+			})
+		}
 	}
 	if err != nil {
 		log.Error().Err(err).Msgf("get proposals by filter: %+v", req)
@@ -125,6 +193,7 @@ func (s *Server) GetByFilter(_ context.Context, req *storagepb.ProposalByFilterR
 		Proposals:      make([]*storagepb.ProposalInfo, 0, len(list.Proposals)),
 		ProposalsShort: make([]*storagepb.ProposalShortInfo, 0, len(list.Proposals)),
 		TotalCount:     uint64(list.TotalCount),
+		NextCursor:     nextCursor,
 	}
 
 	for _, info := range list.Proposals {
@@ -139,6 +208,16 @@ func (s *Server) GetByFilter(_ context.Context, req *storagepb.ProposalByFilterR
 	return res, nil
 }
 
+// cursorValues returns values of the proposal for keys of defaultKeyset or searchKeyset
+func cursorValues(p Proposal, search bool) []any {
+	values := []any{p.Votes, p.Created, p.ID}
+	if search {
+		values = append([]any{stateRank(p.State)}, values...)
+	}
+
+	return values
+}
+
 func convertProposalToAPI(info *Proposal) *storagepb.ProposalInfo {
 	return &storagepb.ProposalInfo{
 		Id:                info.ID,
@@ -173,6 +252,64 @@ func convertProposalToAPI(info *Proposal) *storagepb.ProposalInfo {
 		Timeline:          convertTimelineToAPI(info.Timeline),
 		Spam:              info.Spam,
 		InitialTokenPrice: info.InitialTokenPrice,
+		WinningChoices:    convertWinningChoicesToAPI(info.WinningChoices),
+		ScoresMismatch:    info.ScoresMismatch,
+	}
+}
+
+func convertWinningChoicesToAPI(list []int) []uint32 {
+	res := make([]uint32, len(list))
+	for i, choice := range list {
+		res[i] = uint32(choice)
+	}
+
+	return res
+}
+
+func convertTallyToAPI(t *Tally) *storagepb.ProposalTallyResponse {
+	rounds := make([]*storagepb.ProposalTallyRound, len(t.Rounds))
+	for i, round := range t.Rounds {
+		rounds[i] = &storagepb.ProposalTallyRound{
+			Round:  uint32(round.Round),
+			Scores: round.Scores,
+		}
+	}
+
+	diff := make([]*storagepb.ProposalScoreDiff, len(t.Diff))
+	for i, item := range t.Diff {
+		diff[i] = &storagepb.ProposalScoreDiff{
+			Choice:     uint32(item.Choice),
+			Upstream:   item.Upstream,
+			Calculated: item.Calculated,
+		}
+	}
+
+	return &storagepb.ProposalTallyResponse{
+		Scores:         t.Scores,
+		ScoresTotal:    t.ScoresTotal,
+		Votes:          uint64(t.Votes),
+		InvalidVotes:   uint64(t.InvalidVotes),
+		Rounds:         rounds,
+		ScoresMismatch: t.Mismatch(),
+		Diff:           diff,
+		CalculatedAt:   timestamppb.New(t.UpdatedAt),
+	}
+}
+
+func convertRevisionToAPI(r Revision) *storagepb.ProposalRevision {
+	changes := make([]*storagepb.ProposalFieldChange, len(r.Changes))
+	for i, change := range r.Changes {
+		changes[i] = &storagepb.ProposalFieldChange{
+			Field:    change.Field,
+			OldValue: string(change.Old),
+			NewValue: string(change.New),
+		}
+	}
+
+	return &storagepb.ProposalRevision{
+		Id:        r.ID,
+		CreatedAt: timestamppb.New(r.CreatedAt),
+		Changes:   changes,
 	}
 }
 
@@ -16,6 +16,9 @@ import (
 	coreevents "github.com/goverland-labs/goverland-platform-events/events/core"
 
 	"github.com/goverland-labs/goverland-core-storage/internal/metrics"
+	"github.com/goverland-labs/goverland-core-storage/internal/optimistic"
+	"github.com/goverland-labs/goverland-core-storage/internal/outbox"
+	"github.com/goverland-labs/goverland-core-storage/internal/pagination"
 )
 
 const (
@@ -32,24 +35,29 @@ type EnsResolver interface {
 }
 
 type DataProvider interface {
-	Create(Proposal) error
-	Update(proposal Proposal) error
+	CallInTx(ctx context.Context, cb func(ctx context.Context) error) error
+	Create(ctx context.Context, p Proposal) error
+	Update(ctx context.Context, p Proposal) error
+	UpdateTimeline(ctx context.Context, p Proposal) error
+	UpdateState(ctx context.Context, p Proposal) error
 	GetByID(string) (*Proposal, error)
 	GetAvailableForVoting(time.Duration) ([]*Proposal, error)
-	GetByFilters(filters []Filter) (ProposalList, error)
+	GetByFilters(filters []Filter, count bool) (ProposalList, error)
 	GetTop(filters []Filter) (ProposalList, error)
 	UpdateVotes(list []ResolvedAddress) error
-	GetSucceededChoices(daoId uuid.UUID) []string
+	GetOutcomeSettings(daoID uuid.UUID) (OutcomeSettings, error)
+	CreateRevision(ctx context.Context, r Revision) error
+	GetRevisions(proposalID string, keyset pagination.Keyset, limit int) ([]Revision, error)
 }
 
 type DaoProvider interface {
 	GetIDByOriginalID(string) (uuid.UUID, error)
-	GetTokenPrice(uuid.UUID, int) float64
+	GetTokenPrice(context.Context, uuid.UUID, int) float64
 }
 
 type EventRegistered interface {
 	EventExist(_ context.Context, id, t, event string) (bool, error)
-	RegisterEvent(_ context.Context, id, t, event string) error
+	RegisterEvent(_ context.Context, id, t, event string) (bool, error)
 }
 
 // todo: convert types to interfaces for unit testing
@@ -81,54 +89,63 @@ func NewService(
 }
 
 func (s *Service) HandleProposal(ctx context.Context, pro Proposal) error {
-	existed, err := s.repo.GetByID(pro.ID)
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return fmt.Errorf("handle: %w", err)
-	}
-	s.enrichWithSucceededChoices(&pro)
+	return optimistic.Retry(ctx, "handle_proposal", func(ctx context.Context) error {
+		existed, err := s.repo.GetByID(pro.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("handle: %w", err)
+		}
 
-	if existed == nil {
-		return s.processNew(ctx, pro)
-	}
+		if existed == nil {
+			return s.processNew(ctx, pro)
+		}
 
-	return s.processExisted(ctx, pro, *existed)
+		return s.processExisted(ctx, pro, *existed)
+	})
 }
 
 func (s *Service) HandleDeleted(ctx context.Context, pro Proposal) error {
-	existed, err := s.repo.GetByID(pro.ID)
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return fmt.Errorf("handle: %w", err)
-	}
-
-	if existed == nil {
-		return nil
-	}
+	return optimistic.Retry(ctx, "handle_deleted_proposal", func(ctx context.Context) error {
+		existed, err := s.repo.GetByID(pro.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("handle: %w", err)
+		}
 
-	existed.OriginalState = pro.OriginalState
-	existed.State = StateCancelled
+		if existed == nil {
+			return nil
+		}
 
-	if err = s.repo.Update(*existed); err != nil {
-		return fmt.Errorf("update: %w", err)
-	}
+		existed.OriginalState = pro.OriginalState
+		existed.State = StateCancelled
 
-	s.registerEvent(ctx, *existed, groupName, coreevents.SubjectProposalUpdated)
+		return s.repo.CallInTx(ctx, func(ctx context.Context) error {
+			if err := s.repo.UpdateState(ctx, *existed); err != nil {
+				return fmt.Errorf("update: %w", err)
+			}
 
-	return nil
+			return s.registerEvent(ctx, *existed, groupName, coreevents.SubjectProposalUpdated)
+		})
+	})
 }
 
-func (s *Service) HandleProposalTimeline(_ context.Context, id string, tl Timeline) error {
-	pr, err := s.repo.GetByID(id)
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return fmt.Errorf("handle: %w", err)
-	}
+func (s *Service) HandleProposalTimeline(ctx context.Context, id string, tl Timeline) error {
+	return optimistic.Retry(ctx, "proposal_timeline", func(ctx context.Context) error {
+		pr, err := s.repo.GetByID(id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Warn().Str("proposal_id", id).Msg("proposal is not ready yet")
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("handle: %w", err)
+		}
 
-	pr.Timeline = tl.ActualizeTimeline()
+		pr.Timeline = tl.ActualizeTimeline()
 
-	if err := s.repo.Update(*pr); err != nil {
-		return fmt.Errorf("update timeline: %w", err)
-	}
+		if err := s.repo.UpdateTimeline(ctx, *pr); err != nil {
+			return fmt.Errorf("update timeline: %w", err)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func (s *Service) processNew(ctx context.Context, p Proposal) error {
@@ -138,17 +155,28 @@ func (s *Service) processNew(ctx context.Context, p Proposal) error {
 	}
 
 	p.DaoID = daoID
-	p.State = p.CalculateState()
-	p.InitialTokenPrice = s.dp.GetTokenPrice(daoID, p.Created)
-	err = s.repo.Create(p)
-	if err != nil {
-		return fmt.Errorf("create proposal: %w", err)
-	}
+	if err := s.enrichWithOutcomeSettings(&p); err != nil {
+		return err
+	}
+	p.applyOutcome()
+	p.InitialTokenPrice = s.dp.GetTokenPrice(ctx, daoID, p.Created)
+	err = s.repo.CallInTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, p); err != nil {
+			return fmt.Errorf("create proposal: %w", err)
+		}
+
+		if err := s.registerEvent(ctx, p, groupName, coreevents.SubjectProposalCreated); err != nil {
+			return err
+		}
 
-	s.registerEvent(ctx, p, groupName, coreevents.SubjectProposalCreated)
+		if err := s.publisher.PublishJSON(outbox.WithKey(ctx, daoID.String()), coreevents.SubjectCheckActivitySince, pevents.DaoPayload{ID: p.DaoOriginalID}); err != nil {
+			return fmt.Errorf("publish dao event #%s: %w", daoID.String(), err)
+		}
 
-	if err = s.publisher.PublishJSON(ctx, coreevents.SubjectCheckActivitySince, pevents.DaoPayload{ID: p.DaoOriginalID}); err != nil {
-		log.Error().Err(err).Msgf("publish dao event #%s", daoID.String())
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	s.ensResolver.AddRequests([]string{p.Author})
@@ -156,8 +184,7 @@ func (s *Service) processNew(ctx context.Context, p Proposal) error {
 	return nil
 }
 
-// todo: think about virtual properties and updating specific model fields instead of all model
-// to avoid replacing new fields from existing entity
+// processExisted saves the proposal from the source, columns maintained by the service are not updated
 func (s *Service) processExisted(ctx context.Context, new, existed Proposal) error {
 	equal := compare(new, existed)
 	if equal {
@@ -166,56 +193,104 @@ func (s *Service) processExisted(ctx context.Context, new, existed Proposal) err
 
 	new.DaoID = existed.DaoID
 	new.CreatedAt = existed.CreatedAt
-	new.State = new.CalculateState()
+	if err := s.enrichWithOutcomeSettings(&new); err != nil {
+		return err
+	}
+	new.applyOutcome()
 	new.EnsName = existed.EnsName
 	new.Timeline = existed.Timeline
 	new.InitialTokenPrice = existed.InitialTokenPrice
-	err := s.repo.Update(new)
-	if err != nil {
-		return fmt.Errorf("update proposal #%s: %w", new.ID, err)
+	new.Version = existed.Version
+
+	return s.repo.CallInTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, new); err != nil {
+			return fmt.Errorf("update proposal #%s: %w", new.ID, err)
+		}
+
+		if err := s.saveRevision(ctx, new, existed); err != nil {
+			return err
+		}
+
+		if err := s.registerEvent(ctx, new, groupName, coreevents.SubjectProposalUpdated); err != nil {
+			return err
+		}
+
+		return s.checkSpecificUpdate(ctx, new, existed)
+	})
+}
+
+// saveRevision stores edited content of the proposal, choices edited during the voting are published separately
+func (s *Service) saveRevision(ctx context.Context, new, existed Proposal) error {
+	changes := diffContent(existed, new)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	if err := s.repo.CreateRevision(ctx, Revision{ProposalID: new.ID, Changes: changes}); err != nil {
+		return fmt.Errorf("create revision #%s: %w", new.ID, err)
 	}
 
-	s.registerEvent(ctx, new, groupName, coreevents.SubjectProposalUpdated)
-	s.checkSpecificUpdate(ctx, new, existed)
+	if !choicesChanged(changes) || !new.InProgress() {
+		return nil
+	}
+
+	payload := ContentEditedPayload{ProposalID: new.ID, DaoID: new.DaoID, Changes: changes}
+	if err := s.publisher.PublishJSON(outbox.WithKey(ctx, new.ID), SubjectProposalContentEdited, payload); err != nil {
+		return fmt.Errorf("publish content edited event #%s: %w", new.ID, err)
+	}
 
 	return nil
 }
 
-func (s *Service) checkSpecificUpdate(ctx context.Context, new, existed Proposal) {
+func (s *Service) checkSpecificUpdate(ctx context.Context, new, existed Proposal) error {
 	if new.QuorumReached() {
-		go s.registerEventOnce(ctx, new, groupName, coreevents.SubjectProposalVotingQuorumReached)
+		if err := s.publishEventOnce(ctx, new, groupName, coreevents.SubjectProposalVotingQuorumReached); err != nil {
+			return err
+		}
 	}
 
 	if new.State != existed.State {
-		go s.registerEvent(ctx, new, groupName, coreevents.SubjectProposalUpdatedState)
+		return s.registerEvent(ctx, new, groupName, coreevents.SubjectProposalUpdatedState)
 	}
+
+	return nil
 }
 
 func (s *Service) registerEventOnce(ctx context.Context, p Proposal, group, subject string) {
-	var err error
+	if err := s.publishEventOnce(ctx, p, group, subject); err != nil {
+		log.Error().Err(err).Msgf("register event #%s", p.ID)
+	}
+}
+
+// publishEventOnce registers the event and publishes it only if it isn't registered by a concurrent writer
+func (s *Service) publishEventOnce(ctx context.Context, p Proposal, group, subject string) error {
 	if ok, err := s.er.EventExist(ctx, p.ID, group, subject); ok || err != nil {
-		return
+		return err
 	}
 
-	s.registerEvent(ctx, p, group, subject)
+	return s.repo.CallInTx(ctx, func(ctx context.Context) error {
+		registered, err := s.er.RegisterEvent(ctx, p.ID, group, subject)
+		if err != nil || !registered {
+			return err
+		}
 
-	if err = s.er.RegisterEvent(ctx, p.ID, group, subject); err != nil {
-		log.Error().Err(err).Msgf("register event #%s", p.ID)
-		return
-	}
+		return s.registerEvent(ctx, p, group, subject)
+	})
 }
 
-func (s *Service) registerEvent(ctx context.Context, p Proposal, group, subject string) {
-	var err error
+// registerEvent stores the event and publishes it through the outbox
+func (s *Service) registerEvent(ctx context.Context, p Proposal, group, subject string) (err error) {
 	defer func(group, subject string) {
 		metricSendEventGauge.
 			WithLabelValues(group, subject, metrics.ErrLabelValue(err)).
 			Inc()
 	}(group, subject)
 
-	if err = s.publisher.PublishJSON(ctx, subject, convertToCoreEvent(p)); err != nil {
-		log.Error().Err(err).Msgf("publish event #%s", p.ID)
+	if err = s.publisher.PublishJSON(outbox.WithKey(ctx, p.ID), subject, convertToCoreEvent(p)); err != nil {
+		return fmt.Errorf("publish event #%s: %w", p.ID, err)
 	}
+
+	return nil
 }
 
 func compare(p1, p2 Proposal) bool {
@@ -225,8 +300,11 @@ func compare(p1, p2 Proposal) bool {
 	p1.DaoOriginalID = p2.DaoOriginalID
 	p1.State = p2.State
 	p1.EnsName = p2.EnsName
-	p1.SucceededChoices = p2.SucceededChoices
+	p1.WinningChoices = p2.WinningChoices
+	p1.Settings = p2.Settings
 	p1.InitialTokenPrice = p2.InitialTokenPrice
+	p1.ScoresMismatch = p2.ScoresMismatch
+	p1.Version = p2.Version
 
 	return reflect.DeepEqual(p1, p2)
 }
@@ -265,21 +343,54 @@ func (s *Service) processAvailableForVoting(ctx context.Context) error {
 			go s.registerEventOnce(ctx, *pr, groupName, coreevents.SubjectProposalVotingEndsSoon)
 		}
 
-		s.enrichWithSucceededChoices(pr)
-		state := pr.CalculateState()
-		if state != pr.State {
-			pr.State = state
-			if err = s.repo.Update(*pr); err != nil {
-				return fmt.Errorf("update proposal #%s: %w", pr.ID, err)
+		if err = s.enrichWithOutcomeSettings(pr); err != nil {
+			return err
+		}
+		if pr.outcomeChanged(pr.CalculateOutcome()) {
+			if err = s.actualizeState(ctx, pr.ID); err != nil {
+				return err
 			}
-
-			s.registerEvent(ctx, *pr, groupName, coreevents.SubjectProposalUpdated)
 		}
 	}
 
 	return nil
 }
 
+// actualizeState recalculates the state of the fresh proposal and saves it if it was changed
+func (s *Service) actualizeState(ctx context.Context, id string) error {
+	return optimistic.Retry(ctx, "proposal_state", func(ctx context.Context) error {
+		pr, err := s.repo.GetByID(id)
+		if err != nil {
+			return fmt.Errorf("get proposal #%s: %w", id, err)
+		}
+
+		if err := s.enrichWithOutcomeSettings(pr); err != nil {
+			return err
+		}
+		if !pr.applyOutcome() {
+			return nil
+		}
+
+		return s.repo.CallInTx(ctx, func(ctx context.Context) error {
+			if err := s.repo.UpdateState(ctx, *pr); err != nil {
+				return fmt.Errorf("update proposal #%s: %w", pr.ID, err)
+			}
+
+			return s.registerEvent(ctx, *pr, groupName, coreevents.SubjectProposalUpdated)
+		})
+	})
+}
+
+// GetRevisions returns the page of content revisions of the proposal
+func (s *Service) GetRevisions(id string, keyset pagination.Keyset, limit int) ([]Revision, error) {
+	list, err := s.repo.GetRevisions(id, keyset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("get revisions: %w", err)
+	}
+
+	return list, nil
+}
+
 func (s *Service) GetByID(id string) (*Proposal, error) {
 	pro, err := s.repo.GetByID(id)
 	if err != nil {
@@ -289,8 +400,8 @@ func (s *Service) GetByID(id string) (*Proposal, error) {
 	return pro, nil
 }
 
-func (s *Service) GetByFilters(filters []Filter) (ProposalList, error) {
-	list, err := s.repo.GetByFilters(filters)
+func (s *Service) GetByFilters(filters []Filter, count bool) (ProposalList, error) {
+	list, err := s.repo.GetByFilters(filters, count)
 	if err != nil {
 		return ProposalList{}, fmt.Errorf("get by filters: %w", err)
 	}
@@ -368,20 +479,31 @@ func (s *Service) HandleResolvedAddresses(ctx context.Context, list []ResolvedAd
 
 	proposals, err := s.repo.GetByFilters([]Filter{
 		AuthorsFilter{List: authors},
-	})
+	}, false)
 	if err != nil {
 		return fmt.Errorf("s.repo.GetByFilters: %w", err)
 	}
 
 	for i := range proposals.Proposals {
-		s.registerEvent(ctx, proposals.Proposals[i], groupName, coreevents.SubjectProposalUpdated)
+		if err = s.registerEvent(ctx, proposals.Proposals[i], groupName, coreevents.SubjectProposalUpdated); err != nil {
+			log.Error().Err(err).Msg("register proposal updated event")
+		}
 	}
 
 	return nil
 }
 
-func (s *Service) enrichWithSucceededChoices(pro *Proposal) {
-	if pro.IsSingleChoice() {
-		pro.SucceededChoices = s.repo.GetSucceededChoices(pro.DaoID)
+// enrichWithOutcomeSettings loads the dao settings for the voting types with the outcome calculators
+func (s *Service) enrichWithOutcomeSettings(pro *Proposal) error {
+	if _, ok := outcomeCalculators[pro.Type]; !ok {
+		return nil
 	}
+
+	settings, err := s.repo.GetOutcomeSettings(pro.DaoID)
+	if err != nil {
+		return fmt.Errorf("get outcome settings of dao #%s: %w", pro.DaoID, err)
+	}
+	pro.Settings = settings
+
+	return nil
 }
@@ -21,10 +21,22 @@ var defaultPublisher = func(ctrl *gomock.Controller) Publisher {
 	return m
 }
 
+var inTx = func(m *MockDataProvider) {
+	m.EXPECT().CallInTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, cb func(context.Context) error) error {
+		return cb(ctx)
+	})
+}
+
+var txDataProvider = func(ctrl *gomock.Controller) DataProvider {
+	m := NewMockDataProvider(ctrl)
+	inTx(m)
+	return m
+}
+
 var defaultDaoProvider = func(ctrl *gomock.Controller) DaoProvider {
 	m := NewMockDaoProvider(ctrl)
 	m.EXPECT().GetIDByOriginalID(gomock.Any()).AnyTimes().Return(uuid.New(), nil)
-	m.EXPECT().GetTokenPrice(gomock.Any(), gomock.Any()).AnyTimes().Return(0.0)
+	m.EXPECT().GetTokenPrice(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(0.0)
 	return m
 }
 
@@ -77,8 +89,9 @@ func TestUnitHandleProposal(t *testing.T) {
 		"correct creating": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(nil, gorm.ErrRecordNotFound)
-				m.EXPECT().Create(gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil)
 				return m
 			},
 			p: func(ctrl *gomock.Controller) Publisher {
@@ -92,8 +105,13 @@ func TestUnitHandleProposal(t *testing.T) {
 		"correct updating": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(&Proposal{ID: "id-1", Title: "updated", Quorum: 50}, nil)
-				m.EXPECT().Update(gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().CreateRevision(gomock.Any(), Revision{
+					ProposalID: "id-1",
+					Changes:    Changes{{Field: "title", Old: []byte(`"updated"`), New: []byte(`"name"`)}},
+				}).Times(1).Return(nil)
 				return m
 			},
 			p: func(ctrl *gomock.Controller) Publisher {
@@ -107,8 +125,9 @@ func TestUnitHandleProposal(t *testing.T) {
 		"do not update for equal objects": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(&Proposal{ID: "id-1"}, nil)
-				m.EXPECT().Update(gomock.Any()).Times(0).Return(nil)
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0).Return(nil)
 				return m
 			},
 			p: func(ctrl *gomock.Controller) Publisher {
@@ -120,6 +139,7 @@ func TestUnitHandleProposal(t *testing.T) {
 		"raise err on problems with reading from DB": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(nil, errors.New("unexpected error"))
 				return m
 			},
@@ -132,8 +152,9 @@ func TestUnitHandleProposal(t *testing.T) {
 		"raise err on problems with creating in DB": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(nil, gorm.ErrRecordNotFound)
-				m.EXPECT().Create(gomock.Any()).Times(1).Return(errors.New("unexpected error"))
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("unexpected error"))
 				return m
 			},
 			p: func(ctrl *gomock.Controller) Publisher {
@@ -142,26 +163,28 @@ func TestUnitHandleProposal(t *testing.T) {
 			event:    Proposal{ID: "id-1"},
 			expected: errors.New("unexpected error"),
 		},
-		"allow do not send event after creating": {
+		"raise err on problems with storing event after creating": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(nil, gorm.ErrRecordNotFound)
-				m.EXPECT().Create(gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil)
 				return m
 			},
 			p: func(ctrl *gomock.Controller) Publisher {
 				m := NewMockPublisher(ctrl)
-				m.EXPECT().PublishJSON(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(errors.New("unexpected error"))
+				m.EXPECT().PublishJSON(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(errors.New("unexpected error"))
 				return m
 			},
 			event:    Proposal{ID: "id-1"},
-			expected: nil,
+			expected: errors.New("unexpected error"),
 		},
 		"raise err on problems with updating in DB": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(&Proposal{ID: "id-1", Title: "name"}, nil)
-				m.EXPECT().Update(gomock.Any()).Times(1).Return(errors.New("unexpected error"))
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("unexpected error"))
 				return m
 			},
 			p: func(ctrl *gomock.Controller) Publisher {
@@ -170,20 +193,22 @@ func TestUnitHandleProposal(t *testing.T) {
 			event:    Proposal{ID: "id-1"},
 			expected: errors.New("unexpected error"),
 		},
-		"allow do not send event after updating": {
+		"raise err on problems with storing event after updating": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetByID(gomock.Any()).Times(1).Return(&Proposal{ID: "id-1", Title: "name", Quorum: 50}, nil)
-				m.EXPECT().Update(gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().CreateRevision(gomock.Any(), gomock.Any()).Times(1).Return(nil)
 				return m
 			},
 			p: func(ctrl *gomock.Controller) Publisher {
 				m := NewMockPublisher(ctrl)
-				m.EXPECT().PublishJSON(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(errors.New("unexpected error"))
+				m.EXPECT().PublishJSON(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(errors.New("unexpected error"))
 				return m
 			},
 			event:    Proposal{ID: "id-1", Quorum: 50},
-			expected: nil,
+			expected: errors.New("unexpected error"),
 		},
 	} {
 		t.Run(name, func(t *testing.T) {
@@ -222,6 +247,7 @@ func TestUnitProcessAvailableForVoting(t *testing.T) {
 		"error on getting data": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
+				inTx(m)
 				m.EXPECT().GetAvailableForVoting(gomock.Any()).MaxTimes(1).Return(nil, gorm.ErrRecordNotFound)
 				return m
 			},
@@ -233,26 +259,30 @@ func TestUnitProcessAvailableForVoting(t *testing.T) {
 		"voting has started": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
-				m.EXPECT().GetAvailableForVoting(gomock.Any()).MaxTimes(1).Return([]*Proposal{
-					{
-						CreatedAt: time.Now().Add(-time.Hour * 24),
-						Start:     int(time.Now().Add(-time.Hour * 2).Unix()),
-						End:       int(time.Now().Add(time.Hour * 24).Unix()),
-					},
-				}, nil)
-				m.EXPECT().Update(gomock.Any()).Times(1).Return(nil)
+				inTx(m)
+				pr := Proposal{
+					CreatedAt: time.Now().Add(-time.Hour * 24),
+					Start:     int(time.Now().Add(-time.Hour * 2).Unix()),
+					End:       int(time.Now().Add(time.Hour * 24).Unix()),
+				}
+				m.EXPECT().GetAvailableForVoting(gomock.Any()).MaxTimes(1).Return([]*Proposal{&pr}, nil)
+				m.EXPECT().GetByID(gomock.Any()).Times(1).DoAndReturn(func(string) (*Proposal, error) {
+					fresh := pr
+					return &fresh, nil
+				})
+				m.EXPECT().UpdateState(gomock.Any(), gomock.Any()).Times(1).Return(nil)
 				return m
 			},
 			er: func(ctrl *gomock.Controller) EventRegistered {
 				m := NewMockEventRegistered(ctrl)
 				m.EXPECT().EventExist(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
 				m.EXPECT().RegisterEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).
-					DoAndReturn(func(_ context.Context, _, _, event string) error {
+					DoAndReturn(func(_ context.Context, _, _, event string) (bool, error) {
 						if coreevents.SubjectProposalVotingStarted != event {
 							ctrl.T.Errorf("wrong subject event: %s instead of %s", event, coreevents.SubjectProposalVotingStarted)
 						}
 
-						return nil
+						return true, nil
 					})
 				return m
 			},
@@ -261,26 +291,30 @@ func TestUnitProcessAvailableForVoting(t *testing.T) {
 		"voting has ended": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
-				m.EXPECT().GetAvailableForVoting(gomock.Any()).MaxTimes(1).Return([]*Proposal{
-					{
-						CreatedAt: time.Now().Add(-time.Hour * 24),
-						Start:     int(time.Now().Add(-time.Hour * 25).Unix()),
-						End:       int(time.Now().Add(-time.Hour * 1).Unix()),
-					},
-				}, nil)
-				m.EXPECT().Update(gomock.Any()).Times(1).Return(nil)
+				inTx(m)
+				pr := Proposal{
+					CreatedAt: time.Now().Add(-time.Hour * 24),
+					Start:     int(time.Now().Add(-time.Hour * 25).Unix()),
+					End:       int(time.Now().Add(-time.Hour * 1).Unix()),
+				}
+				m.EXPECT().GetAvailableForVoting(gomock.Any()).MaxTimes(1).Return([]*Proposal{&pr}, nil)
+				m.EXPECT().GetByID(gomock.Any()).Times(1).DoAndReturn(func(string) (*Proposal, error) {
+					fresh := pr
+					return &fresh, nil
+				})
+				m.EXPECT().UpdateState(gomock.Any(), gomock.Any()).Times(1).Return(nil)
 				return m
 			},
 			er: func(ctrl *gomock.Controller) EventRegistered {
 				m := NewMockEventRegistered(ctrl)
 				m.EXPECT().EventExist(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
 				m.EXPECT().RegisterEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).
-					DoAndReturn(func(_ context.Context, _, _, event string) error {
+					DoAndReturn(func(_ context.Context, _, _, event string) (bool, error) {
 						if coreevents.SubjectProposalVotingEnded != event {
 							ctrl.T.Errorf("wrong subject event: %s instead of %s", event, coreevents.SubjectProposalVotingEnded)
 						}
 
-						return nil
+						return true, nil
 					})
 				return m
 			},
@@ -289,26 +323,30 @@ func TestUnitProcessAvailableForVoting(t *testing.T) {
 		"voting is coming": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
-				m.EXPECT().GetAvailableForVoting(gomock.Any()).MaxTimes(1).Return([]*Proposal{
-					{
-						CreatedAt: time.Now().Add(-time.Hour * 24),
-						Start:     int(time.Now().Add(time.Minute * 25).Unix()),
-						End:       int(time.Now().Add(time.Hour * 24 * 7).Unix()),
-					},
-				}, nil)
-				m.EXPECT().Update(gomock.Any()).Times(1).Return(nil)
+				inTx(m)
+				pr := Proposal{
+					CreatedAt: time.Now().Add(-time.Hour * 24),
+					Start:     int(time.Now().Add(time.Minute * 25).Unix()),
+					End:       int(time.Now().Add(time.Hour * 24 * 7).Unix()),
+				}
+				m.EXPECT().GetAvailableForVoting(gomock.Any()).MaxTimes(1).Return([]*Proposal{&pr}, nil)
+				m.EXPECT().GetByID(gomock.Any()).Times(1).DoAndReturn(func(string) (*Proposal, error) {
+					fresh := pr
+					return &fresh, nil
+				})
+				m.EXPECT().UpdateState(gomock.Any(), gomock.Any()).Times(1).Return(nil)
 				return m
 			},
 			er: func(ctrl *gomock.Controller) EventRegistered {
 				m := NewMockEventRegistered(ctrl)
 				m.EXPECT().EventExist(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
 				m.EXPECT().RegisterEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).
-					DoAndReturn(func(_ context.Context, _, _, event string) error {
+					DoAndReturn(func(_ context.Context, _, _, event string) (bool, error) {
 						if coreevents.SubjectProposalVotingStartsSoon != event {
 							ctrl.T.Errorf("wrong subject event: %s instead of %s", event, coreevents.SubjectProposalVotingStartsSoon)
 						}
 
-						return nil
+						return true, nil
 					})
 				return m
 			},
@@ -317,20 +355,24 @@ func TestUnitProcessAvailableForVoting(t *testing.T) {
 		"send single event": {
 			dp: func(ctrl *gomock.Controller) DataProvider {
 				m := NewMockDataProvider(ctrl)
-				m.EXPECT().GetAvailableForVoting(gomock.Any()).MaxTimes(1).Return([]*Proposal{
-					{
-						CreatedAt: time.Now().Add(-time.Hour * 24),
-						Start:     int(time.Now().Add(-time.Minute * 30).Unix()),
-						End:       int(time.Now().Add(time.Hour * 24).Unix()),
-					},
-				}, nil)
-				m.EXPECT().Update(gomock.Any()).Times(1).Return(nil)
+				inTx(m)
+				pr := Proposal{
+					CreatedAt: time.Now().Add(-time.Hour * 24),
+					Start:     int(time.Now().Add(-time.Minute * 30).Unix()),
+					End:       int(time.Now().Add(time.Hour * 24).Unix()),
+				}
+				m.EXPECT().GetAvailableForVoting(gomock.Any()).MaxTimes(1).Return([]*Proposal{&pr}, nil)
+				m.EXPECT().GetByID(gomock.Any()).Times(1).DoAndReturn(func(string) (*Proposal, error) {
+					fresh := pr
+					return &fresh, nil
+				})
+				m.EXPECT().UpdateState(gomock.Any(), gomock.Any()).Times(1).Return(nil)
 				return m
 			},
 			er: func(ctrl *gomock.Controller) EventRegistered {
 				m := NewMockEventRegistered(ctrl)
 				m.EXPECT().EventExist(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
-				m.EXPECT().RegisterEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0).Return(nil)
+				m.EXPECT().RegisterEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0).Return(false, nil)
 				return m
 			},
 			expected: nil,
@@ -376,12 +418,12 @@ func TestUnitCheckSpecificUpdate(t *testing.T) {
 				m := NewMockEventRegistered(ctrl)
 				m.EXPECT().EventExist(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
 				m.EXPECT().RegisterEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).
-					DoAndReturn(func(_ context.Context, _, _, event string) error {
+					DoAndReturn(func(_ context.Context, _, _, event string) (bool, error) {
 						if coreevents.SubjectProposalVotingQuorumReached != event {
 							ctrl.T.Errorf("wrong subject event: %s instead of %s", event, coreevents.SubjectProposalVotingQuorumReached)
 						}
 
-						return nil
+						return true, nil
 					})
 				return m
 			},
@@ -414,7 +456,7 @@ func TestUnitCheckSpecificUpdate(t *testing.T) {
 				ctrl.Finish()
 			}()
 			s, err := NewService(
-				nil,
+				txDataProvider(ctrl),
 				tc.p(ctrl),
 				tc.er(ctrl),
 				defaultDaoProvider(ctrl),
@@ -422,7 +464,7 @@ func TestUnitCheckSpecificUpdate(t *testing.T) {
 			)
 			require.Nil(t, err)
 
-			s.checkSpecificUpdate(context.TODO(), tc.new, tc.existed)
+			require.NoError(t, s.checkSpecificUpdate(context.TODO(), tc.new, tc.existed))
 		})
 	}
 }
@@ -436,7 +478,7 @@ func TestUnitRegisterEventOnce(t *testing.T) {
 			er: func(ctrl *gomock.Controller) EventRegistered {
 				m := NewMockEventRegistered(ctrl)
 				m.EXPECT().EventExist(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
-				m.EXPECT().RegisterEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				m.EXPECT().RegisterEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
 				return m
 			},
 			p: func(ctrl *gomock.Controller) Publisher {
@@ -445,11 +487,24 @@ func TestUnitRegisterEventOnce(t *testing.T) {
 				return m
 			},
 		},
+		"do not send event registered concurrently": {
+			er: func(ctrl *gomock.Controller) EventRegistered {
+				m := NewMockEventRegistered(ctrl)
+				m.EXPECT().EventExist(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+				m.EXPECT().RegisterEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+				return m
+			},
+			p: func(ctrl *gomock.Controller) Publisher {
+				m := NewMockPublisher(ctrl)
+				m.EXPECT().PublishJSON(gomock.Any(), gomock.Any(), gomock.Any()).Times(0).Return(nil)
+				return m
+			},
+		},
 		"do not send event twice": {
 			er: func(ctrl *gomock.Controller) EventRegistered {
 				m := NewMockEventRegistered(ctrl)
 				m.EXPECT().EventExist(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(true, nil)
-				m.EXPECT().RegisterEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0).Return(nil)
+				m.EXPECT().RegisterEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0).Return(false, nil)
 				return m
 			},
 			p: func(ctrl *gomock.Controller) Publisher {
@@ -462,7 +517,7 @@ func TestUnitRegisterEventOnce(t *testing.T) {
 			er: func(ctrl *gomock.Controller) EventRegistered {
 				m := NewMockEventRegistered(ctrl)
 				m.EXPECT().EventExist(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(false, errors.New("unspecified error"))
-				m.EXPECT().RegisterEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0).Return(nil)
+				m.EXPECT().RegisterEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0).Return(false, nil)
 				return m
 			},
 			p: func(ctrl *gomock.Controller) Publisher {
@@ -479,7 +534,7 @@ func TestUnitRegisterEventOnce(t *testing.T) {
 				ctrl.Finish()
 			}()
 			s, err := NewService(
-				nil,
+				txDataProvider(ctrl),
 				tc.p(ctrl),
 				tc.er(ctrl),
 				defaultDaoProvider(ctrl),
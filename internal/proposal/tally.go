@@ -0,0 +1,443 @@
+package proposal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// scoresStateFinal is set by the source once the scores of the ended proposal are not changed anymore
+	scoresStateFinal = "final"
+	tallyBatchSize   = 100
+	// tallyGracePeriod gives the vote consumer time to store the latest votes of the ended proposal
+	tallyGracePeriod = time.Hour
+	// tallyTolerance is the relative difference of scores which is not a mismatch, upstream scores are float32
+	tallyTolerance = 1e-4
+	// failed proposals are retried with exponential backoff between these delays
+	tallyMinRetryDelay = 10 * time.Minute
+	tallyMaxRetryDelay = 24 * time.Hour
+)
+
+var ErrUnsupportedVotingType = errors.New("unsupported voting type")
+
+// Tally is the result of the recalculation of the proposal scores from stored votes
+type Tally struct {
+	ProposalID  string `gorm:"primary_key"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Scores      []float64 `gorm:"serializer:json"`
+	ScoresTotal float64
+	Votes       int
+	// InvalidVotes are skipped by the calculation as they are skipped by the source
+	InvalidVotes int
+	// Rounds are the instant-runoff rounds of ranked-choice proposals
+	Rounds []TallyRound `gorm:"serializer:json"`
+	// Diff keeps the choices with upstream scores which disagree with calculated ones
+	Diff []ScoreDiff `gorm:"serializer:json"`
+	// ScoresUpdated is the scores update time of the proposal at the moment of the calculation
+	ScoresUpdated int
+}
+
+func (Tally) TableName() string {
+	return "proposal_tallies"
+}
+
+// TallyAttempt keeps failures of the proposal tally, the proposal is not selected for the tally until the next attempt
+type TallyAttempt struct {
+	ProposalID    string `gorm:"primary_key"`
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+}
+
+func (TallyAttempt) TableName() string {
+	return "proposal_tally_attempts"
+}
+
+// Mismatch checks that upstream scores disagree with calculated ones
+func (t *Tally) Mismatch() bool {
+	return len(t.Diff) > 0
+}
+
+// TallyRound contains scores of the choices in the round, eliminated choices have zero scores
+type TallyRound struct {
+	Round  int
+	Scores []float64
+}
+
+// ScoreDiff is the disagreement of scores of the choice by the index
+type ScoreDiff struct {
+	Choice     int
+	Upstream   float64
+	Calculated float64
+}
+
+// TallyVote is the part of the stored vote which is used by the tally
+type TallyVote struct {
+	Choice json.RawMessage
+	Vp     float64
+}
+
+type TallyDataProvider interface {
+	// GetForTally returns ended proposals with final scores which have no tally or it is outdated,
+	// failed proposals are returned after new ones once their next attempt is due
+	GetForTally(types []string, limit int) ([]Proposal, error)
+	GetTallyVotes(proposalID string) ([]TallyVote, error)
+	// SaveTally stores the tally and the scores mismatch flag of the proposal and clears its failed attempts
+	SaveTally(ctx context.Context, t Tally) error
+	// PostponeTally records the failed attempt and postpones the next one with backoff
+	PostponeTally(ctx context.Context, proposalID string, reason string) error
+	GetTally(proposalID string) (*Tally, error)
+}
+
+type TallyService struct {
+	repo TallyDataProvider
+}
+
+func NewTallyService(r TallyDataProvider) *TallyService {
+	return &TallyService{
+		repo: r,
+	}
+}
+
+// Recalculate calculates tallies of ended proposals and returns the number of stored ones,
+// proposals which failed are postponed, so they don't take the batch from other proposals
+func (s *TallyService) Recalculate(ctx context.Context) (int, error) {
+	list, err := s.repo.GetForTally(tallyVotingTypes(), tallyBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("get proposals for tally: %w", err)
+	}
+
+	var cnt int
+	for _, p := range list {
+		if err := s.recalculate(ctx, p); err != nil {
+			log.Error().Err(err).Str("proposal_id", p.ID).Msg("recalculate tally")
+
+			if err := s.repo.PostponeTally(ctx, p.ID, err.Error()); err != nil {
+				log.Error().Err(err).Str("proposal_id", p.ID).Msg("postpone tally")
+			}
+
+			continue
+		}
+
+		cnt++
+	}
+
+	return cnt, nil
+}
+
+func (s *TallyService) recalculate(ctx context.Context, p Proposal) error {
+	votes, err := s.repo.GetTallyVotes(p.ID)
+	if err != nil {
+		return fmt.Errorf("get votes: %w", err)
+	}
+
+	tally, err := calculateTally(p, votes)
+	if err != nil {
+		return fmt.Errorf("calculate tally: %w", err)
+	}
+
+	if err := s.repo.SaveTally(ctx, tally); err != nil {
+		return fmt.Errorf("save tally: %w", err)
+	}
+
+	if tally.Mismatch() {
+		log.Warn().
+			Str("proposal_id", p.ID).
+			Str("type", p.Type).
+			Int("choices", len(tally.Diff)).
+			Msg("proposal scores mismatch")
+	}
+
+	return nil
+}
+
+func (s *TallyService) GetTally(proposalID string) (*Tally, error) {
+	tally, err := s.repo.GetTally(proposalID)
+	if err != nil {
+		return nil, fmt.Errorf("get tally: %w", err)
+	}
+
+	return tally, nil
+}
+
+// tallyFunc calculates scores of the choices from votes, choices of votes are 1-based as the source sends them
+type tallyFunc func(choices int, votes []TallyVote) Tally
+
+var tallyFuncs = map[string]tallyFunc{
+	VotingBasic:        tallySingleChoice,
+	VotingSingleChoice: tallySingleChoice,
+	VotingApproval:     tallyApproval,
+	VotingRankedChoice: tallyRankedChoice,
+	VotingQuadratic:    tallyQuadratic,
+	VotingWeighted:     tallyWeighted,
+}
+
+func tallyVotingTypes() []string {
+	types := make([]string, 0, len(tallyFuncs))
+	for t := range tallyFuncs {
+		types = append(types, t)
+	}
+	slices.Sort(types)
+
+	return types
+}
+
+// calculateTally recalculates scores of the proposal by the rules of the voting type and compares them with upstream ones
+func calculateTally(p Proposal, votes []TallyVote) (Tally, error) {
+	fn, ok := tallyFuncs[p.Type]
+	if !ok {
+		return Tally{}, fmt.Errorf("%w: %s", ErrUnsupportedVotingType, p.Type)
+	}
+
+	tally := fn(len(p.Choices), votes)
+	tally.ProposalID = p.ID
+	tally.Votes = len(votes)
+	tally.ScoresUpdated = p.ScoresUpdated
+	tally.Diff = diffScores(p.Scores, tally.Scores)
+
+	return tally, nil
+}
+
+func tallySingleChoice(choices int, votes []TallyVote) Tally {
+	tally := Tally{Scores: make([]float64, choices)}
+	for _, v := range votes {
+		var choice int
+		if err := json.Unmarshal(v.Choice, &choice); err != nil || !validChoice(choice, choices) {
+			tally.InvalidVotes++
+			continue
+		}
+
+		tally.Scores[choice-1] += v.Vp
+		tally.ScoresTotal += v.Vp
+	}
+
+	return tally
+}
+
+// tallyApproval adds the voting power to every approved choice
+func tallyApproval(choices int, votes []TallyVote) Tally {
+	tally := Tally{Scores: make([]float64, choices)}
+	for _, v := range votes {
+		list, ok := parseChoiceList(v.Choice, choices)
+		if !ok {
+			tally.InvalidVotes++
+			continue
+		}
+
+		for i := range tally.Scores {
+			if slices.Contains(list, i+1) {
+				tally.Scores[i] += v.Vp
+			}
+		}
+		tally.ScoresTotal += v.Vp
+	}
+
+	return tally
+}
+
+// tallyRankedChoice runs instant-runoff rounds, the choice with the lowest score is eliminated in every round
+// until the leader gets the majority or less than three choices are left. Ties are resolved to the lower index.
+func tallyRankedChoice(choices int, votes []TallyVote) Tally {
+	tally := Tally{Scores: make([]float64, choices)}
+
+	var ballots [][]int
+	var power []float64
+	for _, v := range votes {
+		list, ok := parseChoiceList(v.Choice, choices)
+		if !ok || !isRanking(list, choices) {
+			tally.InvalidVotes++
+			continue
+		}
+
+		ballots = append(ballots, list)
+		power = append(power, v.Vp)
+		tally.ScoresTotal += v.Vp
+	}
+
+	if len(ballots) == 0 {
+		return tally
+	}
+
+	eliminated := make([]bool, choices)
+	for left := choices; ; left-- {
+		scores := make([]float64, choices)
+		for i, ballot := range ballots {
+			for _, choice := range ballot {
+				if !eliminated[choice-1] {
+					scores[choice-1] += power[i]
+					break
+				}
+			}
+		}
+
+		tally.Rounds = append(tally.Rounds, TallyRound{Round: len(tally.Rounds) + 1, Scores: scores})
+		tally.Scores = scores
+
+		top, bottom := -1, -1
+		for i, score := range scores {
+			if eliminated[i] {
+				continue
+			}
+			if top == -1 || score > scores[top] {
+				top = i
+			}
+			if bottom == -1 || score < scores[bottom] {
+				bottom = i
+			}
+		}
+
+		if scores[top] > tally.ScoresTotal/2 || left < 3 {
+			return tally
+		}
+
+		eliminated[bottom] = true
+	}
+}
+
+// tallyQuadratic sums square roots of the voting power spread by weights and distributes the total voting power
+// proportionally to squares of these sums
+func tallyQuadratic(choices int, votes []TallyVote) Tally {
+	tally := Tally{Scores: make([]float64, choices)}
+	sums := make([]float64, choices)
+	for _, v := range votes {
+		weights, ok := parseChoiceWeights(v.Choice, choices)
+		if !ok {
+			tally.InvalidVotes++
+			continue
+		}
+
+		for i, weight := range weights {
+			sums[i] += math.Sqrt(weight * v.Vp)
+		}
+		tally.ScoresTotal += v.Vp
+	}
+
+	var total float64
+	for i := range sums {
+		sums[i] *= sums[i]
+		total += sums[i]
+	}
+
+	if total == 0 {
+		return tally
+	}
+
+	for i := range sums {
+		tally.Scores[i] = tally.ScoresTotal * sums[i] / total
+	}
+
+	return tally
+}
+
+// tallyWeighted spreads the voting power by weights
+func tallyWeighted(choices int, votes []TallyVote) Tally {
+	tally := Tally{Scores: make([]float64, choices)}
+	for _, v := range votes {
+		weights, ok := parseChoiceWeights(v.Choice, choices)
+		if !ok {
+			tally.InvalidVotes++
+			continue
+		}
+
+		for i, weight := range weights {
+			tally.Scores[i] += weight * v.Vp
+		}
+		tally.ScoresTotal += v.Vp
+	}
+
+	return tally
+}
+
+func validChoice(choice, choices int) bool {
+	return choice >= 1 && choice <= choices
+}
+
+func parseChoiceList(raw json.RawMessage, choices int) ([]int, bool) {
+	var list []int
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil, false
+	}
+
+	for _, choice := range list {
+		if !validChoice(choice, choices) {
+			return nil, false
+		}
+	}
+
+	return list, true
+}
+
+// isRanking checks that all choices are ranked once
+func isRanking(list []int, choices int) bool {
+	if len(list) != choices {
+		return false
+	}
+
+	seen := make([]bool, choices)
+	for _, choice := range list {
+		if seen[choice-1] {
+			return false
+		}
+		seen[choice-1] = true
+	}
+
+	return true
+}
+
+// parseChoiceWeights returns shares of the choices by 0-based indexes, the sum of shares is 1
+func parseChoiceWeights(raw json.RawMessage, choices int) (map[int]float64, bool) {
+	var weights map[string]float64
+	if err := json.Unmarshal(raw, &weights); err != nil {
+		return nil, false
+	}
+
+	var total float64
+	for key, weight := range weights {
+		choice, err := strconv.Atoi(key)
+		if err != nil || !validChoice(choice, choices) || weight < 0 {
+			return nil, false
+		}
+		total += weight
+	}
+
+	if total == 0 {
+		return nil, false
+	}
+
+	shares := make(map[int]float64, len(weights))
+	for key, weight := range weights {
+		choice, _ := strconv.Atoi(key)
+		shares[choice-1] = weight / total
+	}
+
+	return shares, true
+}
+
+// diffScores returns the choices with scores which differ more than the tolerance
+func diffScores(upstream Scores, calculated []float64) []ScoreDiff {
+	var diff []ScoreDiff
+	for i := 0; i < max(len(upstream), len(calculated)); i++ {
+		var up, calc float64
+		if i < len(upstream) {
+			up = float64(upstream[i])
+		}
+		if i < len(calculated) {
+			calc = calculated[i]
+		}
+
+		if math.Abs(up-calc) > tallyTolerance*math.Max(1, math.Max(math.Abs(up), math.Abs(calc))) {
+			diff = append(diff, ScoreDiff{Choice: i, Upstream: up, Calculated: calc})
+		}
+	}
+
+	return diff
+}
@@ -0,0 +1,139 @@
+package proposal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TallyRepo struct {
+	db *gorm.DB
+}
+
+func NewTallyRepo(db *gorm.DB) *TallyRepo {
+	return &TallyRepo{db: db}
+}
+
+func (r *TallyRepo) GetForTally(types []string, limit int) ([]Proposal, error) {
+	var (
+		dummy = Proposal{}
+		_     = dummy.End
+		_     = dummy.Type
+		_     = dummy.State
+		_     = dummy.ScoresState
+		_     = dummy.ScoresUpdated
+		tally = Tally{}
+		_     = tally.ProposalID
+		_     = tally.ScoresUpdated
+		att   = TallyAttempt{}
+		_     = att.ProposalID
+		_     = att.Attempts
+		_     = att.NextAttemptAt
+	)
+
+	var list []Proposal
+	err := r.db.Raw(`
+select p.*
+from proposals p
+         left join proposal_tallies t on t.proposal_id = p.id
+         left join proposal_tally_attempts a on a.proposal_id = p.id
+where p.end < ?
+  and p.type in ?
+  and p.state <> ?
+  and p.scores_state = ?
+  and (t.proposal_id is null or t.scores_updated < p.scores_updated)
+  and (a.proposal_id is null or a.next_attempt_at <= now())
+order by coalesce(a.attempts, 0), p.end
+limit ?`, time.Now().Add(-tallyGracePeriod).Unix(), types, StateCancelled, scoresStateFinal, limit).
+		Scan(&list).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("find proposals: %w", err)
+	}
+
+	return list, nil
+}
+
+func (r *TallyRepo) GetTallyVotes(proposalID string) ([]TallyVote, error) {
+	var list []TallyVote
+	err := r.db.Table("votes").
+		Select("choice, vp").
+		Where("proposal_id = ?", proposalID).
+		Scan(&list).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("find votes: %w", err)
+	}
+
+	return list, nil
+}
+
+// SaveTally replaces the tally of the proposal and sets the mismatch flag, the flag is read-only for proposal updates
+// and doesn't bump the version
+func (r *TallyRepo) SaveTally(ctx context.Context, t Tally) error {
+	var (
+		dummy = Proposal{}
+		_     = dummy.ScoresMismatch
+	)
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "proposal_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"updated_at", "scores", "scores_total", "votes", "invalid_votes", "rounds", "diff", "scores_updated",
+			}),
+		}).Create(&t).Error
+		if err != nil {
+			return fmt.Errorf("save tally: %w", err)
+		}
+
+		err = tx.Exec(`update proposals set scores_mismatch = ? where id = ?`, t.Mismatch(), t.ProposalID).Error
+		if err != nil {
+			return fmt.Errorf("update scores mismatch: %w", err)
+		}
+
+		if err = tx.Delete(&TallyAttempt{ProposalID: t.ProposalID}).Error; err != nil {
+			return fmt.Errorf("delete tally attempts: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// PostponeTally increments failed attempts of the proposal, the delay is doubled on every attempt up to the max one
+func (r *TallyRepo) PostponeTally(ctx context.Context, proposalID string, reason string) error {
+	var (
+		dummy = TallyAttempt{}
+		_     = dummy.ProposalID
+		_     = dummy.Attempts
+		_     = dummy.NextAttemptAt
+		_     = dummy.LastError
+	)
+
+	err := r.db.WithContext(ctx).Exec(`
+insert into proposal_tally_attempts (proposal_id, attempts, next_attempt_at, last_error)
+values (?, 1, now() + make_interval(secs => ?), ?)
+on conflict (proposal_id) do update
+    set attempts        = proposal_tally_attempts.attempts + 1,
+        next_attempt_at = now() + make_interval(secs => least(? * power(2, proposal_tally_attempts.attempts), ?)),
+        last_error      = excluded.last_error`,
+		proposalID, tallyMinRetryDelay.Seconds(), reason, tallyMinRetryDelay.Seconds(), tallyMaxRetryDelay.Seconds(),
+	).Error
+	if err != nil {
+		return fmt.Errorf("postpone tally #%s: %w", proposalID, err)
+	}
+
+	return nil
+}
+
+func (r *TallyRepo) GetTally(proposalID string) (*Tally, error) {
+	var t Tally
+	if err := r.db.Where(&Tally{ProposalID: proposalID}).First(&t).Error; err != nil {
+		return nil, fmt.Errorf("get tally #%s: %w", proposalID, err)
+	}
+
+	return &t, nil
+}
@@ -0,0 +1,173 @@
+package proposal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func votes(list ...any) []TallyVote {
+	res := make([]TallyVote, 0, len(list)/2)
+	for i := 0; i < len(list); i += 2 {
+		choice, _ := json.Marshal(list[i])
+		res = append(res, TallyVote{Choice: choice, Vp: list[i+1].(float64)})
+	}
+
+	return res
+}
+
+func TestUnitCalculateTally(t *testing.T) {
+	for name, tc := range map[string]struct {
+		proposal Proposal
+		votes    []TallyVote
+		expected Tally
+	}{
+		"basic": {
+			proposal: Proposal{Type: VotingBasic, Choices: Choices{"For", "Against", "Abstain"}, Scores: Scores{15, 5, 0}},
+			votes:    votes(1, 10.0, 2, 5.0, 1, 5.0, 4, 100.0, "1", 1.0),
+			expected: Tally{Scores: []float64{15, 5, 0}, ScoresTotal: 20, Votes: 5, InvalidVotes: 2},
+		},
+		"single choice with mismatch": {
+			proposal: Proposal{Type: VotingSingleChoice, Choices: Choices{"a", "b"}, Scores: Scores{10, 10}},
+			votes:    votes(1, 10.0, 2, 5.0),
+			expected: Tally{
+				Scores:      []float64{10, 5},
+				ScoresTotal: 15,
+				Votes:       2,
+				Diff:        []ScoreDiff{{Choice: 1, Upstream: 10, Calculated: 5}},
+			},
+		},
+		"approval": {
+			proposal: Proposal{Type: VotingApproval, Choices: Choices{"a", "b", "c"}, Scores: Scores{10, 15, 0}},
+			votes:    votes([]int{1, 2}, 10.0, []int{2}, 5.0, []int{}, 1.0, []int{3, 5}, 1.0),
+			expected: Tally{Scores: []float64{10, 15, 0}, ScoresTotal: 16, Votes: 4, InvalidVotes: 1},
+		},
+		"weighted": {
+			proposal: Proposal{Type: VotingWeighted, Choices: Choices{"a", "b"}, Scores: Scores{7.5, 2.5}},
+			votes:    votes(map[string]int{"1": 1, "2": 1}, 5.0, map[string]int{"1": 1}, 5.0, map[string]int{"1": 0}, 1.0),
+			expected: Tally{Scores: []float64{7.5, 2.5}, ScoresTotal: 10, Votes: 3, InvalidVotes: 1},
+		},
+		"quadratic": {
+			proposal: Proposal{Type: VotingQuadratic, Choices: Choices{"a", "b"}, Scores: Scores{16, 4}},
+			votes:    votes(map[string]int{"1": 1}, 16.0, map[string]int{"2": 1}, 4.0),
+			expected: Tally{Scores: []float64{16, 4}, ScoresTotal: 20, Votes: 2},
+		},
+		"ranked choice majority in the first round": {
+			proposal: Proposal{Type: VotingRankedChoice, Choices: Choices{"a", "b", "c"}, Scores: Scores{6, 3, 1}},
+			votes:    votes([]int{1, 2, 3}, 6.0, []int{2, 1, 3}, 3.0, []int{3, 2, 1}, 1.0, []int{1, 2}, 5.0),
+			expected: Tally{
+				Scores:       []float64{6, 3, 1},
+				ScoresTotal:  10,
+				Votes:        4,
+				InvalidVotes: 1,
+				Rounds:       []TallyRound{{Round: 1, Scores: []float64{6, 3, 1}}},
+			},
+		},
+		"ranked choice runoff": {
+			proposal: Proposal{Type: VotingRankedChoice, Choices: Choices{"a", "b", "c", "d"}, Scores: Scores{7, 0, 3, 0}},
+			votes: votes(
+				[]int{1, 2, 3, 4}, 4.0,
+				[]int{2, 1, 3, 4}, 3.0,
+				[]int{3, 2, 1, 4}, 2.0,
+				[]int{4, 3, 2, 1}, 1.0,
+			),
+			expected: Tally{
+				Scores:      []float64{7, 0, 3, 0},
+				ScoresTotal: 10,
+				Votes:       4,
+				Rounds: []TallyRound{
+					{Round: 1, Scores: []float64{4, 3, 2, 1}},
+					{Round: 2, Scores: []float64{4, 3, 3, 0}},
+					// the tie of b and c is resolved to the lower index
+					{Round: 3, Scores: []float64{7, 0, 3, 0}},
+				},
+			},
+		},
+		"float32 upstream scores": {
+			proposal: Proposal{Type: VotingSingleChoice, Choices: Choices{"a", "b"}, Scores: Scores{float32(123456.789), 0}},
+			votes:    votes(1, 123456.789),
+			expected: Tally{Scores: []float64{123456.789, 0}, ScoresTotal: 123456.789, Votes: 1},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tc.proposal.ID = "id"
+			tc.expected.ProposalID = "id"
+
+			tally, err := calculateTally(tc.proposal, tc.votes)
+			require.NoError(t, err)
+			require.InDeltaSlice(t, tc.expected.Scores, tally.Scores, 1e-9)
+
+			tally.Scores = tc.expected.Scores
+			require.Equal(t, tc.expected, tally)
+		})
+	}
+
+	_, err := calculateTally(Proposal{Type: "custom"}, nil)
+	require.ErrorIs(t, err, ErrUnsupportedVotingType)
+}
+
+type tallyStub struct {
+	proposals []Proposal
+	votes     map[string][]TallyVote
+	failed    map[string]bool
+	saved     []Tally
+	postponed []string
+}
+
+func (s *tallyStub) GetForTally(_ []string, _ int) ([]Proposal, error) {
+	return s.proposals, nil
+}
+
+func (s *tallyStub) GetTallyVotes(proposalID string) ([]TallyVote, error) {
+	if s.failed[proposalID] {
+		return nil, errors.New("unexpected error")
+	}
+
+	return s.votes[proposalID], nil
+}
+
+func (s *tallyStub) SaveTally(_ context.Context, t Tally) error {
+	s.saved = append(s.saved, t)
+
+	return nil
+}
+
+func (s *tallyStub) PostponeTally(_ context.Context, proposalID string, _ string) error {
+	s.postponed = append(s.postponed, proposalID)
+
+	return nil
+}
+
+func (s *tallyStub) GetTally(_ string) (*Tally, error) {
+	return nil, nil
+}
+
+func TestUnitRecalculateTally(t *testing.T) {
+	repo := &tallyStub{
+		proposals: []Proposal{
+			{ID: "matched", Type: VotingBasic, Choices: Choices{"For", "Against"}, Scores: Scores{1, 0}, ScoresUpdated: 100},
+			{ID: "failed", Type: VotingBasic, Choices: Choices{"For", "Against"}, Scores: Scores{1, 0}},
+			{ID: "mismatched", Type: VotingBasic, Choices: Choices{"For", "Against"}, Scores: Scores{1, 0}, ScoresUpdated: 200},
+		},
+		votes: map[string][]TallyVote{
+			"matched":    votes(1, 1.0),
+			"mismatched": votes(2, 1.0),
+		},
+		failed: map[string]bool{"failed": true},
+	}
+
+	cnt, err := NewTallyService(repo).Recalculate(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, cnt)
+	require.Len(t, repo.saved, 2)
+	require.Equal(t, []string{"failed"}, repo.postponed)
+
+	require.False(t, repo.saved[0].Mismatch())
+	require.Equal(t, 100, repo.saved[0].ScoresUpdated)
+
+	require.True(t, repo.saved[1].Mismatch())
+	require.Equal(t, []ScoreDiff{{Choice: 0, Upstream: 1, Calculated: 0}, {Choice: 1, Upstream: 0, Calculated: 1}}, repo.saved[1].Diff)
+}
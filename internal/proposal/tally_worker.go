@@ -0,0 +1,40 @@
+package proposal
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	tallyCheckDelay = time.Minute
+)
+
+// TallyWorker recalculates scores of ended proposals from stored votes
+type TallyWorker struct {
+	tally *TallyService
+}
+
+func NewTallyWorker(ts *TallyService) *TallyWorker {
+	return &TallyWorker{
+		tally: ts,
+	}
+}
+
+func (w *TallyWorker) Start(ctx context.Context) error {
+	for {
+		cnt, err := w.tally.Recalculate(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("recalculate proposal tallies")
+		} else if cnt > 0 {
+			log.Info().Int("tallies", cnt).Msg("proposal tallies recalculated")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(tallyCheckDelay):
+		}
+	}
+}
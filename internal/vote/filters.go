@@ -3,6 +3,7 @@ package vote
 import (
 	"gorm.io/gorm"
 
+	"github.com/goverland-labs/goverland-core-storage/internal/pagination"
 	"github.com/goverland-labs/goverland-core-storage/internal/proposal"
 )
 
@@ -17,6 +18,20 @@ var (
 	}
 )
 
+var (
+	// createdKeyset repeats OrderByCreated with the id as the tie-breaker for cursor pagination
+	createdKeyset = pagination.NewKeyset(
+		pagination.Key{Column: "votes.created", Desc: true},
+		pagination.Key{Column: "votes.id"},
+	)
+	// vpKeyset repeats OrderByVp and OrderByCreated with the id as the tie-breaker for cursor pagination
+	vpKeyset = pagination.NewKeyset(
+		pagination.Key{Column: "votes.vp", Desc: true},
+		pagination.Key{Column: "votes.created", Desc: true},
+		pagination.Key{Column: "votes.id"},
+	)
+)
+
 type Filter interface {
 	Apply(*gorm.DB) *gorm.DB
 }
@@ -9,6 +9,8 @@ import (
 	events "github.com/goverland-labs/goverland-platform-events/events/core"
 )
 
+const secondsInDay = 24 * 60 * 60
+
 type Vote struct {
 	ID            string `gorm:"primary_key"`
 	CreatedAt     time.Time
@@ -50,6 +52,45 @@ func convertToInternal(pl aggevents.VotesPayload) []Vote {
 	return res
 }
 
+// recastDays returns creation times of stored votes by daos if recast votes are created on other days
+func recastDays(stored, votes []Vote) map[uuid.UUID][]int {
+	type key struct{ proposalID, voter string }
+
+	created := make(map[key]int, len(votes))
+	for _, v := range votes {
+		created[key{v.ProposalID, v.Voter}] = v.Created
+	}
+
+	res := make(map[uuid.UUID][]int)
+	for _, v := range stored {
+		c, ok := created[key{v.ProposalID, v.Voter}]
+		if !ok || c/secondsInDay == v.Created/secondsInDay {
+			continue
+		}
+
+		res[v.DaoID] = append(res[v.DaoID], v.Created)
+	}
+
+	return res
+}
+
+// groupByProposal splits votes by proposals keeping the order of votes
+func groupByProposal(votes []Vote) [][]Vote {
+	idx := make(map[string]int)
+	var groups [][]Vote
+	for _, v := range votes {
+		i, ok := idx[v.ProposalID]
+		if !ok {
+			i = len(groups)
+			idx[v.ProposalID] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], v)
+	}
+
+	return groups
+}
+
 func convertToCoreEvent(votes []Vote) events.VotesPayload {
 	res := make([]events.VotePayload, len(votes))
 	for i, item := range votes {
@@ -130,3 +130,20 @@ func TestUnitConvertToCoreEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitRecastDays(t *testing.T) {
+	const day = 24 * 60 * 60
+
+	stored := []Vote{
+		{DaoID: id1, ProposalID: "proposal-1", Voter: "voter-1", Created: day + 10},
+		{DaoID: id1, ProposalID: "proposal-1", Voter: "voter-2", Created: day + 20},
+		{DaoID: id2, ProposalID: "proposal-2", Voter: "voter-1", Created: day + 30},
+	}
+	votes := []Vote{
+		{DaoID: id1, ProposalID: "proposal-1", Voter: "voter-1", Created: 2*day + 10},
+		{DaoID: id1, ProposalID: "proposal-1", Voter: "voter-2", Created: day + 100},
+		{DaoID: id2, ProposalID: "proposal-2", Voter: "voter-3", Created: 3 * day},
+	}
+
+	assert.Equal(t, map[uuid.UUID][]int{id1: {day + 10}}, recastDays(stored, votes))
+}
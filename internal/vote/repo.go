@@ -1,11 +1,14 @@
 package vote
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/goverland-labs/goverland-core-storage/internal/outbox"
+	"github.com/goverland-labs/goverland-core-storage/internal/pagination"
 	"github.com/goverland-labs/goverland-core-storage/internal/proposal"
 
 	"gorm.io/gorm"
@@ -24,9 +27,14 @@ func NewRepo(db *gorm.DB) *Repo {
 	return &Repo{db: db}
 }
 
+// CallInTx runs cb in the transaction, BatchCreate calls with the passed context join it
+func (r *Repo) CallInTx(ctx context.Context, cb func(ctx context.Context) error) error {
+	return outbox.CallInTx(ctx, r.db, cb)
+}
+
 // BatchCreate creates votes in batch
-func (r *Repo) BatchCreate(data []Vote) error {
-	return r.db.Model(&Vote{}).Clauses(clause.OnConflict{
+func (r *Repo) BatchCreate(ctx context.Context, data []Vote) error {
+	return outbox.Conn(ctx, r.db).Model(&Vote{}).Clauses(clause.OnConflict{
 		Columns: []clause.Column{
 			{Name: "proposal_id"},
 			{Name: "voter"},
@@ -35,25 +43,58 @@ func (r *Repo) BatchCreate(data []Vote) error {
 	}).CreateInBatches(data, defaultBatchSize).Error
 }
 
+// GetStored returns stored votes of voters of passed votes in the same proposals
+func (r *Repo) GetStored(ctx context.Context, votes []Vote) ([]Vote, error) {
+	if len(votes) == 0 {
+		return nil, nil
+	}
+
+	keys := make([][]any, 0, len(votes))
+	for _, v := range votes {
+		keys = append(keys, []any{v.ProposalID, v.Voter})
+	}
+
+	var list []Vote
+	err := outbox.Conn(ctx, r.db).
+		Select("proposal_id, voter, dao_id, created").
+		Where("(proposal_id, voter) in ?", keys).
+		Find(&list).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("get stored votes: %w", err)
+	}
+
+	return list, nil
+}
+
 type List struct {
 	Votes      []Vote
 	TotalCount int64
 	TotalVp    float32
 }
 
-func (r *Repo) GetByFilters(filters []Filter, limit int, offset int, firstVoter string) (List, error) {
+// GetByFilters returns votes with totals if count is true.
+// The vote of the first voter is placed at the beginning of the first page and excluded from other pages.
+func (r *Repo) GetByFilters(filters []Filter, limit int, offset int, firstVoter string, count bool) (List, error) {
+	firstPage := offset == 0
 	db := r.db.Model(&Vote{}).InnerJoins("inner join daos on daos.id = votes.dao_id")
 	for _, f := range filters {
-		if _, ok := f.(proposal.OrderFilter); ok {
+		switch v := f.(type) {
+		case proposal.OrderFilter:
+			continue
+		case pagination.Keyset:
+			firstPage = firstPage && !v.Continued()
 			continue
 		}
 		db = f.Apply(db)
 	}
-	var totals Totals
-	err := db.Select([]string{"count(*) as Votes", "sum(vp) as Vp"}).Scan(&totals).Error
 
-	if err != nil {
-		return List{}, err
+	var totals Totals
+	if count {
+		err := db.Select([]string{"count(*) as Votes", "sum(vp) as Vp"}).Scan(&totals).Error
+		if err != nil {
+			return List{}, err
+		}
 	}
 
 	var list []Vote
@@ -64,13 +105,17 @@ func (r *Repo) GetByFilters(filters []Filter, limit int, offset int, firstVoter
 			db = f.Apply(db)
 		}
 
-		err = db.Find(&list).Error
+		err := db.Find(&list).Error
 		if err != nil {
 			return List{}, err
 		}
 	} else {
 		db = r.db.Model(&Vote{}).InnerJoins("inner join daos on daos.id = votes.dao_id")
 		for _, f := range filters {
+			// the vote is searched regardless of the cursor to exclude it from all pages
+			if _, ok := f.(pagination.Keyset); ok {
+				continue
+			}
 			db = f.Apply(db)
 		}
 		db = VoterFilter{Voter: strings.ToLower(firstVoter)}.Apply(db)
@@ -84,10 +129,11 @@ func (r *Repo) GetByFilters(filters []Filter, limit int, offset int, firstVoter
 		prepend := false
 		if request.RowsAffected > 0 {
 			filters = append(filters, ExcludeVoterFilter{Voter: strings.ToLower(firstVoter)})
-			if offset == 0 {
+			switch {
+			case firstPage:
 				limit = limit - 1
 				prepend = true
-			} else {
+			case offset > 0:
 				offset = offset - 1
 			}
 		}
@@ -97,7 +143,7 @@ func (r *Repo) GetByFilters(filters []Filter, limit int, offset int, firstVoter
 			db = f.Apply(db)
 		}
 
-		err = db.Find(&list).Error
+		err := db.Find(&list).Error
 		if err != nil {
 			return List{}, err
 		}
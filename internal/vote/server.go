@@ -15,7 +15,7 @@ import (
 
 	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
 
-	"github.com/goverland-labs/goverland-core-storage/internal/proposal"
+	"github.com/goverland-labs/goverland-core-storage/internal/pagination"
 )
 
 const (
@@ -43,18 +43,21 @@ func (s *Server) GetVotes(_ context.Context, req *storagepb.VotesFilterRequest)
 	if req.GetOffset() > 0 {
 		offset = int(req.GetOffset())
 	}
-	var filters []Filter
-
+	keyset := createdKeyset
 	if req.GetOrderByVoter() != "" {
-		filters = append(filters, proposal.OrderFilter{
-			Orders: []proposal.Order{OrderByVp, OrderByCreated},
-		})
-	} else {
-		filters = append(filters, proposal.OrderFilter{
-			Orders: []proposal.Order{OrderByCreated},
-		})
+		keyset = vpKeyset
 	}
 
+	if req.GetCursor() != "" {
+		var err error
+		if keyset, err = keyset.Decode(req.GetCursor()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		offset = 0
+	}
+
+	filters := []Filter{keyset}
+
 	if req.GetProposalIds() != nil {
 		filters = append(filters, ProposalIDsFilter{ProposalIDs: req.GetProposalIds()})
 	}
@@ -71,16 +74,26 @@ func (s *Server) GetVotes(_ context.Context, req *storagepb.VotesFilterRequest)
 		filters = append(filters, DaoIDFilter{DaoID: req.GetDaoId()})
 	}
 
-	list, err := s.sp.GetByFilters(filters, limit, offset, req.GetOrderByVoter())
+	list, err := s.sp.GetByFilters(filters, limit+1, offset, req.GetOrderByVoter(), !req.GetSkipTotalCount())
 	if err != nil {
 		log.Error().Err(err).Msgf("get votes by filter: %+v", req)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
+	var nextCursor string
+	list.Votes, nextCursor, err = pagination.Page(list.Votes, limit, func(page []Vote) (string, error) {
+		return nextVotesCursor(keyset, page, req.GetOrderByVoter())
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("encode votes cursor: %+v", req)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
 	res := &storagepb.VotesFilterResponse{
 		Votes:      make([]*storagepb.VoteInfo, len(list.Votes)),
 		TotalCount: uint64(list.TotalCount),
 		TotalVp:    list.TotalVp,
+		NextCursor: nextCursor,
 	}
 
 	for i, info := range list.Votes {
@@ -90,6 +103,25 @@ func (s *Server) GetVotes(_ context.Context, req *storagepb.VotesFilterRequest)
 	return res, nil
 }
 
+// nextVotesCursor returns the cursor after the last vote. The vote of the first voter is placed out of the order,
+// so it's skipped and the cursor without values is used if the page contains this vote only.
+func nextVotesCursor(keyset pagination.Keyset, votes []Vote, firstVoter string) (string, error) {
+	for i := len(votes) - 1; i >= 0; i-- {
+		v := votes[i]
+		if firstVoter != "" && strings.EqualFold(v.Voter, firstVoter) {
+			continue
+		}
+
+		if firstVoter != "" {
+			return keyset.Encode(v.Vp, v.Created, v.ID)
+		}
+
+		return keyset.Encode(v.Created, v.ID)
+	}
+
+	return keyset.Encode()
+}
+
 func (s *Server) Validate(ctx context.Context, req *storagepb.ValidateRequest) (*storagepb.ValidateResponse, error) {
 	validateResp, err := s.sp.Validate(ctx, ValidateRequest{
 		Proposal: req.GetProposal(),
@@ -13,6 +13,7 @@ import (
 	coreevents "github.com/goverland-labs/goverland-platform-events/events/core"
 	"github.com/rs/zerolog/log"
 
+	"github.com/goverland-labs/goverland-core-storage/internal/outbox"
 	"github.com/goverland-labs/goverland-core-storage/internal/pubsub"
 )
 
@@ -26,12 +27,14 @@ type Publisher interface {
 }
 
 type DataProvider interface {
-	BatchCreate(data []Vote) error
-	GetByFilters(filters []Filter, limit int, offset int, firstVoter string) (List, error)
+	CallInTx(ctx context.Context, cb func(ctx context.Context) error) error
+	BatchCreate(ctx context.Context, data []Vote) error
+	GetByFilters(filters []Filter, limit int, offset int, firstVoter string, count bool) (List, error)
 	GetLastItems(lastUpdatedAt time.Time, limit int) ([]Vote, error)
 	UpdateVotes(list []ResolvedAddress) error
 	GetUnique(string, int64) ([]string, error)
 	GetByVoter(string) ([]string, error)
+	GetStored(ctx context.Context, votes []Vote) ([]Vote, error)
 }
 
 type DaoProvider interface {
@@ -42,6 +45,11 @@ type EnsResolver interface {
 	AddRequests(list []string)
 }
 
+type ActivityRefresher interface {
+	// Refresh recalculates the dao activity on days of passed unix timestamps
+	Refresh(ctx context.Context, id uuid.UUID, created ...int) error
+}
+
 type Service struct {
 	notifier *pubsub.PubSub[string]
 
@@ -49,6 +57,7 @@ type Service struct {
 	dao         DaoProvider
 	events      Publisher
 	ensResolver EnsResolver
+	activity    ActivityRefresher
 	dsClient    votingpb.VotingClient
 }
 
@@ -58,6 +67,7 @@ func NewService(
 	dp DaoProvider,
 	p Publisher,
 	er EnsResolver,
+	ar ActivityRefresher,
 	dsClient votingpb.VotingClient,
 ) (*Service, error) {
 	return &Service{
@@ -66,6 +76,7 @@ func NewService(
 		dao:         dp,
 		events:      p,
 		ensResolver: er,
+		activity:    ar,
 		dsClient:    dsClient,
 	}, nil
 }
@@ -94,23 +105,47 @@ func (s *Service) HandleVotes(ctx context.Context, votes []Vote) error {
 		votes[i].DaoID = daoID
 	}
 
-	if err := s.repo.BatchCreate(votes); err != nil {
-		return fmt.Errorf("can't create votes: %w", err)
-	}
+	var recast map[uuid.UUID][]int
+	err := s.repo.CallInTx(ctx, func(ctx context.Context) error {
+		stored, err := s.repo.GetStored(ctx, votes)
+		if err != nil {
+			return err
+		}
+		recast = recastDays(stored, votes)
 
-	s.notifier.PublishNoWait("")
+		if err := s.repo.BatchCreate(ctx, votes); err != nil {
+			return fmt.Errorf("can't create votes: %w", err)
+		}
 
-	if err := s.events.PublishJSON(ctx, coreevents.SubjectVoteCreated, convertToCoreEvent(votes)); err != nil {
-		log.Error().Err(err).Msgf("publish votes event")
+		for _, group := range groupByProposal(votes) {
+			ctx := outbox.WithKey(ctx, group[0].ProposalID)
+			if err := s.events.PublishJSON(ctx, coreevents.SubjectVoteCreated, convertToCoreEvent(group)); err != nil {
+				return fmt.Errorf("publish votes event: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
+	// the activity of new days is refreshed by the vote event, previous days of recast votes are refreshed here
+	for daoID, created := range recast {
+		if err := s.activity.Refresh(ctx, daoID, created...); err != nil {
+			log.Error().Err(err).Str("dao_id", daoID.String()).Msg("refresh activity of recast votes")
+		}
+	}
+
+	s.notifier.PublishNoWait("")
+
 	s.ensResolver.AddRequests(authors)
 
 	return nil
 }
 
-func (s *Service) GetByFilters(filters []Filter, limit int, offset int, firstVoter string) (List, error) {
-	list, err := s.repo.GetByFilters(filters, limit, offset, firstVoter)
+func (s *Service) GetByFilters(filters []Filter, limit int, offset int, firstVoter string, count bool) (List, error) {
+	list, err := s.repo.GetByFilters(filters, limit, offset, firstVoter, count)
 	if err != nil {
 		return List{}, fmt.Errorf("get by filters: %w", err)
 	}
@@ -138,7 +173,7 @@ func (s *Service) Validate(ctx context.Context, req ValidateRequest) (ValidateRe
 	voted, err := s.repo.GetByFilters([]Filter{
 		VoterFilter{Voter: strings.ToLower(req.Voter)},
 		ProposalIDsFilter{ProposalIDs: []string{req.Proposal}},
-	}, 1, 0, "")
+	}, 1, 0, "", false)
 	if err != nil {
 		return ValidateResponse{}, fmt.Errorf("get by filters: %w", err)
 	}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"os"
+
 	"github.com/caarlos0/env/v6"
 	_ "github.com/golang/mock/mockgen/model"
 	"github.com/rs/zerolog"
@@ -34,6 +36,22 @@ func init() {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := internal.Migrate(cfg, os.Args[2:]); err != nil {
+			panic(err)
+		}
+
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "backfill-activity" {
+		if err := internal.BackfillActivity(cfg, os.Args[2:]); err != nil {
+			panic(err)
+		}
+
+		return
+	}
+
 	app, err := internal.NewApplication(cfg)
 	if err != nil {
 		panic(err)
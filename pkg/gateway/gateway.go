@@ -0,0 +1,208 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	routePrefix = "/v1"
+	openAPIPath = routePrefix + "/openapi.json"
+
+	maxBodySize = 1 << 20
+)
+
+// forwardedHeaders are passed to the gRPC server as metadata, so the gateway calls are authorized the same way
+var forwardedHeaders = []string{"authorization", "x-api-key"}
+
+// Route describes the HTTP endpoint for the unary gRPC method
+type Route struct {
+	HTTPMethod string
+	Path       string
+	FullMethod string
+	Method     protoreflect.MethodDescriptor
+}
+
+// Gateway proxies HTTP/JSON requests to the unary methods of gRPC services.
+// Methods with the Get prefix are available by GET with query parameters, others by POST with the JSON body.
+type Gateway struct {
+	conn    grpc.ClientConnInterface
+	routes  []Route
+	openAPI []byte
+
+	unmarshal protojson.UnmarshalOptions
+	marshal   protojson.MarshalOptions
+}
+
+// New builds routes for the services registered in the global proto registry, e.g. storagepb.Dao
+func New(conn grpc.ClientConnInterface, services []string) (*Gateway, error) {
+	g := &Gateway{
+		conn:      conn,
+		unmarshal: protojson.UnmarshalOptions{DiscardUnknown: true},
+		marshal:   protojson.MarshalOptions{EmitUnpopulated: true},
+	}
+
+	for _, name := range services {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return nil, fmt.Errorf("find service %s: %w", name, err)
+		}
+
+		sd, ok := desc.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a service", name)
+		}
+
+		g.routes = append(g.routes, serviceRoutes(sd)...)
+	}
+
+	doc, err := buildOpenAPI(g.routes)
+	if err != nil {
+		return nil, fmt.Errorf("build openapi: %w", err)
+	}
+	g.openAPI = doc
+
+	return g, nil
+}
+
+func (g *Gateway) Routes() []Route {
+	return g.routes
+}
+
+// Register adds the routes and the OpenAPI document to the router
+func (g *Gateway) Register(router *mux.Router) {
+	router.HandleFunc(openAPIPath, g.serveOpenAPI).Methods(http.MethodGet)
+
+	for _, route := range g.routes {
+		router.Handle(route.Path, g.handler(route)).Methods(route.HTTPMethod)
+	}
+}
+
+func (g *Gateway) serveOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(g.openAPI)
+}
+
+func (g *Gateway) handler(route Route) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := newMessage(route.Method.Input())
+		if err := g.decodeRequest(r, route, req); err != nil {
+			writeError(w, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		resp := newMessage(route.Method.Output())
+		if err := g.conn.Invoke(outgoingContext(r), route.FullMethod, req, resp); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		body, err := g.marshal.Marshal(resp)
+		if err != nil {
+			writeError(w, status.Error(codes.Internal, err.Error()))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(body)
+	})
+}
+
+func (g *Gateway) decodeRequest(r *http.Request, route Route, req proto.Message) error {
+	if route.HTTPMethod == http.MethodGet {
+		return populateFromQuery(req.ProtoReflect(), r.URL.Query())
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		return fmt.Errorf("read body: %w", err)
+	}
+
+	if len(body) == 0 {
+		return nil
+	}
+
+	if err = g.unmarshal.Unmarshal(body, req); err != nil {
+		return fmt.Errorf("decode body: %w", err)
+	}
+
+	return nil
+}
+
+func outgoingContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	for _, header := range forwardedHeaders {
+		if values := r.Header.Values(header); len(values) > 0 {
+			md.Set(header, values...)
+		}
+	}
+
+	return metadata.NewOutgoingContext(r.Context(), md)
+}
+
+func serviceRoutes(sd protoreflect.ServiceDescriptor) []Route {
+	methods := sd.Methods()
+	routes := make([]Route, 0, methods.Len())
+	for i := 0; i < methods.Len(); i++ {
+		md := methods.Get(i)
+		// streams can't be mapped to the plain request/response
+		if md.IsStreamingClient() || md.IsStreamingServer() {
+			continue
+		}
+
+		httpMethod := http.MethodPost
+		if strings.HasPrefix(string(md.Name()), "Get") {
+			httpMethod = http.MethodGet
+		}
+
+		routes = append(routes, Route{
+			HTTPMethod: httpMethod,
+			Path:       fmt.Sprintf("%s/%s/%s", routePrefix, kebabCase(string(sd.Name())), kebabCase(string(md.Name()))),
+			FullMethod: fmt.Sprintf("/%s/%s", sd.FullName(), md.Name()),
+			Method:     md,
+		})
+	}
+
+	return routes
+}
+
+func newMessage(md protoreflect.MessageDescriptor) proto.Message {
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName()); err == nil {
+		return mt.New().Interface()
+	}
+
+	return dynamicpb.NewMessage(md)
+}
+
+// kebabCase converts GetByID to get-by-id
+func kebabCase(name string) string {
+	var sb strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prevLower := unicode.IsLower(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || (nextLower && unicode.IsUpper(runes[i-1])) {
+				sb.WriteRune('-')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+
+	return sb.String()
+}
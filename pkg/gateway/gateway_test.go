@@ -0,0 +1,184 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/goverland-labs/goverland-core-storage/protocol/storagepb"
+)
+
+type fakeConn struct {
+	method string
+	req    proto.Message
+	md     metadata.MD
+	resp   proto.Message
+	err    error
+}
+
+func (c *fakeConn) Invoke(ctx context.Context, method string, args any, reply any, _ ...grpc.CallOption) error {
+	c.method = method
+	c.req = args.(proto.Message)
+	c.md, _ = metadata.FromOutgoingContext(ctx)
+	if c.err != nil {
+		return c.err
+	}
+
+	proto.Merge(reply.(proto.Message), c.resp)
+
+	return nil
+}
+
+func (c *fakeConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Error(codes.Unimplemented, "streams are not supported")
+}
+
+func newTestRouter(t *testing.T, conn *fakeConn) *mux.Router {
+	g, err := New(conn, []string{"storagepb.Dao", "storagepb.Vote"})
+	require.NoError(t, err)
+
+	router := mux.NewRouter()
+	g.Register(router)
+
+	return router
+}
+
+func TestUnitRoutes(t *testing.T) {
+	g, err := New(&fakeConn{}, []string{"storagepb.Dao", "storagepb.Vote"})
+	require.NoError(t, err)
+
+	routes := make(map[string]string)
+	for _, r := range g.Routes() {
+		routes[r.Path] = r.HTTPMethod
+	}
+
+	require.Equal(t, http.MethodGet, routes["/v1/dao/get-by-id"])
+	require.Equal(t, http.MethodGet, routes["/v1/dao/get-by-filter"])
+	require.Equal(t, http.MethodPost, routes["/v1/dao/populate-token-prices"])
+	require.Equal(t, http.MethodPost, routes["/v1/vote/vote"])
+	require.NotContains(t, routes, "/v1/vote/votes-subscribe")
+
+	_, err = New(&fakeConn{}, []string{"storagepb.Unknown"})
+	require.Error(t, err)
+}
+
+func TestUnitKebabCase(t *testing.T) {
+	for in, expected := range map[string]string{
+		"GetByID":                "get-by-id",
+		"GetByFilter":            "get-by-filter",
+		"GetDelegatesV2":         "get-delegates-v2",
+		"Dao":                    "dao",
+		"GetRecommendationsList": "get-recommendations-list",
+	} {
+		require.Equal(t, expected, kebabCase(in))
+	}
+}
+
+func TestUnitHandleQuery(t *testing.T) {
+	conn := &fakeConn{resp: &storagepb.DaoByFilterResponse{TotalCount: 2}}
+	router := newTestRouter(t, conn)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/dao/get-by-filter?query=aave&limit=10&dao_ids=id-1&daoIds=id-2", nil)
+	req.Header.Set("X-Api-Key", "key")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "/storagepb.Dao/GetByFilter", conn.method)
+	require.Equal(t, []string{"key"}, conn.md.Get("x-api-key"))
+
+	in := conn.req.(*storagepb.DaoByFilterRequest)
+	require.Equal(t, "aave", in.GetQuery())
+	require.Equal(t, uint64(10), in.GetLimit())
+	require.ElementsMatch(t, []string{"id-1", "id-2"}, in.GetDaoIds())
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, "2", body["totalCount"])
+}
+
+func TestUnitHandleErrors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		method string
+		target string
+		body   string
+		err    error
+		code   int
+	}{
+		"unknown query parameter": {
+			method: http.MethodGet,
+			target: "/v1/dao/get-by-filter?unknown=1",
+			code:   http.StatusBadRequest,
+		},
+		"invalid query value": {
+			method: http.MethodGet,
+			target: "/v1/dao/get-by-filter?limit=abc",
+			code:   http.StatusBadRequest,
+		},
+		"invalid body": {
+			method: http.MethodPost,
+			target: "/v1/vote/vote",
+			body:   "{",
+			code:   http.StatusBadRequest,
+		},
+		"not found": {
+			method: http.MethodGet,
+			target: "/v1/dao/get-by-id?dao_id=id",
+			err:    status.Error(codes.NotFound, "dao not found"),
+			code:   http.StatusNotFound,
+		},
+		"permission denied": {
+			method: http.MethodPost,
+			target: "/v1/vote/vote",
+			body:   `{"id":"id","sig":"0x"}`,
+			err:    status.Error(codes.PermissionDenied, "denied"),
+			code:   http.StatusForbidden,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			conn := &fakeConn{err: tc.err, resp: &storagepb.VoteResponse{}}
+			router := newTestRouter(t, conn)
+
+			req := httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body))
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.code, rec.Code)
+
+			var body errorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.NotEmpty(t, body.Message)
+		})
+	}
+}
+
+func TestUnitOpenAPI(t *testing.T) {
+	router := newTestRouter(t, &fakeConn{})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/openapi.json", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var doc openAPIDocument
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+	require.Contains(t, doc.Paths, "/v1/dao/get-by-filter")
+	require.Contains(t, doc.Paths["/v1/vote/vote"], "post")
+	require.Contains(t, doc.Components["schemas"], "storagepb.DaoInfo")
+
+	params := make([]string, 0)
+	for _, p := range doc.Paths["/v1/dao/get-by-filter"]["get"].Parameters {
+		params = append(params, p.Name)
+	}
+	require.Contains(t, params, "daoIds")
+}
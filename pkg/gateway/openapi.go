@@ -0,0 +1,228 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	openAPIVersion = "3.0.3"
+	schemaRef      = "#/components/schemas/"
+	errorSchema    = "Error"
+)
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Enum                 []string                  `json:"enum,omitempty"`
+	Items                *openAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+	AdditionalProperties *openAPISchema            `json:"additionalProperties,omitempty"`
+}
+
+type openAPIParameter struct {
+	Name     string         `json:"name"`
+	In       string         `json:"in"`
+	Required bool           `json:"required"`
+	Schema   *openAPISchema `json:"schema"`
+}
+
+type openAPIMedia struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPIBody struct {
+	Content map[string]openAPIMedia `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                  `json:"description"`
+	Content     map[string]openAPIMedia `json:"content"`
+}
+
+type openAPIOperation struct {
+	OperationID string                     `json:"operationId"`
+	Tags        []string                   `json:"tags"`
+	Parameters  []openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *openAPIBody               `json:"requestBody,omitempty"`
+	Responses   map[string]openAPIResponse `json:"responses"`
+}
+
+type openAPIDocument struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       map[string]string                       `json:"info"`
+	Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+	Components map[string]map[string]*openAPISchema    `json:"components"`
+}
+
+type schemaBuilder struct {
+	schemas map[string]*openAPISchema
+}
+
+// buildOpenAPI describes the routes in the OpenAPI 3 format, schemas follow the protojson mapping
+func buildOpenAPI(routes []Route) ([]byte, error) {
+	sb := &schemaBuilder{schemas: map[string]*openAPISchema{
+		errorSchema: {
+			Type: "object",
+			Properties: map[string]*openAPISchema{
+				"code":    {Type: "string"},
+				"message": {Type: "string"},
+			},
+		},
+	}}
+
+	doc := openAPIDocument{
+		OpenAPI: openAPIVersion,
+		Info: map[string]string{
+			"title":   "Goverland core storage",
+			"version": strings.TrimPrefix(routePrefix, "/"),
+		},
+		Paths:      make(map[string]map[string]*openAPIOperation, len(routes)),
+		Components: map[string]map[string]*openAPISchema{"schemas": sb.schemas},
+	}
+
+	for _, route := range routes {
+		op := &openAPIOperation{
+			OperationID: strings.ReplaceAll(strings.TrimPrefix(route.FullMethod, "/"), "/", "_"),
+			Tags:        []string{string(route.Method.Parent().Name())},
+			Responses: map[string]openAPIResponse{
+				"200": {
+					Description: "OK",
+					Content:     jsonContent(sb.message(route.Method.Output())),
+				},
+				"default": {
+					Description: "Error",
+					Content:     jsonContent(&openAPISchema{Ref: schemaRef + errorSchema}),
+				},
+			},
+		}
+
+		if route.HTTPMethod == http.MethodGet {
+			op.Parameters = sb.queryParameters(route.Method.Input(), "", map[protoreflect.FullName]bool{})
+		} else {
+			op.RequestBody = &openAPIBody{Content: jsonContent(sb.message(route.Method.Input()))}
+		}
+
+		doc.Paths[route.Path] = map[string]*openAPIOperation{
+			strings.ToLower(route.HTTPMethod): op,
+		}
+	}
+
+	return json.Marshal(doc)
+}
+
+func jsonContent(schema *openAPISchema) map[string]openAPIMedia {
+	return map[string]openAPIMedia{
+		"application/json": {Schema: schema},
+	}
+}
+
+// message registers the message schema in components and returns the reference to it
+func (b *schemaBuilder) message(md protoreflect.MessageDescriptor) *openAPISchema {
+	if md.FullName() == timestampName {
+		return &openAPISchema{Type: "string", Format: "date-time"}
+	}
+
+	name := string(md.FullName())
+	ref := &openAPISchema{Ref: schemaRef + name}
+	if _, ok := b.schemas[name]; ok {
+		return ref
+	}
+
+	schema := &openAPISchema{
+		Type:       "object",
+		Properties: make(map[string]*openAPISchema, md.Fields().Len()),
+	}
+	// registering before filling the properties allows recursive messages
+	b.schemas[name] = schema
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		schema.Properties[fd.JSONName()] = b.field(fd)
+	}
+
+	return ref
+}
+
+func (b *schemaBuilder) field(fd protoreflect.FieldDescriptor) *openAPISchema {
+	if fd.IsMap() {
+		return &openAPISchema{Type: "object", AdditionalProperties: b.singular(fd.MapValue())}
+	}
+
+	if fd.IsList() {
+		return &openAPISchema{Type: "array", Items: b.singular(fd)}
+	}
+
+	return b.singular(fd)
+}
+
+func (b *schemaBuilder) singular(fd protoreflect.FieldDescriptor) *openAPISchema {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return &openAPISchema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &openAPISchema{Type: "integer", Format: "int32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// protojson encodes 64-bit integers as strings
+		return &openAPISchema{Type: "string", Format: "int64"}
+	case protoreflect.FloatKind:
+		return &openAPISchema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return &openAPISchema{Type: "number", Format: "double"}
+	case protoreflect.BytesKind:
+		return &openAPISchema{Type: "string", Format: "byte"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		enum := make([]string, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			enum = append(enum, string(values.Get(i).Name()))
+		}
+		return &openAPISchema{Type: "string", Enum: enum}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return b.message(fd.Message())
+	default:
+		return &openAPISchema{Type: "string"}
+	}
+}
+
+// queryParameters flattens the request into query parameters with dotted names for nested messages
+func (b *schemaBuilder) queryParameters(md protoreflect.MessageDescriptor, prefix string, visited map[protoreflect.FullName]bool) []openAPIParameter {
+	if visited[md.FullName()] {
+		return nil
+	}
+	visited[md.FullName()] = true
+	defer delete(visited, md.FullName())
+
+	var params []openAPIParameter
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := prefix + fd.JSONName()
+
+		if fd.IsMap() {
+			continue
+		}
+
+		if fd.Kind() == protoreflect.MessageKind && fd.Message().FullName() != timestampName {
+			if !fd.IsList() {
+				params = append(params, b.queryParameters(fd.Message(), name+".", visited)...)
+			}
+			continue
+		}
+
+		params = append(params, openAPIParameter{
+			Name:   name,
+			In:     "query",
+			Schema: b.field(fd),
+		})
+	}
+
+	return params
+}
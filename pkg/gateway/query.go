@@ -0,0 +1,158 @@
+package gateway
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const timestampName protoreflect.FullName = "google.protobuf.Timestamp"
+
+// populateFromQuery fills the request by query parameters. Keys are field names in json or proto format,
+// nested fields are separated by dots, repeated fields are passed as repeated parameters,
+// e.g. ?category=defi&category=nft&pagination.limit=10
+func populateFromQuery(msg protoreflect.Message, values url.Values) error {
+	for key, list := range values {
+		if err := setPath(msg, strings.Split(key, "."), list); err != nil {
+			return fmt.Errorf("parameter %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+func setPath(msg protoreflect.Message, path []string, values []string) error {
+	fd := findField(msg.Descriptor(), path[0])
+	if fd == nil {
+		return fmt.Errorf("unknown field %s", path[0])
+	}
+
+	if len(path) > 1 {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+			return fmt.Errorf("field %s has no nested fields", path[0])
+		}
+
+		return setPath(msg.Mutable(fd).Message(), path[1:], values)
+	}
+
+	if fd.IsMap() {
+		return fmt.Errorf("map fields are not supported in query")
+	}
+
+	if fd.IsList() {
+		list := msg.Mutable(fd).List()
+		for _, raw := range values {
+			v, err := parseValue(fd, list.NewElement(), raw)
+			if err != nil {
+				return err
+			}
+			list.Append(v)
+		}
+
+		return nil
+	}
+
+	if len(values) != 1 {
+		return fmt.Errorf("single value expected")
+	}
+
+	var current protoreflect.Value
+	if fd.Kind() == protoreflect.MessageKind {
+		current = msg.NewField(fd)
+	}
+
+	v, err := parseValue(fd, current, values[0])
+	if err != nil {
+		return err
+	}
+	msg.Set(fd, v)
+
+	return nil
+}
+
+func findField(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	fields := md.Fields()
+	if fd := fields.ByName(protoreflect.Name(name)); fd != nil {
+		return fd
+	}
+
+	return fields.ByJSONName(name)
+}
+
+// parseValue converts the raw value to the field kind, empty is used for message fields only
+func parseValue(fd protoreflect.FieldDescriptor, empty protoreflect.Value, raw string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(raw), nil
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(raw)
+		return protoreflect.ValueOfBool(v), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(raw, 10, 32)
+		return protoreflect.ValueOfInt32(int32(v)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(raw, 10, 64)
+		return protoreflect.ValueOfInt64(v), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(raw, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(v)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(raw, 10, 64)
+		return protoreflect.ValueOfUint64(v), err
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(raw, 32)
+		return protoreflect.ValueOfFloat32(float32(v)), err
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(raw, 64)
+		return protoreflect.ValueOfFloat64(v), err
+	case protoreflect.BytesKind:
+		v, err := base64.StdEncoding.DecodeString(raw)
+		return protoreflect.ValueOfBytes(v), err
+	case protoreflect.EnumKind:
+		return parseEnum(fd.Enum(), raw)
+	case protoreflect.MessageKind:
+		if fd.Message().FullName() != timestampName {
+			return protoreflect.Value{}, fmt.Errorf("message fields should be passed by nested keys")
+		}
+
+		return parseTimestamp(empty.Message(), raw)
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported kind %s", fd.Kind())
+	}
+}
+
+func parseEnum(ed protoreflect.EnumDescriptor, raw string) (protoreflect.Value, error) {
+	if ev := ed.Values().ByName(protoreflect.Name(raw)); ev != nil {
+		return protoreflect.ValueOfEnum(ev.Number()), nil
+	}
+
+	num, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || ed.Values().ByNumber(protoreflect.EnumNumber(num)) == nil {
+		return protoreflect.Value{}, fmt.Errorf("unknown enum value %s", raw)
+	}
+
+	return protoreflect.ValueOfEnum(protoreflect.EnumNumber(num)), nil
+}
+
+// parseTimestamp accepts RFC 3339 dates and unix seconds
+func parseTimestamp(msg protoreflect.Message, raw string) (protoreflect.Value, error) {
+	ts, err := time.Parse(time.RFC3339Nano, raw)
+	if err != nil {
+		sec, perr := strconv.ParseInt(raw, 10, 64)
+		if perr != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid timestamp %s", raw)
+		}
+		ts = time.Unix(sec, 0)
+	}
+
+	fields := msg.Descriptor().Fields()
+	msg.Set(fields.ByName("seconds"), protoreflect.ValueOfInt64(ts.Unix()))
+	msg.Set(fields.ByName("nanos"), protoreflect.ValueOfInt32(int32(ts.Nanosecond())))
+
+	return protoreflect.ValueOfMessage(msg), nil
+}
@@ -0,0 +1,26 @@
+package gateway
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/goverland-labs/goverland-core-storage/pkg/middleware"
+)
+
+const readHeaderTimeout = 30 * time.Second
+
+func NewServer(listen string, g *Gateway, timeout time.Duration) *http.Server {
+	router := mux.NewRouter()
+	router.Use(middleware.Panic, middleware.Timeout(timeout), middleware.JSON)
+	g.Register(router)
+
+	server := &http.Server{
+		Addr:              listen,
+		Handler:           router,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	return server
+}
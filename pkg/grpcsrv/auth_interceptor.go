@@ -2,16 +2,199 @@ package grpcsrv
 
 import (
 	"context"
+	"crypto/sha256"
+	"fmt"
+	"path"
+	"strings"
+
+	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	grpcctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	apiKeyHeader = "x-api-key"
+	bearerScheme = "bearer"
+
+	// anyClient is used in scopes to grant methods for all authenticated clients
+	anyClient = "*"
+	// anyMethod is used in scopes to grant all methods
+	anyMethod = "*"
 )
 
+type clientCtxKey struct{}
+
+// Client is the identity of the authenticated caller
+type Client struct {
+	Name string
+	// Scopes are the patterns of full method names available for the client, e.g. /storagepb.Dao/GetByID,
+	// /storagepb.Dao/* or /storagepb.*/Get*
+	Scopes []string
+}
+
+// Allowed checks if the client has access to the full method name
+func (c Client) Allowed(method string) bool {
+	for _, pattern := range c.Scopes {
+		if pattern == anyMethod {
+			return true
+		}
+
+		if ok, _ := path.Match(pattern, method); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ClientFromContext returns the client authenticated by the interceptor
+func ClientFromContext(ctx context.Context) (Client, bool) {
+	c, ok := ctx.Value(clientCtxKey{}).(Client)
+
+	return c, ok
+}
+
+type AuthConfig struct {
+	Enabled bool
+	// APIKeys is the list of client:key pairs
+	APIKeys []string
+	// JWTSecret is the secret for HS256 signed tokens, the client name is taken from the sub claim
+	JWTSecret string
+	// Scopes is the list of client:pattern pairs, the * client grants the pattern to all clients
+	Scopes []string
+}
+
 type Auth struct {
+	enabled bool
+	// keys contains client names by key hashes to avoid timing attacks on lookup
+	keys   map[[sha256.Size]byte]string
+	jwt    *jwtVerifier
+	scopes map[string][]string
 }
 
-func NewAuthInterceptor() *Auth {
-	return &Auth{}
+func NewAuthInterceptor(cfg AuthConfig) (*Auth, error) {
+	a := &Auth{
+		enabled: cfg.Enabled,
+		keys:    make(map[[sha256.Size]byte]string, len(cfg.APIKeys)),
+		scopes:  make(map[string][]string),
+	}
+
+	for _, pair := range cfg.APIKeys {
+		client, key, err := splitPair(pair)
+		if err != nil {
+			return nil, fmt.Errorf("api key: %w", err)
+		}
+
+		a.keys[sha256.Sum256([]byte(key))] = client
+	}
+
+	for _, pair := range cfg.Scopes {
+		client, pattern, err := splitPair(pair)
+		if err != nil {
+			return nil, fmt.Errorf("scope: %w", err)
+		}
+
+		if _, err = path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("scope pattern %s: %w", pattern, err)
+		}
+
+		a.scopes[client] = append(a.scopes[client], pattern)
+	}
+
+	if cfg.JWTSecret != "" {
+		a.jwt = newJWTVerifier([]byte(cfg.JWTSecret))
+	}
+
+	if a.enabled && len(a.keys) == 0 && a.jwt == nil {
+		return nil, fmt.Errorf("auth is enabled without api keys and jwt secret")
+	}
+
+	return a, nil
 }
 
-// todo: implement auth here
+// AuthAndIdentifyTickerFunc authenticates the caller by the x-api-key header or the bearer JWT
+// and checks the called method against the client scopes
 func (a *Auth) AuthAndIdentifyTickerFunc(ctx context.Context) (context.Context, error) {
-	return ctx, nil
+	if !a.enabled {
+		return ctx, nil
+	}
+
+	client, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	method, _ := grpc.Method(ctx)
+	if !client.Allowed(method) {
+		return nil, status.Errorf(codes.PermissionDenied, "method %s is not allowed for the client %s", method, client.Name)
+	}
+
+	grpcctxtags.Extract(ctx).Set("auth.client", client.Name)
+
+	return context.WithValue(ctx, clientCtxKey{}, client), nil
+}
+
+func (a *Auth) authenticate(ctx context.Context) (Client, error) {
+	if key := metadata.ValueFromIncomingContext(ctx, apiKeyHeader); len(key) > 0 {
+		name, ok := a.keys[sha256.Sum256([]byte(key[0]))]
+		if !ok {
+			return Client{}, status.Error(codes.Unauthenticated, "invalid api key")
+		}
+
+		return Client{Name: name, Scopes: a.clientScopes(name)}, nil
+	}
+
+	if a.jwt == nil {
+		return Client{}, status.Error(codes.Unauthenticated, "api key is required")
+	}
+
+	token, err := grpcauth.AuthFromMD(ctx, bearerScheme)
+	if err != nil {
+		return Client{}, status.Error(codes.Unauthenticated, "api key or bearer token is required")
+	}
+
+	claims, err := a.jwt.Verify(token)
+	if err != nil {
+		return Client{}, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+
+	scopes := a.clientScopes(claims.Subject)
+	// the signed scope claim narrows the configured scopes down, so tokens can't escalate privileges
+	if claims.Scope != "" {
+		scopes = intersectScopes(scopes, strings.Fields(claims.Scope))
+	}
+
+	return Client{Name: claims.Subject, Scopes: scopes}, nil
+}
+
+func (a *Auth) clientScopes(name string) []string {
+	scopes := make([]string, 0, len(a.scopes[name])+len(a.scopes[anyClient]))
+	scopes = append(scopes, a.scopes[anyClient]...)
+	scopes = append(scopes, a.scopes[name]...)
+
+	return scopes
+}
+
+// intersectScopes keeps requested patterns which are covered by the granted ones
+func intersectScopes(granted, requested []string) []string {
+	res := make([]string, 0, len(requested))
+	for _, pattern := range requested {
+		if (Client{Scopes: granted}).Allowed(pattern) {
+			res = append(res, pattern)
+		}
+	}
+
+	return res
+}
+
+func splitPair(pair string) (string, string, error) {
+	name, value, ok := strings.Cut(strings.TrimSpace(pair), ":")
+	if !ok || name == "" || value == "" {
+		return "", "", fmt.Errorf("invalid pair %q, expected name:value", pair)
+	}
+
+	return name, value, nil
 }
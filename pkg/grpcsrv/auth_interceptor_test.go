@@ -0,0 +1,155 @@
+package grpcsrv
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type methodStream struct {
+	grpc.ServerTransportStream
+	method string
+}
+
+func (s methodStream) Method() string {
+	return s.method
+}
+
+func callCtx(method string, md ...string) context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(md...))
+
+	return grpc.NewContextWithServerTransportStream(ctx, methodStream{method: method})
+}
+
+func signToken(t *testing.T, secret string, claims map[string]any) string {
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestUnitAuthAndIdentify(t *testing.T) {
+	auth, err := NewAuthInterceptor(AuthConfig{
+		Enabled:   true,
+		APIKeys:   []string{"web:web-key", "admin:admin-key"},
+		JWTSecret: "secret",
+		Scopes:    []string{"*:/storagepb.*/Get*", "web:/storagepb.Vote/*", "admin:*"},
+	})
+	require.NoError(t, err)
+
+	exp := time.Now().Add(time.Hour).Unix()
+
+	for name, tc := range map[string]struct {
+		ctx    context.Context
+		code   codes.Code
+		client string
+	}{
+		"no credentials": {
+			ctx:  callCtx("/storagepb.Dao/GetByID"),
+			code: codes.Unauthenticated,
+		},
+		"invalid api key": {
+			ctx:  callCtx("/storagepb.Dao/GetByID", "x-api-key", "unknown"),
+			code: codes.Unauthenticated,
+		},
+		"read by api key": {
+			ctx:    callCtx("/storagepb.Dao/GetByID", "x-api-key", "web-key"),
+			code:   codes.OK,
+			client: "web",
+		},
+		"service scope by api key": {
+			ctx:    callCtx("/storagepb.Vote/Vote", "x-api-key", "web-key"),
+			code:   codes.OK,
+			client: "web",
+		},
+		"mutation without scope": {
+			ctx:  callCtx("/storagepb.Dao/PopulateTokenPrices", "x-api-key", "web-key"),
+			code: codes.PermissionDenied,
+		},
+		"mutation by admin": {
+			ctx:    callCtx("/storagepb.Dao/PopulateTokenPrices", "x-api-key", "admin-key"),
+			code:   codes.OK,
+			client: "admin",
+		},
+		"valid token": {
+			ctx:    callCtx("/storagepb.Dao/UpdateFungibleIds", "authorization", "Bearer "+signToken(t, "secret", map[string]any{"sub": "admin", "exp": exp})),
+			code:   codes.OK,
+			client: "admin",
+		},
+		"token scope narrows configured scopes": {
+			ctx:  callCtx("/storagepb.Dao/UpdateFungibleIds", "authorization", "Bearer "+signToken(t, "secret", map[string]any{"sub": "admin", "exp": exp, "scope": "/storagepb.Dao/Get*"})),
+			code: codes.PermissionDenied,
+		},
+		"token for unknown client uses common scopes": {
+			ctx:    callCtx("/storagepb.Proposal/GetByID", "authorization", "Bearer "+signToken(t, "secret", map[string]any{"sub": "reader", "exp": exp})),
+			code:   codes.OK,
+			client: "reader",
+		},
+		"token with wrong signature": {
+			ctx:  callCtx("/storagepb.Dao/GetByID", "authorization", "Bearer "+signToken(t, "other", map[string]any{"sub": "admin", "exp": exp})),
+			code: codes.Unauthenticated,
+		},
+		"expired token": {
+			ctx:  callCtx("/storagepb.Dao/GetByID", "authorization", "Bearer "+signToken(t, "secret", map[string]any{"sub": "admin", "exp": time.Now().Add(-time.Minute).Unix()})),
+			code: codes.Unauthenticated,
+		},
+		"token without exp": {
+			ctx:  callCtx("/storagepb.Dao/GetByID", "authorization", "Bearer "+signToken(t, "secret", map[string]any{"sub": "admin"})),
+			code: codes.Unauthenticated,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, err := auth.AuthAndIdentifyTickerFunc(tc.ctx)
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code != codes.OK {
+				return
+			}
+
+			client, ok := ClientFromContext(ctx)
+			require.True(t, ok)
+			require.Equal(t, tc.client, client.Name)
+		})
+	}
+}
+
+func TestUnitNewAuthInterceptor(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg   AuthConfig
+		valid bool
+	}{
+		"disabled": {
+			cfg:   AuthConfig{},
+			valid: true,
+		},
+		"enabled without credentials": {
+			cfg: AuthConfig{Enabled: true},
+		},
+		"invalid api key pair": {
+			cfg: AuthConfig{Enabled: true, APIKeys: []string{"web"}},
+		},
+		"invalid scope pattern": {
+			cfg: AuthConfig{Enabled: true, APIKeys: []string{"web:key"}, Scopes: []string{"web:/storagepb.[Dao/*"}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewAuthInterceptor(tc.cfg)
+			require.Equal(t, tc.valid, err == nil)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package grpcsrv
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+const jwtAlgHS256 = "HS256"
+
+var (
+	errMalformedToken = errors.New("malformed token")
+	errUnsupportedAlg = errors.New("unsupported algorithm")
+	errBadSignature   = errors.New("signature is invalid")
+	errTokenExpired   = errors.New("token is expired")
+	errTokenNotActive = errors.New("token is not valid yet")
+	errEmptySubject   = errors.New("subject is empty")
+)
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Subject   string `json:"sub"`
+	Scope     string `json:"scope"`
+	ExpiresAt int64  `json:"exp"`
+	NotBefore int64  `json:"nbf"`
+}
+
+// jwtVerifier checks HS256 signed tokens, the exp claim is required
+type jwtVerifier struct {
+	secret []byte
+	now    func() time.Time
+}
+
+func newJWTVerifier(secret []byte) *jwtVerifier {
+	return &jwtVerifier{
+		secret: secret,
+		now:    time.Now,
+	}
+}
+
+func (v *jwtVerifier) Verify(token string) (jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return jwtClaims{}, errMalformedToken
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return jwtClaims{}, err
+	}
+
+	if header.Alg != jwtAlgHS256 {
+		return jwtClaims{}, errUnsupportedAlg
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return jwtClaims{}, errMalformedToken
+	}
+
+	mac := hmac.New(sha256.New, v.secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return jwtClaims{}, errBadSignature
+	}
+
+	var claims jwtClaims
+	if err = decodeSegment(parts[1], &claims); err != nil {
+		return jwtClaims{}, err
+	}
+
+	now := v.now().Unix()
+	if claims.ExpiresAt == 0 || now >= claims.ExpiresAt {
+		return jwtClaims{}, errTokenExpired
+	}
+
+	if claims.NotBefore != 0 && now < claims.NotBefore {
+		return jwtClaims{}, errTokenNotActive
+	}
+
+	if claims.Subject == "" {
+		return jwtClaims{}, errEmptySubject
+	}
+
+	return claims, nil
+}
+
+func decodeSegment(segment string, dest any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return errMalformedToken
+	}
+
+	if err = json.Unmarshal(data, dest); err != nil {
+		return errMalformedToken
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package coingecko
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type (
+	Client struct {
+		client    *http.Client
+		apiURL    string
+		authKey   string
+		keyHeader string
+	}
+)
+
+// NewClient creates the client, keyHeader is x-cg-demo-api-key or x-cg-pro-api-key depending on the plan
+func NewClient(apiURL, authKey, keyHeader string, client *http.Client) *Client {
+	return &Client{
+		client:    client,
+		apiURL:    apiURL,
+		authKey:   authKey,
+		keyHeader: keyHeader,
+	}
+}
+
+func (c *Client) GetCoin(id string) (*Coin, error) {
+	resp, err := c.getResponse(
+		fmt.Sprintf("coins/%s", id),
+		map[string]string{
+			"localization":   "false",
+			"tickers":        "false",
+			"community_data": "false",
+			"developer_data": "false",
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get response: %w", err)
+	}
+
+	var coin Coin
+	if err = json.Unmarshal(resp, &coin); err != nil {
+		return nil, fmt.Errorf("unmarshal body: %w", err)
+	}
+
+	return &coin, nil
+}
+
+// GetCoinByContract returns the coin by the token contract on the asset platform, e.g. ethereum
+func (c *Client) GetCoinByContract(platform, address string) (*Coin, error) {
+	resp, err := c.getResponse(fmt.Sprintf("coins/%s/contract/%s", platform, strings.ToLower(address)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("get response: %w", err)
+	}
+
+	var coin Coin
+	if err = json.Unmarshal(resp, &coin); err != nil {
+		return nil, fmt.Errorf("unmarshal body: %w", err)
+	}
+
+	return &coin, nil
+}
+
+// GetPrices returns usd prices by coin ids, unknown coins are skipped
+func (c *Client) GetPrices(ids []string) (map[string]float64, error) {
+	resp, err := c.getResponse("simple/price", map[string]string{
+		"ids":           strings.Join(ids, ","),
+		"vs_currencies": "usd",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get response: %w", err)
+	}
+
+	var prices map[string]map[string]float64
+	if err = json.Unmarshal(resp, &prices); err != nil {
+		return nil, fmt.Errorf("unmarshal body: %w", err)
+	}
+
+	res := make(map[string]float64, len(prices))
+	for id, price := range prices {
+		res[id] = price["usd"]
+	}
+
+	return res, nil
+}
+
+// GetMarketChart returns usd prices of the coin for the last days, days is a number or max
+func (c *Client) GetMarketChart(id, days string) (*MarketChart, error) {
+	resp, err := c.getResponse(fmt.Sprintf("coins/%s/market_chart", id), map[string]string{
+		"vs_currency": "usd",
+		"days":        days,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get response: %w", err)
+	}
+
+	var chart MarketChart
+	if err = json.Unmarshal(resp, &chart); err != nil {
+		return nil, fmt.Errorf("unmarshal body: %w", err)
+	}
+
+	return &chart, nil
+}
+
+func (c *Client) getResponse(subURL string, params map[string]string) ([]byte, error) {
+	req, err := http.NewRequest(
+		http.MethodGet,
+		fmt.Sprintf("%s/%s", c.apiURL, subURL),
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+
+	q := req.URL.Query()
+	for k, v := range params {
+		if v != "" {
+			q.Add(k, v)
+		}
+	}
+	req.URL.RawQuery = q.Encode()
+
+	if c.authKey != "" {
+		req.Header.Add(c.keyHeader, c.authKey)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request do: %w", err)
+	}
+
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, body)
+	}
+
+	return body, nil
+}
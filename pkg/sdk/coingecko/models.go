@@ -0,0 +1,56 @@
+package coingecko
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type (
+	Coin struct {
+		ID              string                    `json:"id"`
+		Symbol          string                    `json:"symbol"`
+		Name            string                    `json:"name"`
+		DetailPlatforms map[string]PlatformDetail `json:"detail_platforms"`
+		MarketData      MarketData                `json:"market_data"`
+	}
+
+	PlatformDetail struct {
+		DecimalPlace    int    `json:"decimal_place"`
+		ContractAddress string `json:"contract_address"`
+	}
+
+	MarketData struct {
+		CurrentPrice          Currencies `json:"current_price"`
+		MarketCap             Currencies `json:"market_cap"`
+		FullyDilutedValuation Currencies `json:"fully_diluted_valuation"`
+		TotalSupply           float64    `json:"total_supply"`
+		CirculatingSupply     float64    `json:"circulating_supply"`
+	}
+
+	Currencies struct {
+		USD float64 `json:"usd"`
+	}
+
+	MarketChart struct {
+		Prices []Point `json:"prices"`
+	}
+
+	Point struct {
+		Time  time.Time
+		Price float64
+	}
+)
+
+func (p *Point) UnmarshalJSON(data []byte) error {
+	var v []float64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("failed to parse data: %w", err)
+	}
+	if len(v) != 2 {
+		return fmt.Errorf("incorrect elements in point object %v", v)
+	}
+	p.Time = time.UnixMilli(int64(v[0]))
+	p.Price = v[1]
+	return nil
+}
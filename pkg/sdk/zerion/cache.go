@@ -0,0 +1,60 @@
+package zerion
+
+import (
+	"sync"
+	"time"
+)
+
+// cacheSweepInterval is the min interval between removals of expired responses
+const cacheSweepInterval = time.Minute
+
+type cachedResponse struct {
+	body      []byte
+	expiresAt time.Time
+}
+
+// cache keeps successful responses by request urls
+type cache struct {
+	mu        sync.RWMutex
+	items     map[string]cachedResponse
+	lastSweep time.Time
+}
+
+func newCache() *cache {
+	return &cache{
+		items:     make(map[string]cachedResponse),
+		lastSweep: time.Now(),
+	}
+}
+
+func (c *cache) get(key string) ([]byte, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	item, ok := c.items[key]
+	if !ok || item.expiresAt.Before(time.Now()) {
+		return nil, false
+	}
+
+	return item.body, true
+}
+
+func (c *cache) set(key string, body []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.lastSweep) > cacheSweepInterval {
+		for k, item := range c.items {
+			if item.expiresAt.Before(now) {
+				delete(c.items, k)
+			}
+		}
+		c.lastSweep = now
+	}
+
+	c.items[key] = cachedResponse{
+		body:      body,
+		expiresAt: now.Add(ttl),
+	}
+}
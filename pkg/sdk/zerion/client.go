@@ -1,32 +1,100 @@
 package zerion
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/rs/zerolog/log"
 )
 
+const (
+	defaultRateLimit  = 5
+	defaultRateBurst  = 5
+	defaultRetries    = 3
+	defaultRetryDelay = 500 * time.Millisecond
+	maxRetryDelay     = 30 * time.Second
+)
+
+// defaultCacheTTL is the lifetime of cached responses by aliases, responses of other aliases are not cached
+var defaultCacheTTL = map[string]time.Duration{
+	"chains":           24 * time.Hour,
+	"fungible-data":    5 * time.Minute,
+	"fungible-chart":   5 * time.Minute,
+	"fungibles-list":   time.Minute,
+	"wallet-positions": 10 * time.Minute,
+}
+
 type (
 	Client struct {
 		client  *http.Client
 		apiURL  string
 		authKey string
+
+		limiter    *Limiter
+		retries    int
+		retryDelay time.Duration
+		cacheTTL   map[string]time.Duration
+		cache      *cache
 	}
+
+	Option func(c *Client)
 )
 
-func NewClient(apiURL, authKey string, client *http.Client) *Client {
-	return &Client{
-		client:  client,
-		apiURL:  apiURL,
-		authKey: authKey,
+// WithRateLimit limits requests per second of the client, the limiter is shared by all callers of the client
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *Client) {
+		c.limiter = NewLimiter(rps, burst)
+	}
+}
+
+// WithRetries sets the number of retries of rate limited and failed requests, the delay is doubled on each retry
+func WithRetries(retries int) Option {
+	return func(c *Client) {
+		c.retries = retries
+	}
+}
+
+// WithCacheTTL overrides the lifetime of cached responses by the alias, zero ttl disables the cache
+func WithCacheTTL(alias string, ttl time.Duration) Option {
+	return func(c *Client) {
+		c.cacheTTL[alias] = ttl
+	}
+}
+
+func NewClient(apiURL, authKey string, client *http.Client, opts ...Option) *Client {
+	c := &Client{
+		client:     client,
+		apiURL:     apiURL,
+		authKey:    authKey,
+		limiter:    NewLimiter(defaultRateLimit, defaultRateBurst),
+		retries:    defaultRetries,
+		retryDelay: defaultRetryDelay,
+		cacheTTL:   make(map[string]time.Duration, len(defaultCacheTTL)),
+		cache:      newCache(),
+	}
+
+	for alias, ttl := range defaultCacheTTL {
+		c.cacheTTL[alias] = ttl
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
+
+	return c
 }
 
-func (c *Client) GetFungibleList(ids string, address string) (*FungibleList, error) {
+func (c *Client) GetFungibleList(ctx context.Context, ids string, address string) (*FungibleList, error) {
 	resp, err := c.getResponse(
+		ctx,
 		http.MethodGet,
 		"fungibles/",
 		"fungibles-list",
@@ -48,8 +116,9 @@ func (c *Client) GetFungibleList(ids string, address string) (*FungibleList, err
 	return &list, nil
 }
 
-func (c *Client) GetChains() ([]ChainData, error) {
+func (c *Client) GetChains(ctx context.Context) ([]ChainData, error) {
 	resp, err := c.getResponse(
+		ctx,
 		http.MethodGet,
 		"chains",
 		"chains",
@@ -67,8 +136,9 @@ func (c *Client) GetChains() ([]ChainData, error) {
 	return chains.Data, nil
 }
 
-func (c *Client) GetFungibleData(id string) (*FungibleData, error) {
+func (c *Client) GetFungibleData(ctx context.Context, id string) (*FungibleData, error) {
 	resp, err := c.getResponse(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf("fungibles/%s/", id),
 		"fungible-data",
@@ -88,8 +158,9 @@ func (c *Client) GetFungibleData(id string) (*FungibleData, error) {
 	return &fungible.FungibleData, nil
 }
 
-func (c *Client) GetFungibleChart(id string, period string) (*ChartData, error) {
+func (c *Client) GetFungibleChart(ctx context.Context, id string, period string) (*ChartData, error) {
 	resp, err := c.getResponse(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf("fungibles/%s/charts/%s/", id, period),
 		"fungible-chart",
@@ -109,8 +180,40 @@ func (c *Client) GetFungibleChart(id string, period string) (*ChartData, error)
 	return &chart.ChartData, nil
 }
 
-func (c *Client) getResponse(method, subURL, alias string, params map[string]string) ([]byte, error) {
-	req, err := http.NewRequest(
+// GetWalletPositions returns up to 100 most valuable fungible positions of the wallet on passed chains,
+// all supported chains are used if chains are not passed
+func (c *Client) GetWalletPositions(ctx context.Context, address string, chainIDs []string) ([]Position, error) {
+	resp, err := c.getResponse(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("wallets/%s/positions/", address),
+		"wallet-positions",
+		map[string]string{
+			"currency":          "usd",
+			"filter[positions]": "only_simple",
+			"filter[trash]":     "only_non_trash",
+			"filter[chain_ids]": strings.Join(chainIDs, ","),
+			"sort":              "-value",
+			"page[size]":        "100",
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get response: %w", err)
+	}
+
+	var positions WalletPositions
+	if err = json.Unmarshal(resp, &positions); err != nil {
+		return nil, fmt.Errorf("unmarshal body: %w", err)
+	}
+
+	return positions.List, nil
+}
+
+// getResponse returns the body of the successful response from the cache or from the api.
+// Rate limited and failed requests are repeated with the exponential backoff.
+func (c *Client) getResponse(ctx context.Context, method, subURL, alias string, params map[string]string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		method,
 		fmt.Sprintf("%s/%s", c.apiURL, subURL),
 		nil,
@@ -131,20 +234,94 @@ func (c *Client) getResponse(method, subURL, alias string, params map[string]str
 
 	request := c.withAuth(req)
 
-	log.Info().Msgf("request %v", request)
+	key := request.URL.String()
+	ttl := c.cacheTTL[alias]
+	if ttl > 0 && method == http.MethodGet {
+		if body, ok := c.cache.get(key); ok {
+			metricCacheHitsCounter.WithLabelValues(alias).Inc()
 
-	resp, err := c.client.Do(request)
+			return body, nil
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		body, retryAfter, err := c.do(request, alias)
+		if err == nil {
+			if ttl > 0 && method == http.MethodGet {
+				c.cache.set(key, body, ttl)
+			}
+
+			return body, nil
+		}
+
+		var apiErr *APIError
+		if attempt >= c.retries || !errors.As(err, &apiErr) || !apiErr.retryable() {
+			return nil, err
+		}
+
+		delay := c.backoff(attempt, retryAfter)
+		log.Debug().Err(err).Str("alias", alias).Msgf("retry zerion request in %s", delay)
+		metricRetriesCounter.WithLabelValues(alias).Inc()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// do sends the request and returns the body of the successful response or the delay requested by the api
+func (c *Client) do(req *http.Request, alias string) ([]byte, time.Duration, error) {
+	start := time.Now()
+	defer func() {
+		metricRequestHistogram.WithLabelValues(alias).Observe(time.Since(start).Seconds())
+	}()
+
+	if err := c.limiter.Wait(req.Context()); err != nil {
+		return nil, 0, fmt.Errorf("wait rate limiter: %w", err)
+	}
+
+	log.Debug().Str("alias", alias).Str("path", req.URL.Path).Msg("zerion request")
+
+	resp, err := c.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request do: %w", err)
+		metricRequestsCounter.WithLabelValues(alias, "error").Inc()
+
+		return nil, 0, fmt.Errorf("request do: %w", err)
 	}
 
 	defer resp.Body.Close()
+
+	metricRequestsCounter.WithLabelValues(alias, strconv.Itoa(resp.StatusCode)).Inc()
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("read body: %w", err)
+		return nil, 0, fmt.Errorf("read body: %w", err)
 	}
 
-	return body, err
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, parseRetryAfter(resp.Header.Get("Retry-After")), newAPIError(alias, resp.StatusCode, body)
+	}
+
+	return body, 0, nil
+}
+
+// backoff returns the delay before the next attempt, the delay requested by the api has the priority
+func (c *Client) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return min(retryAfter, maxRetryDelay)
+	}
+
+	delay := c.retryDelay << attempt
+	if delay <= 0 {
+		return 0
+	}
+
+	// jitter spreads repeated requests of concurrent callers
+	delay += time.Duration(rand.Int63n(int64(delay)/2 + 1))
+
+	return min(delay, maxRetryDelay)
 }
 
 func (c *Client) withAuth(req *http.Request) *http.Request {
@@ -156,3 +333,20 @@ func (c *Client) withAuth(req *http.Request) *http.Request {
 
 	return req
 }
+
+// parseRetryAfter supports both seconds and http date values of the Retry-After header
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+
+	return 0
+}
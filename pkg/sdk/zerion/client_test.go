@@ -0,0 +1,111 @@
+package zerion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitClientStatusErrors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		status int
+		err    error
+	}{
+		"not found":    {status: http.StatusNotFound, err: ErrNotFound},
+		"rate limited": {status: http.StatusTooManyRequests, err: ErrRateLimited},
+		"unavailable":  {status: http.StatusBadGateway, err: ErrUnavailable},
+	} {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = fmt.Fprint(w, `{"errors":[{"title":"error"}]}`)
+			}))
+			defer srv.Close()
+
+			c := NewClient(srv.URL, "key", srv.Client(), WithRetries(0))
+
+			_, err := c.GetFungibleData(context.Background(), "token")
+			require.ErrorIs(t, err, tc.err)
+
+			var apiErr *APIError
+			require.ErrorAs(t, err, &apiErr)
+			require.Equal(t, tc.status, apiErr.StatusCode)
+			require.Equal(t, "fungible-data", apiErr.Alias)
+		})
+	}
+}
+
+func TestUnitClientRetries(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		_, _ = fmt.Fprint(w, `{"data":{"id":"token","attributes":{"name":"Token"}}}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "", srv.Client(), WithRetries(2))
+	c.retryDelay = time.Millisecond
+
+	data, err := c.GetFungibleData(context.Background(), "token")
+	require.NoError(t, err)
+	require.Equal(t, "token", data.ID)
+	require.Equal(t, 3, requests)
+
+	requests = 0
+	c = NewClient(srv.URL, "", srv.Client(), WithRetries(1), WithCacheTTL("fungible-data", 0))
+	c.retryDelay = time.Millisecond
+
+	_, err = c.GetFungibleData(context.Background(), "token")
+	require.ErrorIs(t, err, ErrRateLimited)
+	require.Equal(t, 2, requests)
+}
+
+func TestUnitClientCache(t *testing.T) {
+	requests := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		_, _ = fmt.Fprint(w, `{"data":{"id":"token","attributes":{"points":[]}}}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "", srv.Client(), WithCacheTTL("fungible-chart", 0))
+
+	for i := 0; i < 3; i++ {
+		_, err := c.GetFungibleData(context.Background(), "token")
+		require.NoError(t, err)
+
+		_, err = c.GetFungibleChart(context.Background(), "token", "day")
+		require.NoError(t, err)
+	}
+
+	_, err := c.GetFungibleData(context.Background(), "other")
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]int{
+		"/fungibles/token/":            1,
+		"/fungibles/other/":            1,
+		"/fungibles/token/charts/day/": 3,
+	}, requests)
+}
+
+func TestUnitLimiter(t *testing.T) {
+	l := NewLimiter(1, 2)
+
+	require.NoError(t, l.Wait(context.Background()))
+	require.NoError(t, l.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, l.Wait(ctx), context.DeadlineExceeded)
+}
@@ -0,0 +1,57 @@
+package zerion
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// maxErrorBodyLen limits the response body stored in APIError
+const maxErrorBodyLen = 512
+
+var (
+	ErrNotFound    = errors.New("not found")
+	ErrRateLimited = errors.New("rate limited")
+	ErrUnavailable = errors.New("service unavailable")
+)
+
+// APIError is returned for non 2xx responses, it matches ErrNotFound, ErrRateLimited and ErrUnavailable by errors.Is
+type APIError struct {
+	Alias      string
+	StatusCode int
+	Body       string
+}
+
+func newAPIError(alias string, statusCode int, body []byte) *APIError {
+	if len(body) > maxErrorBodyLen {
+		body = body[:maxErrorBodyLen]
+	}
+
+	return &APIError{
+		Alias:      alias,
+		StatusCode: statusCode,
+		Body:       string(body),
+	}
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("zerion %s: status %d: %s", e.Alias, e.StatusCode, e.Body)
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrUnavailable:
+		return e.StatusCode >= http.StatusInternalServerError
+	default:
+		return false
+	}
+}
+
+// retryable checks if the request can be repeated after the error
+func (e *APIError) retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
@@ -0,0 +1,47 @@
+package zerion
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/goverland-labs/goverland-core-storage/internal/metrics"
+)
+
+var (
+	metricRequestsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "zerion",
+			Name:      "requests_total",
+			Help:      "Number of zerion requests by aliases and response statuses",
+		}, []string{"alias", "status"},
+	)
+
+	metricRequestHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "zerion",
+			Name:      "request_duration_seconds",
+			Help:      "Zerion request duration seconds including waiting for the rate limiter",
+			Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+		}, []string{"alias"},
+	)
+
+	metricRetriesCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "zerion",
+			Name:      "retries_total",
+			Help:      "Number of repeated zerion requests by aliases",
+		}, []string{"alias"},
+	)
+
+	metricCacheHitsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "zerion",
+			Name:      "cache_hits_total",
+			Help:      "Number of zerion responses served from the cache by aliases",
+		}, []string{"alias"},
+	)
+)
@@ -70,6 +70,51 @@ type (
 		Price float64
 	}
 
+	WalletPositions struct {
+		List []Position `json:"data"`
+	}
+
+	Position struct {
+		ID            string                `json:"id"`
+		Attributes    PositionAttributes    `json:"attributes"`
+		Relationships PositionRelationships `json:"relationships"`
+	}
+
+	PositionAttributes struct {
+		Name         string       `json:"name"`
+		Quantity     Quantity     `json:"quantity"`
+		Value        float64      `json:"value"`
+		Price        float64      `json:"price"`
+		FungibleInfo FungibleInfo `json:"fungible_info"`
+		UpdatedAt    time.Time    `json:"updated_at"`
+	}
+
+	Quantity struct {
+		Float   float64 `json:"float"`
+		Numeric string  `json:"numeric"`
+	}
+
+	FungibleInfo struct {
+		Name            string            `json:"name"`
+		Symbol          string            `json:"symbol"`
+		Icon            ChainIcon         `json:"icon"`
+		Implementations []Implementations `json:"implementations"`
+	}
+
+	PositionRelationships struct {
+		Chain    Relationship `json:"chain"`
+		Fungible Relationship `json:"fungible"`
+	}
+
+	Relationship struct {
+		Data RelationshipData `json:"data"`
+	}
+
+	RelationshipData struct {
+		Type string `json:"type"`
+		ID   string `json:"id"`
+	}
+
 	Chains struct {
 		Data []ChainData `json:"data"`
 	}
@@ -22,6 +22,107 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type VerificationDecisionStatus int32
+
+const (
+	VerificationDecisionStatus_VERIFICATION_DECISION_STATUS_UNSPECIFIED VerificationDecisionStatus = 0
+	VerificationDecisionStatus_VERIFICATION_DECISION_STATUS_APPROVED    VerificationDecisionStatus = 1
+	VerificationDecisionStatus_VERIFICATION_DECISION_STATUS_DECLINED    VerificationDecisionStatus = 2
+)
+
+// Enum value maps for VerificationDecisionStatus.
+var (
+	VerificationDecisionStatus_name = map[int32]string{
+		0: "VERIFICATION_DECISION_STATUS_UNSPECIFIED",
+		1: "VERIFICATION_DECISION_STATUS_APPROVED",
+		2: "VERIFICATION_DECISION_STATUS_DECLINED",
+	}
+	VerificationDecisionStatus_value = map[string]int32{
+		"VERIFICATION_DECISION_STATUS_UNSPECIFIED": 0,
+		"VERIFICATION_DECISION_STATUS_APPROVED":    1,
+		"VERIFICATION_DECISION_STATUS_DECLINED":    2,
+	}
+)
+
+func (x VerificationDecisionStatus) Enum() *VerificationDecisionStatus {
+	p := new(VerificationDecisionStatus)
+	*p = x
+	return p
+}
+
+func (x VerificationDecisionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VerificationDecisionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_storagepb_dao_proto_enumTypes[0].Descriptor()
+}
+
+func (VerificationDecisionStatus) Type() protoreflect.EnumType {
+	return &file_storagepb_dao_proto_enumTypes[0]
+}
+
+func (x VerificationDecisionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VerificationDecisionStatus.Descriptor instead.
+func (VerificationDecisionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{0}
+}
+
+type ActivityGranularity int32
+
+const (
+	ActivityGranularity_ACTIVITY_GRANULARITY_UNSPECIFIED ActivityGranularity = 0
+	ActivityGranularity_ACTIVITY_GRANULARITY_DAY         ActivityGranularity = 1
+	ActivityGranularity_ACTIVITY_GRANULARITY_WEEK        ActivityGranularity = 2
+	ActivityGranularity_ACTIVITY_GRANULARITY_MONTH       ActivityGranularity = 3
+)
+
+// Enum value maps for ActivityGranularity.
+var (
+	ActivityGranularity_name = map[int32]string{
+		0: "ACTIVITY_GRANULARITY_UNSPECIFIED",
+		1: "ACTIVITY_GRANULARITY_DAY",
+		2: "ACTIVITY_GRANULARITY_WEEK",
+		3: "ACTIVITY_GRANULARITY_MONTH",
+	}
+	ActivityGranularity_value = map[string]int32{
+		"ACTIVITY_GRANULARITY_UNSPECIFIED": 0,
+		"ACTIVITY_GRANULARITY_DAY":         1,
+		"ACTIVITY_GRANULARITY_WEEK":        2,
+		"ACTIVITY_GRANULARITY_MONTH":       3,
+	}
+)
+
+func (x ActivityGranularity) Enum() *ActivityGranularity {
+	p := new(ActivityGranularity)
+	*p = x
+	return p
+}
+
+func (x ActivityGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActivityGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_storagepb_dao_proto_enumTypes[1].Descriptor()
+}
+
+func (ActivityGranularity) Type() protoreflect.EnumType {
+	return &file_storagepb_dao_proto_enumTypes[1]
+}
+
+func (x ActivityGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActivityGranularity.Descriptor instead.
+func (ActivityGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{1}
+}
+
 type DaoByIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DaoId         string                 `protobuf:"bytes,1,opt,name=dao_id,json=daoId,proto3" json:"dao_id,omitempty"`
@@ -265,8 +366,10 @@ type DaoInfo struct {
 	TokenExist         bool                   `protobuf:"varint,35,opt,name=token_exist,json=tokenExist,proto3" json:"token_exist,omitempty"`
 	TokenSymbol        string                 `protobuf:"bytes,36,opt,name=token_symbol,json=tokenSymbol,proto3" json:"token_symbol,omitempty"`
 	FungibleId         string                 `protobuf:"bytes,37,opt,name=fungible_id,json=fungibleId,proto3" json:"fungible_id,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// source is the source of the primary identity, snapshot or governor
+	Source        string `protobuf:"bytes,38,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DaoInfo) Reset() {
@@ -558,6 +661,13 @@ func (x *DaoInfo) GetFungibleId() string {
 	return ""
 }
 
+func (x *DaoInfo) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
 type DaoByIDResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Dao           *DaoInfo               `protobuf:"bytes,1,opt,name=dao,proto3" json:"dao,omitempty"`
@@ -603,15 +713,21 @@ func (x *DaoByIDResponse) GetDao() *DaoInfo {
 }
 
 type DaoByFilterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         *string                `protobuf:"bytes,1,opt,name=query,proto3,oneof" json:"query,omitempty"`
-	Category      *string                `protobuf:"bytes,2,opt,name=category,proto3,oneof" json:"category,omitempty"`
-	Limit         *uint64                `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset        *uint64                `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
-	DaoIds        []string               `protobuf:"bytes,5,rep,name=dao_ids,json=daoIds,proto3" json:"dao_ids,omitempty"`
-	FungibleIds   []string               `protobuf:"bytes,6,rep,name=fungible_ids,json=fungibleIds,proto3" json:"fungible_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Query       *string                `protobuf:"bytes,1,opt,name=query,proto3,oneof" json:"query,omitempty"`
+	Category    *string                `protobuf:"bytes,2,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Limit       *uint64                `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset      *uint64                `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	DaoIds      []string               `protobuf:"bytes,5,rep,name=dao_ids,json=daoIds,proto3" json:"dao_ids,omitempty"`
+	FungibleIds []string               `protobuf:"bytes,6,rep,name=fungible_ids,json=fungibleIds,proto3" json:"fungible_ids,omitempty"`
+	// cursor is the next_cursor from the previous page, offset is ignored if it's set
+	Cursor         *string `protobuf:"bytes,7,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	SkipTotalCount *bool   `protobuf:"varint,8,opt,name=skip_total_count,json=skipTotalCount,proto3,oneof" json:"skip_total_count,omitempty"`
+	// rollup_children adds proposals and active votes of child daos to the parent,
+	// voters_count of the parent becomes the number of unique voters of the whole family
+	RollupChildren *bool `protobuf:"varint,9,opt,name=rollup_children,json=rollupChildren,proto3,oneof" json:"rollup_children,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DaoByFilterRequest) Reset() {
@@ -686,10 +802,33 @@ func (x *DaoByFilterRequest) GetFungibleIds() []string {
 	return nil
 }
 
+func (x *DaoByFilterRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *DaoByFilterRequest) GetSkipTotalCount() bool {
+	if x != nil && x.SkipTotalCount != nil {
+		return *x.SkipTotalCount
+	}
+	return false
+}
+
+func (x *DaoByFilterRequest) GetRollupChildren() bool {
+	if x != nil && x.RollupChildren != nil {
+		return *x.RollupChildren
+	}
+	return false
+}
+
 type DaoByFilterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Daos          []*DaoInfo             `protobuf:"bytes,1,rep,name=daos,proto3" json:"daos,omitempty"`
-	TotalCount    uint64                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Daos       []*DaoInfo             `protobuf:"bytes,1,rep,name=daos,proto3" json:"daos,omitempty"`
+	TotalCount uint64                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// next_cursor is empty for the last page
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -738,6 +877,13 @@ func (x *DaoByFilterResponse) GetTotalCount() uint64 {
 	return 0
 }
 
+func (x *DaoByFilterResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type TopCategory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
@@ -1050,6 +1196,156 @@ func (x *GetRecommendationsListResponse) GetList() []*DaoRecommendationDetails {
 	return nil
 }
 
+type RecommendationsForAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Limit         *uint64                `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendationsForAddressRequest) Reset() {
+	*x = RecommendationsForAddressRequest{}
+	mi := &file_storagepb_dao_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendationsForAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationsForAddressRequest) ProtoMessage() {}
+
+func (x *RecommendationsForAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationsForAddressRequest.ProtoReflect.Descriptor instead.
+func (*RecommendationsForAddressRequest) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RecommendationsForAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RecommendationsForAddressRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type AddressRecommendation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Dao   *DaoInfo               `protobuf:"bytes,1,opt,name=dao,proto3" json:"dao,omitempty"`
+	// tokens are recommendation tokens of the dao which are held by the address
+	Tokens        []*DaoRecommendationDetails `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressRecommendation) Reset() {
+	*x = AddressRecommendation{}
+	mi := &file_storagepb_dao_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressRecommendation) ProtoMessage() {}
+
+func (x *AddressRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressRecommendation.ProtoReflect.Descriptor instead.
+func (*AddressRecommendation) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AddressRecommendation) GetDao() *DaoInfo {
+	if x != nil {
+		return x.Dao
+	}
+	return nil
+}
+
+func (x *AddressRecommendation) GetTokens() []*DaoRecommendationDetails {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type RecommendationsForAddressResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// list is ordered by the popularity index, daos where the address votes are skipped
+	List          []*AddressRecommendation `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendationsForAddressResponse) Reset() {
+	*x = RecommendationsForAddressResponse{}
+	mi := &file_storagepb_dao_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendationsForAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationsForAddressResponse) ProtoMessage() {}
+
+func (x *RecommendationsForAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationsForAddressResponse.ProtoReflect.Descriptor instead.
+func (*RecommendationsForAddressResponse) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RecommendationsForAddressResponse) GetList() []*AddressRecommendation {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
 type TokenInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DaoId         string                 `protobuf:"bytes,1,opt,name=dao_id,json=daoId,proto3" json:"dao_id,omitempty"`
@@ -1059,7 +1355,7 @@ type TokenInfoRequest struct {
 
 func (x *TokenInfoRequest) Reset() {
 	*x = TokenInfoRequest{}
-	mi := &file_storagepb_dao_proto_msgTypes[13]
+	mi := &file_storagepb_dao_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1071,7 +1367,7 @@ func (x *TokenInfoRequest) String() string {
 func (*TokenInfoRequest) ProtoMessage() {}
 
 func (x *TokenInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storagepb_dao_proto_msgTypes[13]
+	mi := &file_storagepb_dao_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1084,7 +1380,7 @@ func (x *TokenInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenInfoRequest.ProtoReflect.Descriptor instead.
 func (*TokenInfoRequest) Descriptor() ([]byte, []int) {
-	return file_storagepb_dao_proto_rawDescGZIP(), []int{13}
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TokenInfoRequest) GetDaoId() string {
@@ -1111,7 +1407,7 @@ type TokenInfoResponse struct {
 
 func (x *TokenInfoResponse) Reset() {
 	*x = TokenInfoResponse{}
-	mi := &file_storagepb_dao_proto_msgTypes[14]
+	mi := &file_storagepb_dao_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1123,7 +1419,7 @@ func (x *TokenInfoResponse) String() string {
 func (*TokenInfoResponse) ProtoMessage() {}
 
 func (x *TokenInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storagepb_dao_proto_msgTypes[14]
+	mi := &file_storagepb_dao_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1136,7 +1432,7 @@ func (x *TokenInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenInfoResponse.ProtoReflect.Descriptor instead.
 func (*TokenInfoResponse) Descriptor() ([]byte, []int) {
-	return file_storagepb_dao_proto_rawDescGZIP(), []int{14}
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *TokenInfoResponse) GetName() string {
@@ -1215,7 +1511,7 @@ type TokenChainInfo struct {
 
 func (x *TokenChainInfo) Reset() {
 	*x = TokenChainInfo{}
-	mi := &file_storagepb_dao_proto_msgTypes[15]
+	mi := &file_storagepb_dao_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1227,7 +1523,7 @@ func (x *TokenChainInfo) String() string {
 func (*TokenChainInfo) ProtoMessage() {}
 
 func (x *TokenChainInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_storagepb_dao_proto_msgTypes[15]
+	mi := &file_storagepb_dao_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1240,7 +1536,7 @@ func (x *TokenChainInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenChainInfo.ProtoReflect.Descriptor instead.
 func (*TokenChainInfo) Descriptor() ([]byte, []int) {
-	return file_storagepb_dao_proto_rawDescGZIP(), []int{15}
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *TokenChainInfo) GetChainId() string {
@@ -1288,7 +1584,7 @@ type TokenChartRequest struct {
 
 func (x *TokenChartRequest) Reset() {
 	*x = TokenChartRequest{}
-	mi := &file_storagepb_dao_proto_msgTypes[16]
+	mi := &file_storagepb_dao_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1300,7 +1596,7 @@ func (x *TokenChartRequest) String() string {
 func (*TokenChartRequest) ProtoMessage() {}
 
 func (x *TokenChartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storagepb_dao_proto_msgTypes[16]
+	mi := &file_storagepb_dao_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1313,7 +1609,7 @@ func (x *TokenChartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenChartRequest.ProtoReflect.Descriptor instead.
 func (*TokenChartRequest) Descriptor() ([]byte, []int) {
-	return file_storagepb_dao_proto_rawDescGZIP(), []int{16}
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TokenChartRequest) GetDaoId() string {
@@ -1341,7 +1637,7 @@ type TokenChartResponse struct {
 
 func (x *TokenChartResponse) Reset() {
 	*x = TokenChartResponse{}
-	mi := &file_storagepb_dao_proto_msgTypes[17]
+	mi := &file_storagepb_dao_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1353,7 +1649,7 @@ func (x *TokenChartResponse) String() string {
 func (*TokenChartResponse) ProtoMessage() {}
 
 func (x *TokenChartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storagepb_dao_proto_msgTypes[17]
+	mi := &file_storagepb_dao_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1366,7 +1662,7 @@ func (x *TokenChartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenChartResponse.ProtoReflect.Descriptor instead.
 func (*TokenChartResponse) Descriptor() ([]byte, []int) {
-	return file_storagepb_dao_proto_rawDescGZIP(), []int{17}
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TokenChartResponse) GetPrice() float64 {
@@ -1400,7 +1696,7 @@ type Point struct {
 
 func (x *Point) Reset() {
 	*x = Point{}
-	mi := &file_storagepb_dao_proto_msgTypes[18]
+	mi := &file_storagepb_dao_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1412,7 +1708,7 @@ func (x *Point) String() string {
 func (*Point) ProtoMessage() {}
 
 func (x *Point) ProtoReflect() protoreflect.Message {
-	mi := &file_storagepb_dao_proto_msgTypes[18]
+	mi := &file_storagepb_dao_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1425,7 +1721,7 @@ func (x *Point) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Point.ProtoReflect.Descriptor instead.
 func (*Point) Descriptor() ([]byte, []int) {
-	return file_storagepb_dao_proto_rawDescGZIP(), []int{18}
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Point) GetTime() *timestamppb.Timestamp {
@@ -1451,7 +1747,7 @@ type TokenPricesRequest struct {
 
 func (x *TokenPricesRequest) Reset() {
 	*x = TokenPricesRequest{}
-	mi := &file_storagepb_dao_proto_msgTypes[19]
+	mi := &file_storagepb_dao_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1463,7 +1759,7 @@ func (x *TokenPricesRequest) String() string {
 func (*TokenPricesRequest) ProtoMessage() {}
 
 func (x *TokenPricesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storagepb_dao_proto_msgTypes[19]
+	mi := &file_storagepb_dao_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1476,7 +1772,7 @@ func (x *TokenPricesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenPricesRequest.ProtoReflect.Descriptor instead.
 func (*TokenPricesRequest) Descriptor() ([]byte, []int) {
-	return file_storagepb_dao_proto_rawDescGZIP(), []int{19}
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *TokenPricesRequest) GetDaoId() string {
@@ -1495,7 +1791,7 @@ type TokenPricesResponse struct {
 
 func (x *TokenPricesResponse) Reset() {
 	*x = TokenPricesResponse{}
-	mi := &file_storagepb_dao_proto_msgTypes[20]
+	mi := &file_storagepb_dao_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1507,7 +1803,7 @@ func (x *TokenPricesResponse) String() string {
 func (*TokenPricesResponse) ProtoMessage() {}
 
 func (x *TokenPricesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storagepb_dao_proto_msgTypes[20]
+	mi := &file_storagepb_dao_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1520,7 +1816,7 @@ func (x *TokenPricesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenPricesResponse.ProtoReflect.Descriptor instead.
 func (*TokenPricesResponse) Descriptor() ([]byte, []int) {
-	return file_storagepb_dao_proto_rawDescGZIP(), []int{20}
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *TokenPricesResponse) GetStatus() bool {
@@ -1539,7 +1835,7 @@ type UpdateFungibleIdsRequest struct {
 
 func (x *UpdateFungibleIdsRequest) Reset() {
 	*x = UpdateFungibleIdsRequest{}
-	mi := &file_storagepb_dao_proto_msgTypes[21]
+	mi := &file_storagepb_dao_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1551,7 +1847,7 @@ func (x *UpdateFungibleIdsRequest) String() string {
 func (*UpdateFungibleIdsRequest) ProtoMessage() {}
 
 func (x *UpdateFungibleIdsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storagepb_dao_proto_msgTypes[21]
+	mi := &file_storagepb_dao_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1564,7 +1860,7 @@ func (x *UpdateFungibleIdsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFungibleIdsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateFungibleIdsRequest) Descriptor() ([]byte, []int) {
-	return file_storagepb_dao_proto_rawDescGZIP(), []int{21}
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *UpdateFungibleIdsRequest) GetCategory() string {
@@ -1583,7 +1879,7 @@ type UpdateFungibleIdsResponse struct {
 
 func (x *UpdateFungibleIdsResponse) Reset() {
 	*x = UpdateFungibleIdsResponse{}
-	mi := &file_storagepb_dao_proto_msgTypes[22]
+	mi := &file_storagepb_dao_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1595,7 +1891,7 @@ func (x *UpdateFungibleIdsResponse) String() string {
 func (*UpdateFungibleIdsResponse) ProtoMessage() {}
 
 func (x *UpdateFungibleIdsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storagepb_dao_proto_msgTypes[22]
+	mi := &file_storagepb_dao_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,7 +1904,7 @@ func (x *UpdateFungibleIdsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFungibleIdsResponse.ProtoReflect.Descriptor instead.
 func (*UpdateFungibleIdsResponse) Descriptor() ([]byte, []int) {
-	return file_storagepb_dao_proto_rawDescGZIP(), []int{22}
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *UpdateFungibleIdsResponse) GetStatus() bool {
@@ -1618,27 +1914,2008 @@ func (x *UpdateFungibleIdsResponse) GetStatus() bool {
 	return false
 }
 
-var File_storagepb_dao_proto protoreflect.FileDescriptor
+type DaoHistoryRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	DaoId  string                 `protobuf:"bytes,1,opt,name=dao_id,json=daoId,proto3" json:"dao_id,omitempty"`
+	Limit  *uint64                `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// fields filters records which changed any of the fields, e.g. voting.quorum or strategies
+	Fields        []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_storagepb_dao_proto_rawDesc = "" +
-	"\n" +
-	"\x13storagepb/dao.proto\x12\tstoragepb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14storagepb/base.proto\"'\n" +
-	"\x0eDaoByIDRequest\x12\x15\n" +
-	"\x06dao_id\x18\x01 \x01(\tR\x05daoId\"\xcf\x01\n" +
-	"\x06Voting\x12\x14\n" +
-	"\x05delay\x18\x01 \x01(\x04R\x05delay\x12\x16\n" +
-	"\x06period\x18\x02 \x01(\x04R\x06period\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
-	"\x06quorum\x18\x04 \x01(\x02R\x06quorum\x12\x14\n" +
-	"\x05blind\x18\x05 \x01(\bR\x05blind\x12!\n" +
-	"\fhide_abstain\x18\x06 \x01(\bR\vhideAbstain\x12\x18\n" +
-	"\aprivacy\x18\a \x01(\tR\aprivacy\x12\x18\n" +
-	"\aaliased\x18\b \x01(\bR\aaliased\"R\n" +
-	"\bTreasury\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x18\n" +
-	"\anetwork\x18\x03 \x01(\tR\anetwork\"\xba\t\n" +
-	"\aDaoInfo\x12\x0e\n" +
+func (x *DaoHistoryRequest) Reset() {
+	*x = DaoHistoryRequest{}
+	mi := &file_storagepb_dao_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoHistoryRequest) ProtoMessage() {}
+
+func (x *DaoHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoHistoryRequest.ProtoReflect.Descriptor instead.
+func (*DaoHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DaoHistoryRequest) GetDaoId() string {
+	if x != nil {
+		return x.DaoId
+	}
+	return ""
+}
+
+func (x *DaoHistoryRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *DaoHistoryRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *DaoHistoryRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type DaoFieldChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Field string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// old_value and new_value are json encoded values
+	OldValue      string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoFieldChange) Reset() {
+	*x = DaoFieldChange{}
+	mi := &file_storagepb_dao_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoFieldChange) ProtoMessage() {}
+
+func (x *DaoFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoFieldChange.ProtoReflect.Descriptor instead.
+func (*DaoFieldChange) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DaoFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *DaoFieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *DaoFieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type DaoHistoryItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Changes       []*DaoFieldChange      `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoHistoryItem) Reset() {
+	*x = DaoHistoryItem{}
+	mi := &file_storagepb_dao_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoHistoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoHistoryItem) ProtoMessage() {}
+
+func (x *DaoHistoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoHistoryItem.ProtoReflect.Descriptor instead.
+func (*DaoHistoryItem) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DaoHistoryItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DaoHistoryItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DaoHistoryItem) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DaoHistoryItem) GetChanges() []*DaoFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type DaoHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*DaoHistoryItem      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoHistoryResponse) Reset() {
+	*x = DaoHistoryResponse{}
+	mi := &file_storagepb_dao_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoHistoryResponse) ProtoMessage() {}
+
+func (x *DaoHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoHistoryResponse.ProtoReflect.Descriptor instead.
+func (*DaoHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DaoHistoryResponse) GetItems() []*DaoHistoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *DaoHistoryResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type DaoChildrenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dao_id is the internal or original identifier of the parent dao
+	DaoId         string  `protobuf:"bytes,1,opt,name=dao_id,json=daoId,proto3" json:"dao_id,omitempty"`
+	Limit         *uint64 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor        *string `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoChildrenRequest) Reset() {
+	*x = DaoChildrenRequest{}
+	mi := &file_storagepb_dao_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoChildrenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoChildrenRequest) ProtoMessage() {}
+
+func (x *DaoChildrenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoChildrenRequest.ProtoReflect.Descriptor instead.
+func (*DaoChildrenRequest) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DaoChildrenRequest) GetDaoId() string {
+	if x != nil {
+		return x.DaoId
+	}
+	return ""
+}
+
+func (x *DaoChildrenRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *DaoChildrenRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type DaoChildrenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Daos  []*DaoInfo             `protobuf:"bytes,1,rep,name=daos,proto3" json:"daos,omitempty"`
+	// next_cursor is empty for the last page
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoChildrenResponse) Reset() {
+	*x = DaoChildrenResponse{}
+	mi := &file_storagepb_dao_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoChildrenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoChildrenResponse) ProtoMessage() {}
+
+func (x *DaoChildrenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoChildrenResponse.ProtoReflect.Descriptor instead.
+func (*DaoChildrenResponse) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DaoChildrenResponse) GetDaos() []*DaoInfo {
+	if x != nil {
+		return x.Daos
+	}
+	return nil
+}
+
+func (x *DaoChildrenResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type DaoTreasuryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dao_id is the internal or original identifier of the dao
+	DaoId string `protobuf:"bytes,1,opt,name=dao_id,json=daoId,proto3" json:"dao_id,omitempty"`
+	// days of the value series, 30 by default
+	Days          *uint64 `protobuf:"varint,2,opt,name=days,proto3,oneof" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoTreasuryRequest) Reset() {
+	*x = DaoTreasuryRequest{}
+	mi := &file_storagepb_dao_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoTreasuryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoTreasuryRequest) ProtoMessage() {}
+
+func (x *DaoTreasuryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoTreasuryRequest.ProtoReflect.Descriptor instead.
+func (*DaoTreasuryRequest) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DaoTreasuryRequest) GetDaoId() string {
+	if x != nil {
+		return x.DaoId
+	}
+	return ""
+}
+
+func (x *DaoTreasuryRequest) GetDays() uint64 {
+	if x != nil && x.Days != nil {
+		return *x.Days
+	}
+	return 0
+}
+
+type TreasuryPosition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FungibleId    string                 `protobuf:"bytes,1,opt,name=fungible_id,json=fungibleId,proto3" json:"fungible_id,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol        string                 `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	IconUrl       string                 `protobuf:"bytes,5,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         float64                `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`
+	Value         float64                `protobuf:"fixed64,8,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TreasuryPosition) Reset() {
+	*x = TreasuryPosition{}
+	mi := &file_storagepb_dao_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreasuryPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreasuryPosition) ProtoMessage() {}
+
+func (x *TreasuryPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreasuryPosition.ProtoReflect.Descriptor instead.
+func (*TreasuryPosition) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TreasuryPosition) GetFungibleId() string {
+	if x != nil {
+		return x.FungibleId
+	}
+	return ""
+}
+
+func (x *TreasuryPosition) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *TreasuryPosition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TreasuryPosition) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TreasuryPosition) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *TreasuryPosition) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TreasuryPosition) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *TreasuryPosition) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type TreasuryWallet struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Network string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Value   float64                `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	// updated_at is empty if the wallet has no snapshots yet
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Positions     []*TreasuryPosition    `protobuf:"bytes,6,rep,name=positions,proto3" json:"positions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TreasuryWallet) Reset() {
+	*x = TreasuryWallet{}
+	mi := &file_storagepb_dao_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreasuryWallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreasuryWallet) ProtoMessage() {}
+
+func (x *TreasuryWallet) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreasuryWallet.ProtoReflect.Descriptor instead.
+func (*TreasuryWallet) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TreasuryWallet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TreasuryWallet) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TreasuryWallet) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *TreasuryWallet) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *TreasuryWallet) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TreasuryWallet) GetPositions() []*TreasuryPosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type TreasuryValuePoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TreasuryValuePoint) Reset() {
+	*x = TreasuryValuePoint{}
+	mi := &file_storagepb_dao_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreasuryValuePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreasuryValuePoint) ProtoMessage() {}
+
+func (x *TreasuryValuePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreasuryValuePoint.ProtoReflect.Descriptor instead.
+func (*TreasuryValuePoint) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TreasuryValuePoint) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *TreasuryValuePoint) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type DaoTreasuryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// total_value is the usd value of all wallets by their latest snapshots
+	TotalValue float64           `protobuf:"fixed64,1,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	Wallets    []*TreasuryWallet `protobuf:"bytes,2,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	// series contains the daily usd value of wallets
+	Series        []*TreasuryValuePoint `protobuf:"bytes,3,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoTreasuryResponse) Reset() {
+	*x = DaoTreasuryResponse{}
+	mi := &file_storagepb_dao_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoTreasuryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoTreasuryResponse) ProtoMessage() {}
+
+func (x *DaoTreasuryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoTreasuryResponse.ProtoReflect.Descriptor instead.
+func (*DaoTreasuryResponse) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DaoTreasuryResponse) GetTotalValue() float64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *DaoTreasuryResponse) GetWallets() []*TreasuryWallet {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+func (x *DaoTreasuryResponse) GetSeries() []*TreasuryValuePoint {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type PendingVerificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         *uint64                `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,2,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingVerificationsRequest) Reset() {
+	*x = PendingVerificationsRequest{}
+	mi := &file_storagepb_dao_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingVerificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingVerificationsRequest) ProtoMessage() {}
+
+func (x *PendingVerificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingVerificationsRequest.ProtoReflect.Descriptor instead.
+func (*PendingVerificationsRequest) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PendingVerificationsRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *PendingVerificationsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type PendingVerificationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Daos  []*DaoInfo             `protobuf:"bytes,1,rep,name=daos,proto3" json:"daos,omitempty"`
+	// next_cursor is empty for the last page
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingVerificationsResponse) Reset() {
+	*x = PendingVerificationsResponse{}
+	mi := &file_storagepb_dao_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingVerificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingVerificationsResponse) ProtoMessage() {}
+
+func (x *PendingVerificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingVerificationsResponse.ProtoReflect.Descriptor instead.
+func (*PendingVerificationsResponse) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PendingVerificationsResponse) GetDaos() []*DaoInfo {
+	if x != nil {
+		return x.Daos
+	}
+	return nil
+}
+
+func (x *PendingVerificationsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type DecideVerificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dao_id is the internal or original identifier of the dao
+	DaoId   string                     `protobuf:"bytes,1,opt,name=dao_id,json=daoId,proto3" json:"dao_id,omitempty"`
+	Status  VerificationDecisionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=storagepb.VerificationDecisionStatus" json:"status,omitempty"`
+	Comment string                     `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	// moderator is the identity of the person who made the decision
+	Moderator string `protobuf:"bytes,4,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	// clear_fungible_id removes the fungible id of the declined dao, it's kept by default
+	ClearFungibleId bool `protobuf:"varint,5,opt,name=clear_fungible_id,json=clearFungibleId,proto3" json:"clear_fungible_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DecideVerificationRequest) Reset() {
+	*x = DecideVerificationRequest{}
+	mi := &file_storagepb_dao_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideVerificationRequest) ProtoMessage() {}
+
+func (x *DecideVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideVerificationRequest.ProtoReflect.Descriptor instead.
+func (*DecideVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DecideVerificationRequest) GetDaoId() string {
+	if x != nil {
+		return x.DaoId
+	}
+	return ""
+}
+
+func (x *DecideVerificationRequest) GetStatus() VerificationDecisionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VerificationDecisionStatus_VERIFICATION_DECISION_STATUS_UNSPECIFIED
+}
+
+func (x *DecideVerificationRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *DecideVerificationRequest) GetModerator() string {
+	if x != nil {
+		return x.Moderator
+	}
+	return ""
+}
+
+func (x *DecideVerificationRequest) GetClearFungibleId() bool {
+	if x != nil {
+		return x.ClearFungibleId
+	}
+	return false
+}
+
+type DecideVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dao           *DaoInfo               `protobuf:"bytes,1,opt,name=dao,proto3" json:"dao,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideVerificationResponse) Reset() {
+	*x = DecideVerificationResponse{}
+	mi := &file_storagepb_dao_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideVerificationResponse) ProtoMessage() {}
+
+func (x *DecideVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideVerificationResponse.ProtoReflect.Descriptor instead.
+func (*DecideVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DecideVerificationResponse) GetDao() *DaoInfo {
+	if x != nil {
+		return x.Dao
+	}
+	return nil
+}
+
+type VerificationDecisionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dao_id is the internal or original identifier of the dao
+	DaoId         string  `protobuf:"bytes,1,opt,name=dao_id,json=daoId,proto3" json:"dao_id,omitempty"`
+	Limit         *uint64 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor        *string `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerificationDecisionsRequest) Reset() {
+	*x = VerificationDecisionsRequest{}
+	mi := &file_storagepb_dao_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerificationDecisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerificationDecisionsRequest) ProtoMessage() {}
+
+func (x *VerificationDecisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerificationDecisionsRequest.ProtoReflect.Descriptor instead.
+func (*VerificationDecisionsRequest) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *VerificationDecisionsRequest) GetDaoId() string {
+	if x != nil {
+		return x.DaoId
+	}
+	return ""
+}
+
+func (x *VerificationDecisionsRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *VerificationDecisionsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type VerificationDecisionItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Moderator string                 `protobuf:"bytes,3,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	// client is the authenticated api client which submitted the decision
+	Client            string `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	Status            string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	PreviousStatus    string `protobuf:"bytes,6,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	Comment           string `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	FungibleId        string `protobuf:"bytes,8,opt,name=fungible_id,json=fungibleId,proto3" json:"fungible_id,omitempty"`
+	FungibleIdCleared bool   `protobuf:"varint,9,opt,name=fungible_id_cleared,json=fungibleIdCleared,proto3" json:"fungible_id_cleared,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *VerificationDecisionItem) Reset() {
+	*x = VerificationDecisionItem{}
+	mi := &file_storagepb_dao_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerificationDecisionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerificationDecisionItem) ProtoMessage() {}
+
+func (x *VerificationDecisionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerificationDecisionItem.ProtoReflect.Descriptor instead.
+func (*VerificationDecisionItem) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *VerificationDecisionItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VerificationDecisionItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *VerificationDecisionItem) GetModerator() string {
+	if x != nil {
+		return x.Moderator
+	}
+	return ""
+}
+
+func (x *VerificationDecisionItem) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *VerificationDecisionItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VerificationDecisionItem) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
+}
+
+func (x *VerificationDecisionItem) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *VerificationDecisionItem) GetFungibleId() string {
+	if x != nil {
+		return x.FungibleId
+	}
+	return ""
+}
+
+func (x *VerificationDecisionItem) GetFungibleIdCleared() bool {
+	if x != nil {
+		return x.FungibleIdCleared
+	}
+	return false
+}
+
+type VerificationDecisionsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Items         []*VerificationDecisionItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                      `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerificationDecisionsResponse) Reset() {
+	*x = VerificationDecisionsResponse{}
+	mi := &file_storagepb_dao_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerificationDecisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerificationDecisionsResponse) ProtoMessage() {}
+
+func (x *VerificationDecisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerificationDecisionsResponse.ProtoReflect.Descriptor instead.
+func (*VerificationDecisionsResponse) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *VerificationDecisionsResponse) GetItems() []*VerificationDecisionItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *VerificationDecisionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type DaoSimilarRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dao_id is the internal or original identifier of the dao
+	DaoId         string  `protobuf:"bytes,1,opt,name=dao_id,json=daoId,proto3" json:"dao_id,omitempty"`
+	Limit         *uint64 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoSimilarRequest) Reset() {
+	*x = DaoSimilarRequest{}
+	mi := &file_storagepb_dao_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoSimilarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoSimilarRequest) ProtoMessage() {}
+
+func (x *DaoSimilarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoSimilarRequest.ProtoReflect.Descriptor instead.
+func (*DaoSimilarRequest) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DaoSimilarRequest) GetDaoId() string {
+	if x != nil {
+		return x.DaoId
+	}
+	return ""
+}
+
+func (x *DaoSimilarRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type SimilarDao struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Dao   *DaoInfo               `protobuf:"bytes,1,opt,name=dao,proto3" json:"dao,omitempty"`
+	// score is the Jaccard index of voter sets of both daos
+	Score         float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	SharedVoters  uint64  `protobuf:"varint,3,opt,name=shared_voters,json=sharedVoters,proto3" json:"shared_voters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarDao) Reset() {
+	*x = SimilarDao{}
+	mi := &file_storagepb_dao_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarDao) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarDao) ProtoMessage() {}
+
+func (x *SimilarDao) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarDao.ProtoReflect.Descriptor instead.
+func (*SimilarDao) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SimilarDao) GetDao() *DaoInfo {
+	if x != nil {
+		return x.Dao
+	}
+	return nil
+}
+
+func (x *SimilarDao) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SimilarDao) GetSharedVoters() uint64 {
+	if x != nil {
+		return x.SharedVoters
+	}
+	return 0
+}
+
+type DaoSimilarResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// list is ordered by the score, it's recalculated daily
+	List          []*SimilarDao `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoSimilarResponse) Reset() {
+	*x = DaoSimilarResponse{}
+	mi := &file_storagepb_dao_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoSimilarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoSimilarResponse) ProtoMessage() {}
+
+func (x *DaoSimilarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoSimilarResponse.ProtoReflect.Descriptor instead.
+func (*DaoSimilarResponse) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DaoSimilarResponse) GetList() []*SimilarDao {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type DaoActivityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dao_id is the internal or original identifier of the dao
+	DaoId string `protobuf:"bytes,1,opt,name=dao_id,json=daoId,proto3" json:"dao_id,omitempty"`
+	// from is 30 days before to by default, the series is started from the period containing it
+	From *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	// to is now by default
+	To *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	// granularity is daily by default, weeks are started on Monday, all periods are in UTC
+	Granularity   ActivityGranularity `protobuf:"varint,4,opt,name=granularity,proto3,enum=storagepb.ActivityGranularity" json:"granularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoActivityRequest) Reset() {
+	*x = DaoActivityRequest{}
+	mi := &file_storagepb_dao_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoActivityRequest) ProtoMessage() {}
+
+func (x *DaoActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoActivityRequest.ProtoReflect.Descriptor instead.
+func (*DaoActivityRequest) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DaoActivityRequest) GetDaoId() string {
+	if x != nil {
+		return x.DaoId
+	}
+	return ""
+}
+
+func (x *DaoActivityRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DaoActivityRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *DaoActivityRequest) GetGranularity() ActivityGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return ActivityGranularity_ACTIVITY_GRANULARITY_UNSPECIFIED
+}
+
+type ActivityPoint struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Time      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Proposals uint64                 `protobuf:"varint,2,opt,name=proposals,proto3" json:"proposals,omitempty"`
+	Votes     uint64                 `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`
+	// voters is the number of unique voters in the period
+	Voters uint64 `protobuf:"varint,4,opt,name=voters,proto3" json:"voters,omitempty"`
+	// new_voters is the number of voters who voted in the dao for the first time
+	NewVoters     uint64  `protobuf:"varint,5,opt,name=new_voters,json=newVoters,proto3" json:"new_voters,omitempty"`
+	Vp            float64 `protobuf:"fixed64,6,opt,name=vp,proto3" json:"vp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityPoint) Reset() {
+	*x = ActivityPoint{}
+	mi := &file_storagepb_dao_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityPoint) ProtoMessage() {}
+
+func (x *ActivityPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityPoint.ProtoReflect.Descriptor instead.
+func (*ActivityPoint) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ActivityPoint) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ActivityPoint) GetProposals() uint64 {
+	if x != nil {
+		return x.Proposals
+	}
+	return 0
+}
+
+func (x *ActivityPoint) GetVotes() uint64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *ActivityPoint) GetVoters() uint64 {
+	if x != nil {
+		return x.Voters
+	}
+	return 0
+}
+
+func (x *ActivityPoint) GetNewVoters() uint64 {
+	if x != nil {
+		return x.NewVoters
+	}
+	return 0
+}
+
+func (x *ActivityPoint) GetVp() float64 {
+	if x != nil {
+		return x.Vp
+	}
+	return 0
+}
+
+type DaoActivityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// points are returned for every period including periods without activity
+	Points        []*ActivityPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoActivityResponse) Reset() {
+	*x = DaoActivityResponse{}
+	mi := &file_storagepb_dao_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoActivityResponse) ProtoMessage() {}
+
+func (x *DaoActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoActivityResponse.ProtoReflect.Descriptor instead.
+func (*DaoActivityResponse) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DaoActivityResponse) GetPoints() []*ActivityPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type DaoIdentity struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// original_id is the source-qualified id, e.g. governor:1:0xabc, snapshot spaces are without the source prefix
+	OriginalId string `protobuf:"bytes,1,opt,name=original_id,json=originalId,proto3" json:"original_id,omitempty"`
+	Source     string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// primary is the identity which the dao data is received from
+	Primary       bool `protobuf:"varint,4,opt,name=primary,proto3" json:"primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoIdentity) Reset() {
+	*x = DaoIdentity{}
+	mi := &file_storagepb_dao_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoIdentity) ProtoMessage() {}
+
+func (x *DaoIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoIdentity.ProtoReflect.Descriptor instead.
+func (*DaoIdentity) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DaoIdentity) GetOriginalId() string {
+	if x != nil {
+		return x.OriginalId
+	}
+	return ""
+}
+
+func (x *DaoIdentity) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DaoIdentity) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *DaoIdentity) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+type DaoIdentitiesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dao_id is the internal id or any source-qualified id of the dao
+	DaoId         string `protobuf:"bytes,1,opt,name=dao_id,json=daoId,proto3" json:"dao_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoIdentitiesRequest) Reset() {
+	*x = DaoIdentitiesRequest{}
+	mi := &file_storagepb_dao_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoIdentitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoIdentitiesRequest) ProtoMessage() {}
+
+func (x *DaoIdentitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoIdentitiesRequest.ProtoReflect.Descriptor instead.
+func (*DaoIdentitiesRequest) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DaoIdentitiesRequest) GetDaoId() string {
+	if x != nil {
+		return x.DaoId
+	}
+	return ""
+}
+
+type DaoIdentitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*DaoIdentity         `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoIdentitiesResponse) Reset() {
+	*x = DaoIdentitiesResponse{}
+	mi := &file_storagepb_dao_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoIdentitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoIdentitiesResponse) ProtoMessage() {}
+
+func (x *DaoIdentitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoIdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*DaoIdentitiesResponse) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DaoIdentitiesResponse) GetList() []*DaoIdentity {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type LinkIdentityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dao_id is the internal id or any source-qualified id of the dao
+	DaoId string `protobuf:"bytes,1,opt,name=dao_id,json=daoId,proto3" json:"dao_id,omitempty"`
+	// identity is the source-qualified id which is linked to the dao
+	Identity      string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIdentityRequest) Reset() {
+	*x = LinkIdentityRequest{}
+	mi := &file_storagepb_dao_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityRequest) ProtoMessage() {}
+
+func (x *LinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*LinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *LinkIdentityRequest) GetDaoId() string {
+	if x != nil {
+		return x.DaoId
+	}
+	return ""
+}
+
+func (x *LinkIdentityRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+type DaoByTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// address is the token address in strategies, it's case insensitive
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// network is the chain id, all chains are used if it's empty
+	Network       *string `protobuf:"bytes,2,opt,name=network,proto3,oneof" json:"network,omitempty"`
+	Limit         *uint64 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor        *string `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoByTokenRequest) Reset() {
+	*x = DaoByTokenRequest{}
+	mi := &file_storagepb_dao_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoByTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoByTokenRequest) ProtoMessage() {}
+
+func (x *DaoByTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoByTokenRequest.ProtoReflect.Descriptor instead.
+func (*DaoByTokenRequest) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DaoByTokenRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DaoByTokenRequest) GetNetwork() string {
+	if x != nil && x.Network != nil {
+		return *x.Network
+	}
+	return ""
+}
+
+func (x *DaoByTokenRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *DaoByTokenRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type DaoByTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// daos are ordered by the popularity index
+	Daos []*DaoInfo `protobuf:"bytes,1,rep,name=daos,proto3" json:"daos,omitempty"`
+	// next_cursor is empty for the last page
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoByTokenResponse) Reset() {
+	*x = DaoByTokenResponse{}
+	mi := &file_storagepb_dao_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoByTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoByTokenResponse) ProtoMessage() {}
+
+func (x *DaoByTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoByTokenResponse.ProtoReflect.Descriptor instead.
+func (*DaoByTokenResponse) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DaoByTokenResponse) GetDaos() []*DaoInfo {
+	if x != nil {
+		return x.Daos
+	}
+	return nil
+}
+
+func (x *DaoByTokenResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type DaoByStrategyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the strategy name, multichain sub-strategies are taken into account
+	Name          string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Limit         *uint64 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor        *string `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoByStrategyRequest) Reset() {
+	*x = DaoByStrategyRequest{}
+	mi := &file_storagepb_dao_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoByStrategyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoByStrategyRequest) ProtoMessage() {}
+
+func (x *DaoByStrategyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoByStrategyRequest.ProtoReflect.Descriptor instead.
+func (*DaoByStrategyRequest) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DaoByStrategyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DaoByStrategyRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *DaoByStrategyRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type DaoByStrategyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// daos are ordered by the popularity index
+	Daos []*DaoInfo `protobuf:"bytes,1,rep,name=daos,proto3" json:"daos,omitempty"`
+	// next_cursor is empty for the last page
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaoByStrategyResponse) Reset() {
+	*x = DaoByStrategyResponse{}
+	mi := &file_storagepb_dao_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaoByStrategyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaoByStrategyResponse) ProtoMessage() {}
+
+func (x *DaoByStrategyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_dao_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaoByStrategyResponse.ProtoReflect.Descriptor instead.
+func (*DaoByStrategyResponse) Descriptor() ([]byte, []int) {
+	return file_storagepb_dao_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DaoByStrategyResponse) GetDaos() []*DaoInfo {
+	if x != nil {
+		return x.Daos
+	}
+	return nil
+}
+
+func (x *DaoByStrategyResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+var File_storagepb_dao_proto protoreflect.FileDescriptor
+
+const file_storagepb_dao_proto_rawDesc = "" +
+	"\n" +
+	"\x13storagepb/dao.proto\x12\tstoragepb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14storagepb/base.proto\"'\n" +
+	"\x0eDaoByIDRequest\x12\x15\n" +
+	"\x06dao_id\x18\x01 \x01(\tR\x05daoId\"\xcf\x01\n" +
+	"\x06Voting\x12\x14\n" +
+	"\x05delay\x18\x01 \x01(\x04R\x05delay\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\x04R\x06period\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06quorum\x18\x04 \x01(\x02R\x06quorum\x12\x14\n" +
+	"\x05blind\x18\x05 \x01(\bR\x05blind\x12!\n" +
+	"\fhide_abstain\x18\x06 \x01(\bR\vhideAbstain\x12\x18\n" +
+	"\aprivacy\x18\a \x01(\tR\aprivacy\x12\x18\n" +
+	"\aaliased\x18\b \x01(\bR\aaliased\"R\n" +
+	"\bTreasury\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\"\xd2\t\n" +
+	"\aDaoInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\n" +
 	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
@@ -1688,24 +3965,33 @@ const file_storagepb_dao_proto_rawDesc = "" +
 	"tokenExist\x12!\n" +
 	"\ftoken_symbol\x18$ \x01(\tR\vtokenSymbol\x12\x1f\n" +
 	"\vfungible_id\x18% \x01(\tR\n" +
-	"fungibleId\"7\n" +
+	"fungibleId\x12\x16\n" +
+	"\x06source\x18& \x01(\tR\x06source\"7\n" +
 	"\x0fDaoByIDResponse\x12$\n" +
-	"\x03dao\x18\x01 \x01(\v2\x12.storagepb.DaoInfoR\x03dao\"\xf0\x01\n" +
+	"\x03dao\x18\x01 \x01(\v2\x12.storagepb.DaoInfoR\x03dao\"\x9e\x03\n" +
 	"\x12DaoByFilterRequest\x12\x19\n" +
 	"\x05query\x18\x01 \x01(\tH\x00R\x05query\x88\x01\x01\x12\x1f\n" +
 	"\bcategory\x18\x02 \x01(\tH\x01R\bcategory\x88\x01\x01\x12\x19\n" +
 	"\x05limit\x18\x03 \x01(\x04H\x02R\x05limit\x88\x01\x01\x12\x1b\n" +
 	"\x06offset\x18\x04 \x01(\x04H\x03R\x06offset\x88\x01\x01\x12\x17\n" +
 	"\adao_ids\x18\x05 \x03(\tR\x06daoIds\x12!\n" +
-	"\ffungible_ids\x18\x06 \x03(\tR\vfungibleIdsB\b\n" +
+	"\ffungible_ids\x18\x06 \x03(\tR\vfungibleIds\x12\x1b\n" +
+	"\x06cursor\x18\a \x01(\tH\x04R\x06cursor\x88\x01\x01\x12-\n" +
+	"\x10skip_total_count\x18\b \x01(\bH\x05R\x0eskipTotalCount\x88\x01\x01\x12,\n" +
+	"\x0frollup_children\x18\t \x01(\bH\x06R\x0erollupChildren\x88\x01\x01B\b\n" +
 	"\x06_queryB\v\n" +
 	"\t_categoryB\b\n" +
 	"\x06_limitB\t\n" +
-	"\a_offset\"^\n" +
+	"\a_offsetB\t\n" +
+	"\a_cursorB\x13\n" +
+	"\x11_skip_total_countB\x12\n" +
+	"\x10_rollup_children\"\x7f\n" +
 	"\x13DaoByFilterResponse\x12&\n" +
 	"\x04daos\x18\x01 \x03(\v2\x12.storagepb.DaoInfoR\x04daos\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x04R\n" +
-	"totalCount\"r\n" +
+	"totalCount\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"r\n" +
 	"\vTopCategory\x12\x1a\n" +
 	"\bcategory\x18\x01 \x01(\tR\bcategory\x12&\n" +
 	"\x04daos\x18\x02 \x03(\v2\x12.storagepb.DaoInfoR\x04daos\x12\x1f\n" +
@@ -1729,7 +4015,16 @@ const file_storagepb_dao_proto_rawDesc = "" +
 	"network_id\x18\x05 \x01(\tR\tnetworkId\x12\x18\n" +
 	"\aaddress\x18\x06 \x01(\tR\aaddress\"Y\n" +
 	"\x1eGetRecommendationsListResponse\x127\n" +
-	"\x04list\x18\x01 \x03(\v2#.storagepb.DaoRecommendationDetailsR\x04list\")\n" +
+	"\x04list\x18\x01 \x03(\v2#.storagepb.DaoRecommendationDetailsR\x04list\"a\n" +
+	" RecommendationsForAddressRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x04H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"z\n" +
+	"\x15AddressRecommendation\x12$\n" +
+	"\x03dao\x18\x01 \x01(\v2\x12.storagepb.DaoInfoR\x03dao\x12;\n" +
+	"\x06tokens\x18\x02 \x03(\v2#.storagepb.DaoRecommendationDetailsR\x06tokens\"Y\n" +
+	"!RecommendationsForAddressResponse\x124\n" +
+	"\x04list\x18\x01 \x03(\v2 .storagepb.AddressRecommendationR\x04list\")\n" +
 	"\x10TokenInfoRequest\x12\x15\n" +
 	"\x06dao_id\x18\x01 \x01(\tR\x05daoId\"\xd2\x02\n" +
 	"\x11TokenInfoResponse\x12\x12\n" +
@@ -1767,16 +4062,206 @@ const file_storagepb_dao_proto_rawDesc = "" +
 	"\x18UpdateFungibleIdsRequest\x12\x1a\n" +
 	"\bcategory\x18\x01 \x01(\tR\bcategory\"3\n" +
 	"\x19UpdateFungibleIdsResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\bR\x06status2\xb0\x05\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\"\x8f\x01\n" +
+	"\x11DaoHistoryRequest\x12\x15\n" +
+	"\x06dao_id\x18\x01 \x01(\tR\x05daoId\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x04H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x01R\x06cursor\x88\x01\x01\x12\x16\n" +
+	"\x06fields\x18\x04 \x03(\tR\x06fieldsB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursor\"`\n" +
+	"\x0eDaoFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"\xa8\x01\n" +
+	"\x0eDaoHistoryItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x123\n" +
+	"\achanges\x18\x04 \x03(\v2\x19.storagepb.DaoFieldChangeR\achanges\"f\n" +
+	"\x12DaoHistoryResponse\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.storagepb.DaoHistoryItemR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"x\n" +
+	"\x12DaoChildrenRequest\x12\x15\n" +
+	"\x06dao_id\x18\x01 \x01(\tR\x05daoId\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x04H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x01R\x06cursor\x88\x01\x01B\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursor\"^\n" +
+	"\x13DaoChildrenResponse\x12&\n" +
+	"\x04daos\x18\x01 \x03(\v2\x12.storagepb.DaoInfoR\x04daos\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"M\n" +
+	"\x12DaoTreasuryRequest\x12\x15\n" +
+	"\x06dao_id\x18\x01 \x01(\tR\x05daoId\x12\x17\n" +
+	"\x04days\x18\x02 \x01(\x04H\x00R\x04days\x88\x01\x01B\a\n" +
+	"\x05_days\"\xd8\x01\n" +
+	"\x10TreasuryPosition\x12\x1f\n" +
+	"\vfungible_id\x18\x01 \x01(\tR\n" +
+	"fungibleId\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06symbol\x18\x04 \x01(\tR\x06symbol\x12\x19\n" +
+	"\bicon_url\x18\x05 \x01(\tR\aiconUrl\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x01R\bquantity\x12\x14\n" +
+	"\x05price\x18\a \x01(\x01R\x05price\x12\x14\n" +
+	"\x05value\x18\b \x01(\x01R\x05value\"\xe4\x01\n" +
+	"\x0eTreasuryWallet\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x01R\x05value\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\tpositions\x18\x06 \x03(\v2\x1b.storagepb.TreasuryPositionR\tpositions\"Z\n" +
+	"\x12TreasuryValuePoint\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"\xa2\x01\n" +
+	"\x13DaoTreasuryResponse\x12\x1f\n" +
+	"\vtotal_value\x18\x01 \x01(\x01R\n" +
+	"totalValue\x123\n" +
+	"\awallets\x18\x02 \x03(\v2\x19.storagepb.TreasuryWalletR\awallets\x125\n" +
+	"\x06series\x18\x03 \x03(\v2\x1d.storagepb.TreasuryValuePointR\x06series\"j\n" +
+	"\x1bPendingVerificationsRequest\x12\x19\n" +
+	"\x05limit\x18\x01 \x01(\x04H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x02 \x01(\tH\x01R\x06cursor\x88\x01\x01B\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursor\"g\n" +
+	"\x1cPendingVerificationsResponse\x12&\n" +
+	"\x04daos\x18\x01 \x03(\v2\x12.storagepb.DaoInfoR\x04daos\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xd5\x01\n" +
+	"\x19DecideVerificationRequest\x12\x15\n" +
+	"\x06dao_id\x18\x01 \x01(\tR\x05daoId\x12=\n" +
+	"\x06status\x18\x02 \x01(\x0e2%.storagepb.VerificationDecisionStatusR\x06status\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12\x1c\n" +
+	"\tmoderator\x18\x04 \x01(\tR\tmoderator\x12*\n" +
+	"\x11clear_fungible_id\x18\x05 \x01(\bR\x0fclearFungibleId\"B\n" +
+	"\x1aDecideVerificationResponse\x12$\n" +
+	"\x03dao\x18\x01 \x01(\v2\x12.storagepb.DaoInfoR\x03dao\"\x82\x01\n" +
+	"\x1cVerificationDecisionsRequest\x12\x15\n" +
+	"\x06dao_id\x18\x01 \x01(\tR\x05daoId\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x04H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x01R\x06cursor\x88\x01\x01B\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursor\"\xc7\x02\n" +
+	"\x18VerificationDecisionItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1c\n" +
+	"\tmoderator\x18\x03 \x01(\tR\tmoderator\x12\x16\n" +
+	"\x06client\x18\x04 \x01(\tR\x06client\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12'\n" +
+	"\x0fprevious_status\x18\x06 \x01(\tR\x0epreviousStatus\x12\x18\n" +
+	"\acomment\x18\a \x01(\tR\acomment\x12\x1f\n" +
+	"\vfungible_id\x18\b \x01(\tR\n" +
+	"fungibleId\x12.\n" +
+	"\x13fungible_id_cleared\x18\t \x01(\bR\x11fungibleIdCleared\"{\n" +
+	"\x1dVerificationDecisionsResponse\x129\n" +
+	"\x05items\x18\x01 \x03(\v2#.storagepb.VerificationDecisionItemR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"O\n" +
+	"\x11DaoSimilarRequest\x12\x15\n" +
+	"\x06dao_id\x18\x01 \x01(\tR\x05daoId\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x04H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"m\n" +
+	"\n" +
+	"SimilarDao\x12$\n" +
+	"\x03dao\x18\x01 \x01(\v2\x12.storagepb.DaoInfoR\x03dao\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12#\n" +
+	"\rshared_voters\x18\x03 \x01(\x04R\fsharedVoters\"?\n" +
+	"\x12DaoSimilarResponse\x12)\n" +
+	"\x04list\x18\x01 \x03(\v2\x15.storagepb.SimilarDaoR\x04list\"\xe3\x01\n" +
+	"\x12DaoActivityRequest\x12\x15\n" +
+	"\x06dao_id\x18\x01 \x01(\tR\x05daoId\x123\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x04from\x88\x01\x01\x12/\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x02to\x88\x01\x01\x12@\n" +
+	"\vgranularity\x18\x04 \x01(\x0e2\x1e.storagepb.ActivityGranularityR\vgranularityB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"\xba\x01\n" +
+	"\rActivityPoint\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1c\n" +
+	"\tproposals\x18\x02 \x01(\x04R\tproposals\x12\x14\n" +
+	"\x05votes\x18\x03 \x01(\x04R\x05votes\x12\x16\n" +
+	"\x06voters\x18\x04 \x01(\x04R\x06voters\x12\x1d\n" +
+	"\n" +
+	"new_voters\x18\x05 \x01(\x04R\tnewVoters\x12\x0e\n" +
+	"\x02vp\x18\x06 \x01(\x01R\x02vp\"G\n" +
+	"\x13DaoActivityResponse\x120\n" +
+	"\x06points\x18\x01 \x03(\v2\x18.storagepb.ActivityPointR\x06points\"\x81\x01\n" +
+	"\vDaoIdentity\x12\x1f\n" +
+	"\voriginal_id\x18\x01 \x01(\tR\n" +
+	"originalId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x1f\n" +
+	"\vexternal_id\x18\x03 \x01(\tR\n" +
+	"externalId\x12\x18\n" +
+	"\aprimary\x18\x04 \x01(\bR\aprimary\"-\n" +
+	"\x14DaoIdentitiesRequest\x12\x15\n" +
+	"\x06dao_id\x18\x01 \x01(\tR\x05daoId\"C\n" +
+	"\x15DaoIdentitiesResponse\x12*\n" +
+	"\x04list\x18\x01 \x03(\v2\x16.storagepb.DaoIdentityR\x04list\"H\n" +
+	"\x13LinkIdentityRequest\x12\x15\n" +
+	"\x06dao_id\x18\x01 \x01(\tR\x05daoId\x12\x1a\n" +
+	"\bidentity\x18\x02 \x01(\tR\bidentity\"\xa5\x01\n" +
+	"\x11DaoByTokenRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1d\n" +
+	"\anetwork\x18\x02 \x01(\tH\x00R\anetwork\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x03 \x01(\x04H\x01R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x04 \x01(\tH\x02R\x06cursor\x88\x01\x01B\n" +
+	"\n" +
+	"\b_networkB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursor\"]\n" +
+	"\x12DaoByTokenResponse\x12&\n" +
+	"\x04daos\x18\x01 \x03(\v2\x12.storagepb.DaoInfoR\x04daos\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"w\n" +
+	"\x14DaoByStrategyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x04H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x01R\x06cursor\x88\x01\x01B\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursor\"`\n" +
+	"\x15DaoByStrategyResponse\x12&\n" +
+	"\x04daos\x18\x01 \x03(\v2\x12.storagepb.DaoInfoR\x04daos\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor*\xa0\x01\n" +
+	"\x1aVerificationDecisionStatus\x12,\n" +
+	"(VERIFICATION_DECISION_STATUS_UNSPECIFIED\x10\x00\x12)\n" +
+	"%VERIFICATION_DECISION_STATUS_APPROVED\x10\x01\x12)\n" +
+	"%VERIFICATION_DECISION_STATUS_DECLINED\x10\x02*\x98\x01\n" +
+	"\x13ActivityGranularity\x12$\n" +
+	" ACTIVITY_GRANULARITY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18ACTIVITY_GRANULARITY_DAY\x10\x01\x12\x1d\n" +
+	"\x19ACTIVITY_GRANULARITY_WEEK\x10\x02\x12\x1e\n" +
+	"\x1aACTIVITY_GRANULARITY_MONTH\x10\x032\xae\x0e\n" +
 	"\x03Dao\x12@\n" +
 	"\aGetByID\x12\x19.storagepb.DaoByIDRequest\x1a\x1a.storagepb.DaoByIDResponse\x12L\n" +
 	"\vGetByFilter\x12\x1d.storagepb.DaoByFilterRequest\x1a\x1e.storagepb.DaoByFilterResponse\x12[\n" +
 	"\x12GetTopByCategories\x12!.storagepb.TopByCategoriesRequest\x1a\".storagepb.TopByCategoriesResponse\x12m\n" +
-	"\x16GetRecommendationsList\x12(.storagepb.GetRecommendationsListRequest\x1a).storagepb.GetRecommendationsListResponse\x12I\n" +
+	"\x16GetRecommendationsList\x12(.storagepb.GetRecommendationsListRequest\x1a).storagepb.GetRecommendationsListResponse\x12y\n" +
+	"\x1cGetRecommendationsForAddress\x12+.storagepb.RecommendationsForAddressRequest\x1a,.storagepb.RecommendationsForAddressResponse\x12I\n" +
 	"\fGetTokenInfo\x12\x1b.storagepb.TokenInfoRequest\x1a\x1c.storagepb.TokenInfoResponse\x12L\n" +
 	"\rGetTokenChart\x12\x1c.storagepb.TokenChartRequest\x1a\x1d.storagepb.TokenChartResponse\x12T\n" +
 	"\x13PopulateTokenPrices\x12\x1d.storagepb.TokenPricesRequest\x1a\x1e.storagepb.TokenPricesResponse\x12^\n" +
-	"\x11UpdateFungibleIds\x12#.storagepb.UpdateFungibleIdsRequest\x1a$.storagepb.UpdateFungibleIdsResponseB\rZ\v.;storagepbb\x06proto3"
+	"\x11UpdateFungibleIds\x12#.storagepb.UpdateFungibleIdsRequest\x1a$.storagepb.UpdateFungibleIdsResponse\x12I\n" +
+	"\n" +
+	"GetHistory\x12\x1c.storagepb.DaoHistoryRequest\x1a\x1d.storagepb.DaoHistoryResponse\x12L\n" +
+	"\vGetChildren\x12\x1d.storagepb.DaoChildrenRequest\x1a\x1e.storagepb.DaoChildrenResponse\x12L\n" +
+	"\vGetTreasury\x12\x1d.storagepb.DaoTreasuryRequest\x1a\x1e.storagepb.DaoTreasuryResponse\x12j\n" +
+	"\x17GetPendingVerifications\x12&.storagepb.PendingVerificationsRequest\x1a'.storagepb.PendingVerificationsResponse\x12a\n" +
+	"\x12DecideVerification\x12$.storagepb.DecideVerificationRequest\x1a%.storagepb.DecideVerificationResponse\x12m\n" +
+	"\x18GetVerificationDecisions\x12'.storagepb.VerificationDecisionsRequest\x1a(.storagepb.VerificationDecisionsResponse\x12I\n" +
+	"\n" +
+	"GetSimilar\x12\x1c.storagepb.DaoSimilarRequest\x1a\x1d.storagepb.DaoSimilarResponse\x12L\n" +
+	"\vGetActivity\x12\x1d.storagepb.DaoActivityRequest\x1a\x1e.storagepb.DaoActivityResponse\x12R\n" +
+	"\rGetIdentities\x12\x1f.storagepb.DaoIdentitiesRequest\x1a .storagepb.DaoIdentitiesResponse\x12P\n" +
+	"\fLinkIdentity\x12\x1e.storagepb.LinkIdentityRequest\x1a .storagepb.DaoIdentitiesResponse\x12I\n" +
+	"\n" +
+	"GetByToken\x12\x1c.storagepb.DaoByTokenRequest\x1a\x1d.storagepb.DaoByTokenResponse\x12R\n" +
+	"\rGetByStrategy\x12\x1f.storagepb.DaoByStrategyRequest\x1a .storagepb.DaoByStrategyResponseB\rZ\v.;storagepbb\x06proto3"
 
 var (
 	file_storagepb_dao_proto_rawDescOnce sync.Once
@@ -1790,69 +4275,160 @@ func file_storagepb_dao_proto_rawDescGZIP() []byte {
 	return file_storagepb_dao_proto_rawDescData
 }
 
-var file_storagepb_dao_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_storagepb_dao_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_storagepb_dao_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_storagepb_dao_proto_goTypes = []any{
-	(*DaoByIDRequest)(nil),                 // 0: storagepb.DaoByIDRequest
-	(*Voting)(nil),                         // 1: storagepb.Voting
-	(*Treasury)(nil),                       // 2: storagepb.Treasury
-	(*DaoInfo)(nil),                        // 3: storagepb.DaoInfo
-	(*DaoByIDResponse)(nil),                // 4: storagepb.DaoByIDResponse
-	(*DaoByFilterRequest)(nil),             // 5: storagepb.DaoByFilterRequest
-	(*DaoByFilterResponse)(nil),            // 6: storagepb.DaoByFilterResponse
-	(*TopCategory)(nil),                    // 7: storagepb.TopCategory
-	(*TopByCategoriesRequest)(nil),         // 8: storagepb.TopByCategoriesRequest
-	(*TopByCategoriesResponse)(nil),        // 9: storagepb.TopByCategoriesResponse
-	(*GetRecommendationsListRequest)(nil),  // 10: storagepb.GetRecommendationsListRequest
-	(*DaoRecommendationDetails)(nil),       // 11: storagepb.DaoRecommendationDetails
-	(*GetRecommendationsListResponse)(nil), // 12: storagepb.GetRecommendationsListResponse
-	(*TokenInfoRequest)(nil),               // 13: storagepb.TokenInfoRequest
-	(*TokenInfoResponse)(nil),              // 14: storagepb.TokenInfoResponse
-	(*TokenChainInfo)(nil),                 // 15: storagepb.TokenChainInfo
-	(*TokenChartRequest)(nil),              // 16: storagepb.TokenChartRequest
-	(*TokenChartResponse)(nil),             // 17: storagepb.TokenChartResponse
-	(*Point)(nil),                          // 18: storagepb.Point
-	(*TokenPricesRequest)(nil),             // 19: storagepb.TokenPricesRequest
-	(*TokenPricesResponse)(nil),            // 20: storagepb.TokenPricesResponse
-	(*UpdateFungibleIdsRequest)(nil),       // 21: storagepb.UpdateFungibleIdsRequest
-	(*UpdateFungibleIdsResponse)(nil),      // 22: storagepb.UpdateFungibleIdsResponse
-	(*timestamppb.Timestamp)(nil),          // 23: google.protobuf.Timestamp
-	(*Strategy)(nil),                       // 24: storagepb.Strategy
+	(VerificationDecisionStatus)(0),           // 0: storagepb.VerificationDecisionStatus
+	(ActivityGranularity)(0),                  // 1: storagepb.ActivityGranularity
+	(*DaoByIDRequest)(nil),                    // 2: storagepb.DaoByIDRequest
+	(*Voting)(nil),                            // 3: storagepb.Voting
+	(*Treasury)(nil),                          // 4: storagepb.Treasury
+	(*DaoInfo)(nil),                           // 5: storagepb.DaoInfo
+	(*DaoByIDResponse)(nil),                   // 6: storagepb.DaoByIDResponse
+	(*DaoByFilterRequest)(nil),                // 7: storagepb.DaoByFilterRequest
+	(*DaoByFilterResponse)(nil),               // 8: storagepb.DaoByFilterResponse
+	(*TopCategory)(nil),                       // 9: storagepb.TopCategory
+	(*TopByCategoriesRequest)(nil),            // 10: storagepb.TopByCategoriesRequest
+	(*TopByCategoriesResponse)(nil),           // 11: storagepb.TopByCategoriesResponse
+	(*GetRecommendationsListRequest)(nil),     // 12: storagepb.GetRecommendationsListRequest
+	(*DaoRecommendationDetails)(nil),          // 13: storagepb.DaoRecommendationDetails
+	(*GetRecommendationsListResponse)(nil),    // 14: storagepb.GetRecommendationsListResponse
+	(*RecommendationsForAddressRequest)(nil),  // 15: storagepb.RecommendationsForAddressRequest
+	(*AddressRecommendation)(nil),             // 16: storagepb.AddressRecommendation
+	(*RecommendationsForAddressResponse)(nil), // 17: storagepb.RecommendationsForAddressResponse
+	(*TokenInfoRequest)(nil),                  // 18: storagepb.TokenInfoRequest
+	(*TokenInfoResponse)(nil),                 // 19: storagepb.TokenInfoResponse
+	(*TokenChainInfo)(nil),                    // 20: storagepb.TokenChainInfo
+	(*TokenChartRequest)(nil),                 // 21: storagepb.TokenChartRequest
+	(*TokenChartResponse)(nil),                // 22: storagepb.TokenChartResponse
+	(*Point)(nil),                             // 23: storagepb.Point
+	(*TokenPricesRequest)(nil),                // 24: storagepb.TokenPricesRequest
+	(*TokenPricesResponse)(nil),               // 25: storagepb.TokenPricesResponse
+	(*UpdateFungibleIdsRequest)(nil),          // 26: storagepb.UpdateFungibleIdsRequest
+	(*UpdateFungibleIdsResponse)(nil),         // 27: storagepb.UpdateFungibleIdsResponse
+	(*DaoHistoryRequest)(nil),                 // 28: storagepb.DaoHistoryRequest
+	(*DaoFieldChange)(nil),                    // 29: storagepb.DaoFieldChange
+	(*DaoHistoryItem)(nil),                    // 30: storagepb.DaoHistoryItem
+	(*DaoHistoryResponse)(nil),                // 31: storagepb.DaoHistoryResponse
+	(*DaoChildrenRequest)(nil),                // 32: storagepb.DaoChildrenRequest
+	(*DaoChildrenResponse)(nil),               // 33: storagepb.DaoChildrenResponse
+	(*DaoTreasuryRequest)(nil),                // 34: storagepb.DaoTreasuryRequest
+	(*TreasuryPosition)(nil),                  // 35: storagepb.TreasuryPosition
+	(*TreasuryWallet)(nil),                    // 36: storagepb.TreasuryWallet
+	(*TreasuryValuePoint)(nil),                // 37: storagepb.TreasuryValuePoint
+	(*DaoTreasuryResponse)(nil),               // 38: storagepb.DaoTreasuryResponse
+	(*PendingVerificationsRequest)(nil),       // 39: storagepb.PendingVerificationsRequest
+	(*PendingVerificationsResponse)(nil),      // 40: storagepb.PendingVerificationsResponse
+	(*DecideVerificationRequest)(nil),         // 41: storagepb.DecideVerificationRequest
+	(*DecideVerificationResponse)(nil),        // 42: storagepb.DecideVerificationResponse
+	(*VerificationDecisionsRequest)(nil),      // 43: storagepb.VerificationDecisionsRequest
+	(*VerificationDecisionItem)(nil),          // 44: storagepb.VerificationDecisionItem
+	(*VerificationDecisionsResponse)(nil),     // 45: storagepb.VerificationDecisionsResponse
+	(*DaoSimilarRequest)(nil),                 // 46: storagepb.DaoSimilarRequest
+	(*SimilarDao)(nil),                        // 47: storagepb.SimilarDao
+	(*DaoSimilarResponse)(nil),                // 48: storagepb.DaoSimilarResponse
+	(*DaoActivityRequest)(nil),                // 49: storagepb.DaoActivityRequest
+	(*ActivityPoint)(nil),                     // 50: storagepb.ActivityPoint
+	(*DaoActivityResponse)(nil),               // 51: storagepb.DaoActivityResponse
+	(*DaoIdentity)(nil),                       // 52: storagepb.DaoIdentity
+	(*DaoIdentitiesRequest)(nil),              // 53: storagepb.DaoIdentitiesRequest
+	(*DaoIdentitiesResponse)(nil),             // 54: storagepb.DaoIdentitiesResponse
+	(*LinkIdentityRequest)(nil),               // 55: storagepb.LinkIdentityRequest
+	(*DaoByTokenRequest)(nil),                 // 56: storagepb.DaoByTokenRequest
+	(*DaoByTokenResponse)(nil),                // 57: storagepb.DaoByTokenResponse
+	(*DaoByStrategyRequest)(nil),              // 58: storagepb.DaoByStrategyRequest
+	(*DaoByStrategyResponse)(nil),             // 59: storagepb.DaoByStrategyResponse
+	(*timestamppb.Timestamp)(nil),             // 60: google.protobuf.Timestamp
+	(*Strategy)(nil),                          // 61: storagepb.Strategy
 }
 var file_storagepb_dao_proto_depIdxs = []int32{
-	23, // 0: storagepb.DaoInfo.created_at:type_name -> google.protobuf.Timestamp
-	23, // 1: storagepb.DaoInfo.updated_at:type_name -> google.protobuf.Timestamp
-	24, // 2: storagepb.DaoInfo.strategies:type_name -> storagepb.Strategy
-	1,  // 3: storagepb.DaoInfo.voting:type_name -> storagepb.Voting
-	2,  // 4: storagepb.DaoInfo.treasuries:type_name -> storagepb.Treasury
-	3,  // 5: storagepb.DaoByIDResponse.dao:type_name -> storagepb.DaoInfo
-	3,  // 6: storagepb.DaoByFilterResponse.daos:type_name -> storagepb.DaoInfo
-	3,  // 7: storagepb.TopCategory.daos:type_name -> storagepb.DaoInfo
-	7,  // 8: storagepb.TopByCategoriesResponse.categories:type_name -> storagepb.TopCategory
-	11, // 9: storagepb.GetRecommendationsListResponse.list:type_name -> storagepb.DaoRecommendationDetails
-	15, // 10: storagepb.TokenInfoResponse.chains:type_name -> storagepb.TokenChainInfo
-	18, // 11: storagepb.TokenChartResponse.points:type_name -> storagepb.Point
-	23, // 12: storagepb.Point.time:type_name -> google.protobuf.Timestamp
-	0,  // 13: storagepb.Dao.GetByID:input_type -> storagepb.DaoByIDRequest
-	5,  // 14: storagepb.Dao.GetByFilter:input_type -> storagepb.DaoByFilterRequest
-	8,  // 15: storagepb.Dao.GetTopByCategories:input_type -> storagepb.TopByCategoriesRequest
-	10, // 16: storagepb.Dao.GetRecommendationsList:input_type -> storagepb.GetRecommendationsListRequest
-	13, // 17: storagepb.Dao.GetTokenInfo:input_type -> storagepb.TokenInfoRequest
-	16, // 18: storagepb.Dao.GetTokenChart:input_type -> storagepb.TokenChartRequest
-	19, // 19: storagepb.Dao.PopulateTokenPrices:input_type -> storagepb.TokenPricesRequest
-	21, // 20: storagepb.Dao.UpdateFungibleIds:input_type -> storagepb.UpdateFungibleIdsRequest
-	4,  // 21: storagepb.Dao.GetByID:output_type -> storagepb.DaoByIDResponse
-	6,  // 22: storagepb.Dao.GetByFilter:output_type -> storagepb.DaoByFilterResponse
-	9,  // 23: storagepb.Dao.GetTopByCategories:output_type -> storagepb.TopByCategoriesResponse
-	12, // 24: storagepb.Dao.GetRecommendationsList:output_type -> storagepb.GetRecommendationsListResponse
-	14, // 25: storagepb.Dao.GetTokenInfo:output_type -> storagepb.TokenInfoResponse
-	17, // 26: storagepb.Dao.GetTokenChart:output_type -> storagepb.TokenChartResponse
-	20, // 27: storagepb.Dao.PopulateTokenPrices:output_type -> storagepb.TokenPricesResponse
-	22, // 28: storagepb.Dao.UpdateFungibleIds:output_type -> storagepb.UpdateFungibleIdsResponse
-	21, // [21:29] is the sub-list for method output_type
-	13, // [13:21] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	60, // 0: storagepb.DaoInfo.created_at:type_name -> google.protobuf.Timestamp
+	60, // 1: storagepb.DaoInfo.updated_at:type_name -> google.protobuf.Timestamp
+	61, // 2: storagepb.DaoInfo.strategies:type_name -> storagepb.Strategy
+	3,  // 3: storagepb.DaoInfo.voting:type_name -> storagepb.Voting
+	4,  // 4: storagepb.DaoInfo.treasuries:type_name -> storagepb.Treasury
+	5,  // 5: storagepb.DaoByIDResponse.dao:type_name -> storagepb.DaoInfo
+	5,  // 6: storagepb.DaoByFilterResponse.daos:type_name -> storagepb.DaoInfo
+	5,  // 7: storagepb.TopCategory.daos:type_name -> storagepb.DaoInfo
+	9,  // 8: storagepb.TopByCategoriesResponse.categories:type_name -> storagepb.TopCategory
+	13, // 9: storagepb.GetRecommendationsListResponse.list:type_name -> storagepb.DaoRecommendationDetails
+	5,  // 10: storagepb.AddressRecommendation.dao:type_name -> storagepb.DaoInfo
+	13, // 11: storagepb.AddressRecommendation.tokens:type_name -> storagepb.DaoRecommendationDetails
+	16, // 12: storagepb.RecommendationsForAddressResponse.list:type_name -> storagepb.AddressRecommendation
+	20, // 13: storagepb.TokenInfoResponse.chains:type_name -> storagepb.TokenChainInfo
+	23, // 14: storagepb.TokenChartResponse.points:type_name -> storagepb.Point
+	60, // 15: storagepb.Point.time:type_name -> google.protobuf.Timestamp
+	60, // 16: storagepb.DaoHistoryItem.created_at:type_name -> google.protobuf.Timestamp
+	29, // 17: storagepb.DaoHistoryItem.changes:type_name -> storagepb.DaoFieldChange
+	30, // 18: storagepb.DaoHistoryResponse.items:type_name -> storagepb.DaoHistoryItem
+	5,  // 19: storagepb.DaoChildrenResponse.daos:type_name -> storagepb.DaoInfo
+	60, // 20: storagepb.TreasuryWallet.updated_at:type_name -> google.protobuf.Timestamp
+	35, // 21: storagepb.TreasuryWallet.positions:type_name -> storagepb.TreasuryPosition
+	60, // 22: storagepb.TreasuryValuePoint.time:type_name -> google.protobuf.Timestamp
+	36, // 23: storagepb.DaoTreasuryResponse.wallets:type_name -> storagepb.TreasuryWallet
+	37, // 24: storagepb.DaoTreasuryResponse.series:type_name -> storagepb.TreasuryValuePoint
+	5,  // 25: storagepb.PendingVerificationsResponse.daos:type_name -> storagepb.DaoInfo
+	0,  // 26: storagepb.DecideVerificationRequest.status:type_name -> storagepb.VerificationDecisionStatus
+	5,  // 27: storagepb.DecideVerificationResponse.dao:type_name -> storagepb.DaoInfo
+	60, // 28: storagepb.VerificationDecisionItem.created_at:type_name -> google.protobuf.Timestamp
+	44, // 29: storagepb.VerificationDecisionsResponse.items:type_name -> storagepb.VerificationDecisionItem
+	5,  // 30: storagepb.SimilarDao.dao:type_name -> storagepb.DaoInfo
+	47, // 31: storagepb.DaoSimilarResponse.list:type_name -> storagepb.SimilarDao
+	60, // 32: storagepb.DaoActivityRequest.from:type_name -> google.protobuf.Timestamp
+	60, // 33: storagepb.DaoActivityRequest.to:type_name -> google.protobuf.Timestamp
+	1,  // 34: storagepb.DaoActivityRequest.granularity:type_name -> storagepb.ActivityGranularity
+	60, // 35: storagepb.ActivityPoint.time:type_name -> google.protobuf.Timestamp
+	50, // 36: storagepb.DaoActivityResponse.points:type_name -> storagepb.ActivityPoint
+	52, // 37: storagepb.DaoIdentitiesResponse.list:type_name -> storagepb.DaoIdentity
+	5,  // 38: storagepb.DaoByTokenResponse.daos:type_name -> storagepb.DaoInfo
+	5,  // 39: storagepb.DaoByStrategyResponse.daos:type_name -> storagepb.DaoInfo
+	2,  // 40: storagepb.Dao.GetByID:input_type -> storagepb.DaoByIDRequest
+	7,  // 41: storagepb.Dao.GetByFilter:input_type -> storagepb.DaoByFilterRequest
+	10, // 42: storagepb.Dao.GetTopByCategories:input_type -> storagepb.TopByCategoriesRequest
+	12, // 43: storagepb.Dao.GetRecommendationsList:input_type -> storagepb.GetRecommendationsListRequest
+	15, // 44: storagepb.Dao.GetRecommendationsForAddress:input_type -> storagepb.RecommendationsForAddressRequest
+	18, // 45: storagepb.Dao.GetTokenInfo:input_type -> storagepb.TokenInfoRequest
+	21, // 46: storagepb.Dao.GetTokenChart:input_type -> storagepb.TokenChartRequest
+	24, // 47: storagepb.Dao.PopulateTokenPrices:input_type -> storagepb.TokenPricesRequest
+	26, // 48: storagepb.Dao.UpdateFungibleIds:input_type -> storagepb.UpdateFungibleIdsRequest
+	28, // 49: storagepb.Dao.GetHistory:input_type -> storagepb.DaoHistoryRequest
+	32, // 50: storagepb.Dao.GetChildren:input_type -> storagepb.DaoChildrenRequest
+	34, // 51: storagepb.Dao.GetTreasury:input_type -> storagepb.DaoTreasuryRequest
+	39, // 52: storagepb.Dao.GetPendingVerifications:input_type -> storagepb.PendingVerificationsRequest
+	41, // 53: storagepb.Dao.DecideVerification:input_type -> storagepb.DecideVerificationRequest
+	43, // 54: storagepb.Dao.GetVerificationDecisions:input_type -> storagepb.VerificationDecisionsRequest
+	46, // 55: storagepb.Dao.GetSimilar:input_type -> storagepb.DaoSimilarRequest
+	49, // 56: storagepb.Dao.GetActivity:input_type -> storagepb.DaoActivityRequest
+	53, // 57: storagepb.Dao.GetIdentities:input_type -> storagepb.DaoIdentitiesRequest
+	55, // 58: storagepb.Dao.LinkIdentity:input_type -> storagepb.LinkIdentityRequest
+	56, // 59: storagepb.Dao.GetByToken:input_type -> storagepb.DaoByTokenRequest
+	58, // 60: storagepb.Dao.GetByStrategy:input_type -> storagepb.DaoByStrategyRequest
+	6,  // 61: storagepb.Dao.GetByID:output_type -> storagepb.DaoByIDResponse
+	8,  // 62: storagepb.Dao.GetByFilter:output_type -> storagepb.DaoByFilterResponse
+	11, // 63: storagepb.Dao.GetTopByCategories:output_type -> storagepb.TopByCategoriesResponse
+	14, // 64: storagepb.Dao.GetRecommendationsList:output_type -> storagepb.GetRecommendationsListResponse
+	17, // 65: storagepb.Dao.GetRecommendationsForAddress:output_type -> storagepb.RecommendationsForAddressResponse
+	19, // 66: storagepb.Dao.GetTokenInfo:output_type -> storagepb.TokenInfoResponse
+	22, // 67: storagepb.Dao.GetTokenChart:output_type -> storagepb.TokenChartResponse
+	25, // 68: storagepb.Dao.PopulateTokenPrices:output_type -> storagepb.TokenPricesResponse
+	27, // 69: storagepb.Dao.UpdateFungibleIds:output_type -> storagepb.UpdateFungibleIdsResponse
+	31, // 70: storagepb.Dao.GetHistory:output_type -> storagepb.DaoHistoryResponse
+	33, // 71: storagepb.Dao.GetChildren:output_type -> storagepb.DaoChildrenResponse
+	38, // 72: storagepb.Dao.GetTreasury:output_type -> storagepb.DaoTreasuryResponse
+	40, // 73: storagepb.Dao.GetPendingVerifications:output_type -> storagepb.PendingVerificationsResponse
+	42, // 74: storagepb.Dao.DecideVerification:output_type -> storagepb.DecideVerificationResponse
+	45, // 75: storagepb.Dao.GetVerificationDecisions:output_type -> storagepb.VerificationDecisionsResponse
+	48, // 76: storagepb.Dao.GetSimilar:output_type -> storagepb.DaoSimilarResponse
+	51, // 77: storagepb.Dao.GetActivity:output_type -> storagepb.DaoActivityResponse
+	54, // 78: storagepb.Dao.GetIdentities:output_type -> storagepb.DaoIdentitiesResponse
+	54, // 79: storagepb.Dao.LinkIdentity:output_type -> storagepb.DaoIdentitiesResponse
+	57, // 80: storagepb.Dao.GetByToken:output_type -> storagepb.DaoByTokenResponse
+	59, // 81: storagepb.Dao.GetByStrategy:output_type -> storagepb.DaoByStrategyResponse
+	61, // [61:82] is the sub-list for method output_type
+	40, // [40:61] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_storagepb_dao_proto_init() }
@@ -1862,18 +4438,29 @@ func file_storagepb_dao_proto_init() {
 	}
 	file_storagepb_base_proto_init()
 	file_storagepb_dao_proto_msgTypes[5].OneofWrappers = []any{}
+	file_storagepb_dao_proto_msgTypes[13].OneofWrappers = []any{}
+	file_storagepb_dao_proto_msgTypes[26].OneofWrappers = []any{}
+	file_storagepb_dao_proto_msgTypes[30].OneofWrappers = []any{}
+	file_storagepb_dao_proto_msgTypes[32].OneofWrappers = []any{}
+	file_storagepb_dao_proto_msgTypes[37].OneofWrappers = []any{}
+	file_storagepb_dao_proto_msgTypes[41].OneofWrappers = []any{}
+	file_storagepb_dao_proto_msgTypes[44].OneofWrappers = []any{}
+	file_storagepb_dao_proto_msgTypes[47].OneofWrappers = []any{}
+	file_storagepb_dao_proto_msgTypes[54].OneofWrappers = []any{}
+	file_storagepb_dao_proto_msgTypes[56].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_storagepb_dao_proto_rawDesc), len(file_storagepb_dao_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   23,
+			NumEnums:      2,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_storagepb_dao_proto_goTypes,
 		DependencyIndexes: file_storagepb_dao_proto_depIdxs,
+		EnumInfos:         file_storagepb_dao_proto_enumTypes,
 		MessageInfos:      file_storagepb_dao_proto_msgTypes,
 	}.Build()
 	File_storagepb_dao_proto = out.File
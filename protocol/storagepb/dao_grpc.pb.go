@@ -19,14 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Dao_GetByID_FullMethodName                = "/storagepb.Dao/GetByID"
-	Dao_GetByFilter_FullMethodName            = "/storagepb.Dao/GetByFilter"
-	Dao_GetTopByCategories_FullMethodName     = "/storagepb.Dao/GetTopByCategories"
-	Dao_GetRecommendationsList_FullMethodName = "/storagepb.Dao/GetRecommendationsList"
-	Dao_GetTokenInfo_FullMethodName           = "/storagepb.Dao/GetTokenInfo"
-	Dao_GetTokenChart_FullMethodName          = "/storagepb.Dao/GetTokenChart"
-	Dao_PopulateTokenPrices_FullMethodName    = "/storagepb.Dao/PopulateTokenPrices"
-	Dao_UpdateFungibleIds_FullMethodName      = "/storagepb.Dao/UpdateFungibleIds"
+	Dao_GetByID_FullMethodName                      = "/storagepb.Dao/GetByID"
+	Dao_GetByFilter_FullMethodName                  = "/storagepb.Dao/GetByFilter"
+	Dao_GetTopByCategories_FullMethodName           = "/storagepb.Dao/GetTopByCategories"
+	Dao_GetRecommendationsList_FullMethodName       = "/storagepb.Dao/GetRecommendationsList"
+	Dao_GetRecommendationsForAddress_FullMethodName = "/storagepb.Dao/GetRecommendationsForAddress"
+	Dao_GetTokenInfo_FullMethodName                 = "/storagepb.Dao/GetTokenInfo"
+	Dao_GetTokenChart_FullMethodName                = "/storagepb.Dao/GetTokenChart"
+	Dao_PopulateTokenPrices_FullMethodName          = "/storagepb.Dao/PopulateTokenPrices"
+	Dao_UpdateFungibleIds_FullMethodName            = "/storagepb.Dao/UpdateFungibleIds"
+	Dao_GetHistory_FullMethodName                   = "/storagepb.Dao/GetHistory"
+	Dao_GetChildren_FullMethodName                  = "/storagepb.Dao/GetChildren"
+	Dao_GetTreasury_FullMethodName                  = "/storagepb.Dao/GetTreasury"
+	Dao_GetPendingVerifications_FullMethodName      = "/storagepb.Dao/GetPendingVerifications"
+	Dao_DecideVerification_FullMethodName           = "/storagepb.Dao/DecideVerification"
+	Dao_GetVerificationDecisions_FullMethodName     = "/storagepb.Dao/GetVerificationDecisions"
+	Dao_GetSimilar_FullMethodName                   = "/storagepb.Dao/GetSimilar"
+	Dao_GetActivity_FullMethodName                  = "/storagepb.Dao/GetActivity"
+	Dao_GetIdentities_FullMethodName                = "/storagepb.Dao/GetIdentities"
+	Dao_LinkIdentity_FullMethodName                 = "/storagepb.Dao/LinkIdentity"
+	Dao_GetByToken_FullMethodName                   = "/storagepb.Dao/GetByToken"
+	Dao_GetByStrategy_FullMethodName                = "/storagepb.Dao/GetByStrategy"
 )
 
 // DaoClient is the client API for Dao service.
@@ -37,10 +50,23 @@ type DaoClient interface {
 	GetByFilter(ctx context.Context, in *DaoByFilterRequest, opts ...grpc.CallOption) (*DaoByFilterResponse, error)
 	GetTopByCategories(ctx context.Context, in *TopByCategoriesRequest, opts ...grpc.CallOption) (*TopByCategoriesResponse, error)
 	GetRecommendationsList(ctx context.Context, in *GetRecommendationsListRequest, opts ...grpc.CallOption) (*GetRecommendationsListResponse, error)
+	GetRecommendationsForAddress(ctx context.Context, in *RecommendationsForAddressRequest, opts ...grpc.CallOption) (*RecommendationsForAddressResponse, error)
 	GetTokenInfo(ctx context.Context, in *TokenInfoRequest, opts ...grpc.CallOption) (*TokenInfoResponse, error)
 	GetTokenChart(ctx context.Context, in *TokenChartRequest, opts ...grpc.CallOption) (*TokenChartResponse, error)
 	PopulateTokenPrices(ctx context.Context, in *TokenPricesRequest, opts ...grpc.CallOption) (*TokenPricesResponse, error)
 	UpdateFungibleIds(ctx context.Context, in *UpdateFungibleIdsRequest, opts ...grpc.CallOption) (*UpdateFungibleIdsResponse, error)
+	GetHistory(ctx context.Context, in *DaoHistoryRequest, opts ...grpc.CallOption) (*DaoHistoryResponse, error)
+	GetChildren(ctx context.Context, in *DaoChildrenRequest, opts ...grpc.CallOption) (*DaoChildrenResponse, error)
+	GetTreasury(ctx context.Context, in *DaoTreasuryRequest, opts ...grpc.CallOption) (*DaoTreasuryResponse, error)
+	GetPendingVerifications(ctx context.Context, in *PendingVerificationsRequest, opts ...grpc.CallOption) (*PendingVerificationsResponse, error)
+	DecideVerification(ctx context.Context, in *DecideVerificationRequest, opts ...grpc.CallOption) (*DecideVerificationResponse, error)
+	GetVerificationDecisions(ctx context.Context, in *VerificationDecisionsRequest, opts ...grpc.CallOption) (*VerificationDecisionsResponse, error)
+	GetSimilar(ctx context.Context, in *DaoSimilarRequest, opts ...grpc.CallOption) (*DaoSimilarResponse, error)
+	GetActivity(ctx context.Context, in *DaoActivityRequest, opts ...grpc.CallOption) (*DaoActivityResponse, error)
+	GetIdentities(ctx context.Context, in *DaoIdentitiesRequest, opts ...grpc.CallOption) (*DaoIdentitiesResponse, error)
+	LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*DaoIdentitiesResponse, error)
+	GetByToken(ctx context.Context, in *DaoByTokenRequest, opts ...grpc.CallOption) (*DaoByTokenResponse, error)
+	GetByStrategy(ctx context.Context, in *DaoByStrategyRequest, opts ...grpc.CallOption) (*DaoByStrategyResponse, error)
 }
 
 type daoClient struct {
@@ -91,6 +117,16 @@ func (c *daoClient) GetRecommendationsList(ctx context.Context, in *GetRecommend
 	return out, nil
 }
 
+func (c *daoClient) GetRecommendationsForAddress(ctx context.Context, in *RecommendationsForAddressRequest, opts ...grpc.CallOption) (*RecommendationsForAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendationsForAddressResponse)
+	err := c.cc.Invoke(ctx, Dao_GetRecommendationsForAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daoClient) GetTokenInfo(ctx context.Context, in *TokenInfoRequest, opts ...grpc.CallOption) (*TokenInfoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TokenInfoResponse)
@@ -131,6 +167,126 @@ func (c *daoClient) UpdateFungibleIds(ctx context.Context, in *UpdateFungibleIds
 	return out, nil
 }
 
+func (c *daoClient) GetHistory(ctx context.Context, in *DaoHistoryRequest, opts ...grpc.CallOption) (*DaoHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaoHistoryResponse)
+	err := c.cc.Invoke(ctx, Dao_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daoClient) GetChildren(ctx context.Context, in *DaoChildrenRequest, opts ...grpc.CallOption) (*DaoChildrenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaoChildrenResponse)
+	err := c.cc.Invoke(ctx, Dao_GetChildren_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daoClient) GetTreasury(ctx context.Context, in *DaoTreasuryRequest, opts ...grpc.CallOption) (*DaoTreasuryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaoTreasuryResponse)
+	err := c.cc.Invoke(ctx, Dao_GetTreasury_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daoClient) GetPendingVerifications(ctx context.Context, in *PendingVerificationsRequest, opts ...grpc.CallOption) (*PendingVerificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PendingVerificationsResponse)
+	err := c.cc.Invoke(ctx, Dao_GetPendingVerifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daoClient) DecideVerification(ctx context.Context, in *DecideVerificationRequest, opts ...grpc.CallOption) (*DecideVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecideVerificationResponse)
+	err := c.cc.Invoke(ctx, Dao_DecideVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daoClient) GetVerificationDecisions(ctx context.Context, in *VerificationDecisionsRequest, opts ...grpc.CallOption) (*VerificationDecisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerificationDecisionsResponse)
+	err := c.cc.Invoke(ctx, Dao_GetVerificationDecisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daoClient) GetSimilar(ctx context.Context, in *DaoSimilarRequest, opts ...grpc.CallOption) (*DaoSimilarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaoSimilarResponse)
+	err := c.cc.Invoke(ctx, Dao_GetSimilar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daoClient) GetActivity(ctx context.Context, in *DaoActivityRequest, opts ...grpc.CallOption) (*DaoActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaoActivityResponse)
+	err := c.cc.Invoke(ctx, Dao_GetActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daoClient) GetIdentities(ctx context.Context, in *DaoIdentitiesRequest, opts ...grpc.CallOption) (*DaoIdentitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaoIdentitiesResponse)
+	err := c.cc.Invoke(ctx, Dao_GetIdentities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daoClient) LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*DaoIdentitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaoIdentitiesResponse)
+	err := c.cc.Invoke(ctx, Dao_LinkIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daoClient) GetByToken(ctx context.Context, in *DaoByTokenRequest, opts ...grpc.CallOption) (*DaoByTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaoByTokenResponse)
+	err := c.cc.Invoke(ctx, Dao_GetByToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daoClient) GetByStrategy(ctx context.Context, in *DaoByStrategyRequest, opts ...grpc.CallOption) (*DaoByStrategyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaoByStrategyResponse)
+	err := c.cc.Invoke(ctx, Dao_GetByStrategy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DaoServer is the server API for Dao service.
 // All implementations must embed UnimplementedDaoServer
 // for forward compatibility.
@@ -139,10 +295,23 @@ type DaoServer interface {
 	GetByFilter(context.Context, *DaoByFilterRequest) (*DaoByFilterResponse, error)
 	GetTopByCategories(context.Context, *TopByCategoriesRequest) (*TopByCategoriesResponse, error)
 	GetRecommendationsList(context.Context, *GetRecommendationsListRequest) (*GetRecommendationsListResponse, error)
+	GetRecommendationsForAddress(context.Context, *RecommendationsForAddressRequest) (*RecommendationsForAddressResponse, error)
 	GetTokenInfo(context.Context, *TokenInfoRequest) (*TokenInfoResponse, error)
 	GetTokenChart(context.Context, *TokenChartRequest) (*TokenChartResponse, error)
 	PopulateTokenPrices(context.Context, *TokenPricesRequest) (*TokenPricesResponse, error)
 	UpdateFungibleIds(context.Context, *UpdateFungibleIdsRequest) (*UpdateFungibleIdsResponse, error)
+	GetHistory(context.Context, *DaoHistoryRequest) (*DaoHistoryResponse, error)
+	GetChildren(context.Context, *DaoChildrenRequest) (*DaoChildrenResponse, error)
+	GetTreasury(context.Context, *DaoTreasuryRequest) (*DaoTreasuryResponse, error)
+	GetPendingVerifications(context.Context, *PendingVerificationsRequest) (*PendingVerificationsResponse, error)
+	DecideVerification(context.Context, *DecideVerificationRequest) (*DecideVerificationResponse, error)
+	GetVerificationDecisions(context.Context, *VerificationDecisionsRequest) (*VerificationDecisionsResponse, error)
+	GetSimilar(context.Context, *DaoSimilarRequest) (*DaoSimilarResponse, error)
+	GetActivity(context.Context, *DaoActivityRequest) (*DaoActivityResponse, error)
+	GetIdentities(context.Context, *DaoIdentitiesRequest) (*DaoIdentitiesResponse, error)
+	LinkIdentity(context.Context, *LinkIdentityRequest) (*DaoIdentitiesResponse, error)
+	GetByToken(context.Context, *DaoByTokenRequest) (*DaoByTokenResponse, error)
+	GetByStrategy(context.Context, *DaoByStrategyRequest) (*DaoByStrategyResponse, error)
 	mustEmbedUnimplementedDaoServer()
 }
 
@@ -165,6 +334,9 @@ func (UnimplementedDaoServer) GetTopByCategories(context.Context, *TopByCategori
 func (UnimplementedDaoServer) GetRecommendationsList(context.Context, *GetRecommendationsListRequest) (*GetRecommendationsListResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetRecommendationsList not implemented")
 }
+func (UnimplementedDaoServer) GetRecommendationsForAddress(context.Context, *RecommendationsForAddressRequest) (*RecommendationsForAddressResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRecommendationsForAddress not implemented")
+}
 func (UnimplementedDaoServer) GetTokenInfo(context.Context, *TokenInfoRequest) (*TokenInfoResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTokenInfo not implemented")
 }
@@ -177,6 +349,42 @@ func (UnimplementedDaoServer) PopulateTokenPrices(context.Context, *TokenPricesR
 func (UnimplementedDaoServer) UpdateFungibleIds(context.Context, *UpdateFungibleIdsRequest) (*UpdateFungibleIdsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateFungibleIds not implemented")
 }
+func (UnimplementedDaoServer) GetHistory(context.Context, *DaoHistoryRequest) (*DaoHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (UnimplementedDaoServer) GetChildren(context.Context, *DaoChildrenRequest) (*DaoChildrenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChildren not implemented")
+}
+func (UnimplementedDaoServer) GetTreasury(context.Context, *DaoTreasuryRequest) (*DaoTreasuryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTreasury not implemented")
+}
+func (UnimplementedDaoServer) GetPendingVerifications(context.Context, *PendingVerificationsRequest) (*PendingVerificationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPendingVerifications not implemented")
+}
+func (UnimplementedDaoServer) DecideVerification(context.Context, *DecideVerificationRequest) (*DecideVerificationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DecideVerification not implemented")
+}
+func (UnimplementedDaoServer) GetVerificationDecisions(context.Context, *VerificationDecisionsRequest) (*VerificationDecisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVerificationDecisions not implemented")
+}
+func (UnimplementedDaoServer) GetSimilar(context.Context, *DaoSimilarRequest) (*DaoSimilarResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSimilar not implemented")
+}
+func (UnimplementedDaoServer) GetActivity(context.Context, *DaoActivityRequest) (*DaoActivityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActivity not implemented")
+}
+func (UnimplementedDaoServer) GetIdentities(context.Context, *DaoIdentitiesRequest) (*DaoIdentitiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIdentities not implemented")
+}
+func (UnimplementedDaoServer) LinkIdentity(context.Context, *LinkIdentityRequest) (*DaoIdentitiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LinkIdentity not implemented")
+}
+func (UnimplementedDaoServer) GetByToken(context.Context, *DaoByTokenRequest) (*DaoByTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetByToken not implemented")
+}
+func (UnimplementedDaoServer) GetByStrategy(context.Context, *DaoByStrategyRequest) (*DaoByStrategyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetByStrategy not implemented")
+}
 func (UnimplementedDaoServer) mustEmbedUnimplementedDaoServer() {}
 func (UnimplementedDaoServer) testEmbeddedByValue()             {}
 
@@ -270,6 +478,24 @@ func _Dao_GetRecommendationsList_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dao_GetRecommendationsForAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendationsForAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaoServer).GetRecommendationsForAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dao_GetRecommendationsForAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaoServer).GetRecommendationsForAddress(ctx, req.(*RecommendationsForAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dao_GetTokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TokenInfoRequest)
 	if err := dec(in); err != nil {
@@ -342,6 +568,222 @@ func _Dao_UpdateFungibleIds_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dao_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaoHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaoServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dao_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaoServer).GetHistory(ctx, req.(*DaoHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dao_GetChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaoChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaoServer).GetChildren(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dao_GetChildren_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaoServer).GetChildren(ctx, req.(*DaoChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dao_GetTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaoTreasuryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaoServer).GetTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dao_GetTreasury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaoServer).GetTreasury(ctx, req.(*DaoTreasuryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dao_GetPendingVerifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingVerificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaoServer).GetPendingVerifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dao_GetPendingVerifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaoServer).GetPendingVerifications(ctx, req.(*PendingVerificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dao_DecideVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaoServer).DecideVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dao_DecideVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaoServer).DecideVerification(ctx, req.(*DecideVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dao_GetVerificationDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerificationDecisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaoServer).GetVerificationDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dao_GetVerificationDecisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaoServer).GetVerificationDecisions(ctx, req.(*VerificationDecisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dao_GetSimilar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaoSimilarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaoServer).GetSimilar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dao_GetSimilar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaoServer).GetSimilar(ctx, req.(*DaoSimilarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dao_GetActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaoActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaoServer).GetActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dao_GetActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaoServer).GetActivity(ctx, req.(*DaoActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dao_GetIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaoIdentitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaoServer).GetIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dao_GetIdentities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaoServer).GetIdentities(ctx, req.(*DaoIdentitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dao_LinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaoServer).LinkIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dao_LinkIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaoServer).LinkIdentity(ctx, req.(*LinkIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dao_GetByToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaoByTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaoServer).GetByToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dao_GetByToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaoServer).GetByToken(ctx, req.(*DaoByTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dao_GetByStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaoByStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaoServer).GetByStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dao_GetByStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaoServer).GetByStrategy(ctx, req.(*DaoByStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Dao_ServiceDesc is the grpc.ServiceDesc for Dao service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -365,6 +807,10 @@ var Dao_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRecommendationsList",
 			Handler:    _Dao_GetRecommendationsList_Handler,
 		},
+		{
+			MethodName: "GetRecommendationsForAddress",
+			Handler:    _Dao_GetRecommendationsForAddress_Handler,
+		},
 		{
 			MethodName: "GetTokenInfo",
 			Handler:    _Dao_GetTokenInfo_Handler,
@@ -381,6 +827,54 @@ var Dao_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateFungibleIds",
 			Handler:    _Dao_UpdateFungibleIds_Handler,
 		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _Dao_GetHistory_Handler,
+		},
+		{
+			MethodName: "GetChildren",
+			Handler:    _Dao_GetChildren_Handler,
+		},
+		{
+			MethodName: "GetTreasury",
+			Handler:    _Dao_GetTreasury_Handler,
+		},
+		{
+			MethodName: "GetPendingVerifications",
+			Handler:    _Dao_GetPendingVerifications_Handler,
+		},
+		{
+			MethodName: "DecideVerification",
+			Handler:    _Dao_DecideVerification_Handler,
+		},
+		{
+			MethodName: "GetVerificationDecisions",
+			Handler:    _Dao_GetVerificationDecisions_Handler,
+		},
+		{
+			MethodName: "GetSimilar",
+			Handler:    _Dao_GetSimilar_Handler,
+		},
+		{
+			MethodName: "GetActivity",
+			Handler:    _Dao_GetActivity_Handler,
+		},
+		{
+			MethodName: "GetIdentities",
+			Handler:    _Dao_GetIdentities_Handler,
+		},
+		{
+			MethodName: "LinkIdentity",
+			Handler:    _Dao_LinkIdentity_Handler,
+		},
+		{
+			MethodName: "GetByToken",
+			Handler:    _Dao_GetByToken_Handler,
+		},
+		{
+			MethodName: "GetByStrategy",
+			Handler:    _Dao_GetByStrategy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "storagepb/dao.proto",
@@ -219,8 +219,12 @@ type ProposalInfo struct {
 	EnsName           string                  `protobuf:"bytes,30,opt,name=ens_name,json=ensName,proto3" json:"ens_name,omitempty"`
 	Spam              bool                    `protobuf:"varint,31,opt,name=spam,proto3" json:"spam,omitempty"`
 	InitialTokenPrice float64                 `protobuf:"fixed64,32,opt,name=initial_token_price,json=initialTokenPrice,proto3" json:"initial_token_price,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// winning_choices are the indexes of the leading choices and scores of the finished voting
+	WinningChoices []uint32 `protobuf:"varint,33,rep,packed,name=winning_choices,json=winningChoices,proto3" json:"winning_choices,omitempty"`
+	// scores_mismatch is set if scores recalculated from stored votes disagree with scores
+	ScoresMismatch bool `protobuf:"varint,34,opt,name=scores_mismatch,json=scoresMismatch,proto3" json:"scores_mismatch,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ProposalInfo) Reset() {
@@ -477,6 +481,20 @@ func (x *ProposalInfo) GetInitialTokenPrice() float64 {
 	return 0
 }
 
+func (x *ProposalInfo) GetWinningChoices() []uint32 {
+	if x != nil {
+		return x.WinningChoices
+	}
+	return nil
+}
+
+func (x *ProposalInfo) GetScoresMismatch() bool {
+	if x != nil {
+		return x.ScoresMismatch
+	}
+	return false
+}
+
 type ProposalTimelineItem struct {
 	state         protoimpl.MessageState              `protogen:"open.v1"`
 	CreatedAt     *timestamppb.Timestamp              `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
@@ -574,19 +592,22 @@ func (x *ProposalByIDResponse) GetProposal() *ProposalInfo {
 }
 
 type ProposalByFilterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Dao           *string                `protobuf:"bytes,1,opt,name=dao,proto3,oneof" json:"dao,omitempty"`
-	Category      *string                `protobuf:"bytes,2,opt,name=category,proto3,oneof" json:"category,omitempty"`
-	Limit         *uint64                `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset        *uint64                `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
-	Title         *string                `protobuf:"bytes,5,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Order         *string                `protobuf:"bytes,6,opt,name=order,proto3,oneof" json:"order,omitempty"`
-	Top           *bool                  `protobuf:"varint,7,opt,name=top,proto3,oneof" json:"top,omitempty"`
-	ProposalIds   []string               `protobuf:"bytes,8,rep,name=proposal_ids,json=proposalIds,proto3" json:"proposal_ids,omitempty"`
-	OnlyActive    *bool                  `protobuf:"varint,9,opt,name=only_active,json=onlyActive,proto3,oneof" json:"only_active,omitempty"`
-	Level         *ProposalInfoLevel     `protobuf:"varint,10,opt,name=level,proto3,enum=storagepb.ProposalInfoLevel,oneof" json:"level,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Dao         *string                `protobuf:"bytes,1,opt,name=dao,proto3,oneof" json:"dao,omitempty"`
+	Category    *string                `protobuf:"bytes,2,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Limit       *uint64                `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset      *uint64                `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Title       *string                `protobuf:"bytes,5,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Order       *string                `protobuf:"bytes,6,opt,name=order,proto3,oneof" json:"order,omitempty"`
+	Top         *bool                  `protobuf:"varint,7,opt,name=top,proto3,oneof" json:"top,omitempty"`
+	ProposalIds []string               `protobuf:"bytes,8,rep,name=proposal_ids,json=proposalIds,proto3" json:"proposal_ids,omitempty"`
+	OnlyActive  *bool                  `protobuf:"varint,9,opt,name=only_active,json=onlyActive,proto3,oneof" json:"only_active,omitempty"`
+	Level       *ProposalInfoLevel     `protobuf:"varint,10,opt,name=level,proto3,enum=storagepb.ProposalInfoLevel,oneof" json:"level,omitempty"`
+	// cursor is the next_cursor from the previous page, offset is ignored if it's set, top lists don't support it
+	Cursor         *string `protobuf:"bytes,11,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	SkipTotalCount *bool   `protobuf:"varint,12,opt,name=skip_total_count,json=skipTotalCount,proto3,oneof" json:"skip_total_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ProposalByFilterRequest) Reset() {
@@ -689,13 +710,29 @@ func (x *ProposalByFilterRequest) GetLevel() ProposalInfoLevel {
 	return ProposalInfoLevel_PROPOSAL_INFO_LEVEL_UNSPECIFIED
 }
 
+func (x *ProposalByFilterRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ProposalByFilterRequest) GetSkipTotalCount() bool {
+	if x != nil && x.SkipTotalCount != nil {
+		return *x.SkipTotalCount
+	}
+	return false
+}
+
 type ProposalByFilterResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Proposals      []*ProposalInfo        `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
 	TotalCount     uint64                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	ProposalsShort []*ProposalShortInfo   `protobuf:"bytes,3,rep,name=proposals_short,json=proposalsShort,proto3" json:"proposals_short,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// next_cursor is empty for the last page
+	NextCursor    string `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProposalByFilterResponse) Reset() {
@@ -749,6 +786,13 @@ func (x *ProposalByFilterResponse) GetProposalsShort() []*ProposalShortInfo {
 	return nil
 }
 
+func (x *ProposalByFilterResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type ProposalShortInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -817,6 +861,498 @@ func (x *ProposalShortInfo) GetCreated() uint64 {
 	return 0
 }
 
+type ProposalTallyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    string                 `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalTallyRequest) Reset() {
+	*x = ProposalTallyRequest{}
+	mi := &file_storagepb_proposal_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalTallyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalTallyRequest) ProtoMessage() {}
+
+func (x *ProposalTallyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_proposal_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalTallyRequest.ProtoReflect.Descriptor instead.
+func (*ProposalTallyRequest) Descriptor() ([]byte, []int) {
+	return file_storagepb_proposal_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProposalTallyRequest) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+type ProposalTallyRound struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         uint32                 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Scores        []float64              `protobuf:"fixed64,2,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalTallyRound) Reset() {
+	*x = ProposalTallyRound{}
+	mi := &file_storagepb_proposal_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalTallyRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalTallyRound) ProtoMessage() {}
+
+func (x *ProposalTallyRound) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_proposal_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalTallyRound.ProtoReflect.Descriptor instead.
+func (*ProposalTallyRound) Descriptor() ([]byte, []int) {
+	return file_storagepb_proposal_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProposalTallyRound) GetRound() uint32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *ProposalTallyRound) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type ProposalScoreDiff struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// choice is the index of the choice and scores
+	Choice        uint32  `protobuf:"varint,1,opt,name=choice,proto3" json:"choice,omitempty"`
+	Upstream      float64 `protobuf:"fixed64,2,opt,name=upstream,proto3" json:"upstream,omitempty"`
+	Calculated    float64 `protobuf:"fixed64,3,opt,name=calculated,proto3" json:"calculated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalScoreDiff) Reset() {
+	*x = ProposalScoreDiff{}
+	mi := &file_storagepb_proposal_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalScoreDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalScoreDiff) ProtoMessage() {}
+
+func (x *ProposalScoreDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_proposal_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalScoreDiff.ProtoReflect.Descriptor instead.
+func (*ProposalScoreDiff) Descriptor() ([]byte, []int) {
+	return file_storagepb_proposal_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProposalScoreDiff) GetChoice() uint32 {
+	if x != nil {
+		return x.Choice
+	}
+	return 0
+}
+
+func (x *ProposalScoreDiff) GetUpstream() float64 {
+	if x != nil {
+		return x.Upstream
+	}
+	return 0
+}
+
+func (x *ProposalScoreDiff) GetCalculated() float64 {
+	if x != nil {
+		return x.Calculated
+	}
+	return 0
+}
+
+type ProposalTallyResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Scores       []float64              `protobuf:"fixed64,1,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	ScoresTotal  float64                `protobuf:"fixed64,2,opt,name=scores_total,json=scoresTotal,proto3" json:"scores_total,omitempty"`
+	Votes        uint64                 `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`
+	InvalidVotes uint64                 `protobuf:"varint,4,opt,name=invalid_votes,json=invalidVotes,proto3" json:"invalid_votes,omitempty"`
+	// rounds are the instant-runoff rounds of ranked-choice proposals
+	Rounds         []*ProposalTallyRound  `protobuf:"bytes,5,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	ScoresMismatch bool                   `protobuf:"varint,6,opt,name=scores_mismatch,json=scoresMismatch,proto3" json:"scores_mismatch,omitempty"`
+	Diff           []*ProposalScoreDiff   `protobuf:"bytes,7,rep,name=diff,proto3" json:"diff,omitempty"`
+	CalculatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=calculated_at,json=calculatedAt,proto3" json:"calculated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProposalTallyResponse) Reset() {
+	*x = ProposalTallyResponse{}
+	mi := &file_storagepb_proposal_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalTallyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalTallyResponse) ProtoMessage() {}
+
+func (x *ProposalTallyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_proposal_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalTallyResponse.ProtoReflect.Descriptor instead.
+func (*ProposalTallyResponse) Descriptor() ([]byte, []int) {
+	return file_storagepb_proposal_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProposalTallyResponse) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *ProposalTallyResponse) GetScoresTotal() float64 {
+	if x != nil {
+		return x.ScoresTotal
+	}
+	return 0
+}
+
+func (x *ProposalTallyResponse) GetVotes() uint64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *ProposalTallyResponse) GetInvalidVotes() uint64 {
+	if x != nil {
+		return x.InvalidVotes
+	}
+	return 0
+}
+
+func (x *ProposalTallyResponse) GetRounds() []*ProposalTallyRound {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+func (x *ProposalTallyResponse) GetScoresMismatch() bool {
+	if x != nil {
+		return x.ScoresMismatch
+	}
+	return false
+}
+
+func (x *ProposalTallyResponse) GetDiff() []*ProposalScoreDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *ProposalTallyResponse) GetCalculatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CalculatedAt
+	}
+	return nil
+}
+
+type ProposalRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    string                 `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Limit         *uint64                `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalRevisionsRequest) Reset() {
+	*x = ProposalRevisionsRequest{}
+	mi := &file_storagepb_proposal_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalRevisionsRequest) ProtoMessage() {}
+
+func (x *ProposalRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_proposal_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ProposalRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_storagepb_proposal_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProposalRevisionsRequest) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+func (x *ProposalRevisionsRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ProposalRevisionsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type ProposalFieldChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// field is one of title, body, choices and discussion
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// old_value and new_value are json encoded values
+	OldValue      string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalFieldChange) Reset() {
+	*x = ProposalFieldChange{}
+	mi := &file_storagepb_proposal_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalFieldChange) ProtoMessage() {}
+
+func (x *ProposalFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_proposal_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalFieldChange.ProtoReflect.Descriptor instead.
+func (*ProposalFieldChange) Descriptor() ([]byte, []int) {
+	return file_storagepb_proposal_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProposalFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ProposalFieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ProposalFieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type ProposalRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Changes       []*ProposalFieldChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalRevision) Reset() {
+	*x = ProposalRevision{}
+	mi := &file_storagepb_proposal_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalRevision) ProtoMessage() {}
+
+func (x *ProposalRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_proposal_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalRevision.ProtoReflect.Descriptor instead.
+func (*ProposalRevision) Descriptor() ([]byte, []int) {
+	return file_storagepb_proposal_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProposalRevision) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProposalRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProposalRevision) GetChanges() []*ProposalFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ProposalRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ProposalRevision    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalRevisionsResponse) Reset() {
+	*x = ProposalRevisionsResponse{}
+	mi := &file_storagepb_proposal_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalRevisionsResponse) ProtoMessage() {}
+
+func (x *ProposalRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storagepb_proposal_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ProposalRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_storagepb_proposal_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProposalRevisionsResponse) GetItems() []*ProposalRevision {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ProposalRevisionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 var File_storagepb_proposal_proto protoreflect.FileDescriptor
 
 const file_storagepb_proposal_proto_rawDesc = "" +
@@ -824,7 +1360,7 @@ const file_storagepb_proposal_proto_rawDesc = "" +
 	"\x18storagepb/proposal.proto\x12\tstoragepb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14storagepb/base.proto\"6\n" +
 	"\x13ProposalByIDRequest\x12\x1f\n" +
 	"\vproposal_id\x18\x01 \x01(\tR\n" +
-	"proposalId\"\xb9\a\n" +
+	"proposalId\"\x8b\b\n" +
 	"\fProposalInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\n" +
@@ -864,7 +1400,9 @@ const file_storagepb_proposal_proto_rawDesc = "" +
 	"\btimeline\x18\x1d \x03(\v2\x1f.storagepb.ProposalTimelineItemR\btimeline\x12\x19\n" +
 	"\bens_name\x18\x1e \x01(\tR\aensName\x12\x12\n" +
 	"\x04spam\x18\x1f \x01(\bR\x04spam\x12.\n" +
-	"\x13initial_token_price\x18  \x01(\x01R\x11initialTokenPrice\"\x96\x03\n" +
+	"\x13initial_token_price\x18  \x01(\x01R\x11initialTokenPrice\x12'\n" +
+	"\x0fwinning_choices\x18! \x03(\rR\x0ewinningChoices\x12'\n" +
+	"\x0fscores_mismatch\x18\" \x01(\bR\x0escoresMismatch\"\x96\x03\n" +
 	"\x14ProposalTimelineItem\x129\n" +
 	"\n" +
 	"created_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12F\n" +
@@ -883,7 +1421,7 @@ const file_storagepb_proposal_proto_rawDesc = "" +
 	"\x13ProposalVotingEnded\x10\b\x12\x1a\n" +
 	"\x16ProposalVotingEndsSoon\x10\t\"K\n" +
 	"\x14ProposalByIDResponse\x123\n" +
-	"\bproposal\x18\x01 \x01(\v2\x17.storagepb.ProposalInfoR\bproposal\"\xb8\x03\n" +
+	"\bproposal\x18\x01 \x01(\v2\x17.storagepb.ProposalInfoR\bproposal\"\xa4\x04\n" +
 	"\x17ProposalByFilterRequest\x12\x15\n" +
 	"\x03dao\x18\x01 \x01(\tH\x00R\x03dao\x88\x01\x01\x12\x1f\n" +
 	"\bcategory\x18\x02 \x01(\tH\x01R\bcategory\x88\x01\x01\x12\x19\n" +
@@ -896,7 +1434,10 @@ const file_storagepb_proposal_proto_rawDesc = "" +
 	"\vonly_active\x18\t \x01(\bH\aR\n" +
 	"onlyActive\x88\x01\x01\x127\n" +
 	"\x05level\x18\n" +
-	" \x01(\x0e2\x1c.storagepb.ProposalInfoLevelH\bR\x05level\x88\x01\x01B\x06\n" +
+	" \x01(\x0e2\x1c.storagepb.ProposalInfoLevelH\bR\x05level\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\v \x01(\tH\tR\x06cursor\x88\x01\x01\x12-\n" +
+	"\x10skip_total_count\x18\f \x01(\bH\n" +
+	"R\x0eskipTotalCount\x88\x01\x01B\x06\n" +
 	"\x04_daoB\v\n" +
 	"\t_categoryB\b\n" +
 	"\x06_limitB\t\n" +
@@ -905,24 +1446,71 @@ const file_storagepb_proposal_proto_rawDesc = "" +
 	"\x06_orderB\x06\n" +
 	"\x04_topB\x0e\n" +
 	"\f_only_activeB\b\n" +
-	"\x06_level\"\xb9\x01\n" +
+	"\x06_levelB\t\n" +
+	"\a_cursorB\x13\n" +
+	"\x11_skip_total_count\"\xda\x01\n" +
 	"\x18ProposalByFilterResponse\x125\n" +
 	"\tproposals\x18\x01 \x03(\v2\x17.storagepb.ProposalInfoR\tproposals\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x04R\n" +
 	"totalCount\x12E\n" +
-	"\x0fproposals_short\x18\x03 \x03(\v2\x1c.storagepb.ProposalShortInfoR\x0eproposalsShort\"i\n" +
+	"\x0fproposals_short\x18\x03 \x03(\v2\x1c.storagepb.ProposalShortInfoR\x0eproposalsShort\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\tR\n" +
+	"nextCursor\"i\n" +
 	"\x11ProposalShortInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
 	"\x05state\x18\x03 \x01(\tR\x05state\x12\x18\n" +
-	"\acreated\x18\x04 \x01(\x04R\acreated*u\n" +
+	"\acreated\x18\x04 \x01(\x04R\acreated\"7\n" +
+	"\x14ProposalTallyRequest\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\tR\n" +
+	"proposalId\"B\n" +
+	"\x12ProposalTallyRound\x12\x14\n" +
+	"\x05round\x18\x01 \x01(\rR\x05round\x12\x16\n" +
+	"\x06scores\x18\x02 \x03(\x01R\x06scores\"g\n" +
+	"\x11ProposalScoreDiff\x12\x16\n" +
+	"\x06choice\x18\x01 \x01(\rR\x06choice\x12\x1a\n" +
+	"\bupstream\x18\x02 \x01(\x01R\bupstream\x12\x1e\n" +
+	"\n" +
+	"calculated\x18\x03 \x01(\x01R\n" +
+	"calculated\"\xe0\x02\n" +
+	"\x15ProposalTallyResponse\x12\x16\n" +
+	"\x06scores\x18\x01 \x03(\x01R\x06scores\x12!\n" +
+	"\fscores_total\x18\x02 \x01(\x01R\vscoresTotal\x12\x14\n" +
+	"\x05votes\x18\x03 \x01(\x04R\x05votes\x12#\n" +
+	"\rinvalid_votes\x18\x04 \x01(\x04R\finvalidVotes\x125\n" +
+	"\x06rounds\x18\x05 \x03(\v2\x1d.storagepb.ProposalTallyRoundR\x06rounds\x12'\n" +
+	"\x0fscores_mismatch\x18\x06 \x01(\bR\x0escoresMismatch\x120\n" +
+	"\x04diff\x18\a \x03(\v2\x1c.storagepb.ProposalScoreDiffR\x04diff\x12?\n" +
+	"\rcalculated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fcalculatedAt\"\x88\x01\n" +
+	"\x18ProposalRevisionsRequest\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\tR\n" +
+	"proposalId\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x04H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x01R\x06cursor\x88\x01\x01B\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursor\"e\n" +
+	"\x13ProposalFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"\x97\x01\n" +
+	"\x10ProposalRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\achanges\x18\x03 \x03(\v2\x1e.storagepb.ProposalFieldChangeR\achanges\"o\n" +
+	"\x19ProposalRevisionsResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.storagepb.ProposalRevisionR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor*u\n" +
 	"\x11ProposalInfoLevel\x12#\n" +
 	"\x1fPROPOSAL_INFO_LEVEL_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18PROPOSAL_INFO_LEVEL_FULL\x10\x01\x12\x1d\n" +
-	"\x19PROPOSAL_INFO_LEVEL_SHORT\x10\x022\xae\x01\n" +
+	"\x19PROPOSAL_INFO_LEVEL_SHORT\x10\x022\xd8\x02\n" +
 	"\bProposal\x12J\n" +
 	"\aGetByID\x12\x1e.storagepb.ProposalByIDRequest\x1a\x1f.storagepb.ProposalByIDResponse\x12V\n" +
-	"\vGetByFilter\x12\".storagepb.ProposalByFilterRequest\x1a#.storagepb.ProposalByFilterResponseB\rZ\v.;storagepbb\x06proto3"
+	"\vGetByFilter\x12\".storagepb.ProposalByFilterRequest\x1a#.storagepb.ProposalByFilterResponse\x12M\n" +
+	"\bGetTally\x12\x1f.storagepb.ProposalTallyRequest\x1a .storagepb.ProposalTallyResponse\x12Y\n" +
+	"\fGetRevisions\x12#.storagepb.ProposalRevisionsRequest\x1a$.storagepb.ProposalRevisionsResponseB\rZ\v.;storagepbb\x06proto3"
 
 var (
 	file_storagepb_proposal_proto_rawDescOnce sync.Once
@@ -937,7 +1525,7 @@ func file_storagepb_proposal_proto_rawDescGZIP() []byte {
 }
 
 var file_storagepb_proposal_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_storagepb_proposal_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_storagepb_proposal_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_storagepb_proposal_proto_goTypes = []any{
 	(ProposalInfoLevel)(0),                   // 0: storagepb.ProposalInfoLevel
 	(ProposalTimelineItem_TimelineAction)(0), // 1: storagepb.ProposalTimelineItem.TimelineAction
@@ -948,29 +1536,47 @@ var file_storagepb_proposal_proto_goTypes = []any{
 	(*ProposalByFilterRequest)(nil),          // 6: storagepb.ProposalByFilterRequest
 	(*ProposalByFilterResponse)(nil),         // 7: storagepb.ProposalByFilterResponse
 	(*ProposalShortInfo)(nil),                // 8: storagepb.ProposalShortInfo
-	(*timestamppb.Timestamp)(nil),            // 9: google.protobuf.Timestamp
-	(*Strategy)(nil),                         // 10: storagepb.Strategy
+	(*ProposalTallyRequest)(nil),             // 9: storagepb.ProposalTallyRequest
+	(*ProposalTallyRound)(nil),               // 10: storagepb.ProposalTallyRound
+	(*ProposalScoreDiff)(nil),                // 11: storagepb.ProposalScoreDiff
+	(*ProposalTallyResponse)(nil),            // 12: storagepb.ProposalTallyResponse
+	(*ProposalRevisionsRequest)(nil),         // 13: storagepb.ProposalRevisionsRequest
+	(*ProposalFieldChange)(nil),              // 14: storagepb.ProposalFieldChange
+	(*ProposalRevision)(nil),                 // 15: storagepb.ProposalRevision
+	(*ProposalRevisionsResponse)(nil),        // 16: storagepb.ProposalRevisionsResponse
+	(*timestamppb.Timestamp)(nil),            // 17: google.protobuf.Timestamp
+	(*Strategy)(nil),                         // 18: storagepb.Strategy
 }
 var file_storagepb_proposal_proto_depIdxs = []int32{
-	9,  // 0: storagepb.ProposalInfo.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 1: storagepb.ProposalInfo.updated_at:type_name -> google.protobuf.Timestamp
-	10, // 2: storagepb.ProposalInfo.strategies:type_name -> storagepb.Strategy
+	17, // 0: storagepb.ProposalInfo.created_at:type_name -> google.protobuf.Timestamp
+	17, // 1: storagepb.ProposalInfo.updated_at:type_name -> google.protobuf.Timestamp
+	18, // 2: storagepb.ProposalInfo.strategies:type_name -> storagepb.Strategy
 	4,  // 3: storagepb.ProposalInfo.timeline:type_name -> storagepb.ProposalTimelineItem
-	9,  // 4: storagepb.ProposalTimelineItem.created_at:type_name -> google.protobuf.Timestamp
+	17, // 4: storagepb.ProposalTimelineItem.created_at:type_name -> google.protobuf.Timestamp
 	1,  // 5: storagepb.ProposalTimelineItem.action:type_name -> storagepb.ProposalTimelineItem.TimelineAction
 	3,  // 6: storagepb.ProposalByIDResponse.proposal:type_name -> storagepb.ProposalInfo
 	0,  // 7: storagepb.ProposalByFilterRequest.level:type_name -> storagepb.ProposalInfoLevel
 	3,  // 8: storagepb.ProposalByFilterResponse.proposals:type_name -> storagepb.ProposalInfo
 	8,  // 9: storagepb.ProposalByFilterResponse.proposals_short:type_name -> storagepb.ProposalShortInfo
-	2,  // 10: storagepb.Proposal.GetByID:input_type -> storagepb.ProposalByIDRequest
-	6,  // 11: storagepb.Proposal.GetByFilter:input_type -> storagepb.ProposalByFilterRequest
-	5,  // 12: storagepb.Proposal.GetByID:output_type -> storagepb.ProposalByIDResponse
-	7,  // 13: storagepb.Proposal.GetByFilter:output_type -> storagepb.ProposalByFilterResponse
-	12, // [12:14] is the sub-list for method output_type
-	10, // [10:12] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	10, // 10: storagepb.ProposalTallyResponse.rounds:type_name -> storagepb.ProposalTallyRound
+	11, // 11: storagepb.ProposalTallyResponse.diff:type_name -> storagepb.ProposalScoreDiff
+	17, // 12: storagepb.ProposalTallyResponse.calculated_at:type_name -> google.protobuf.Timestamp
+	17, // 13: storagepb.ProposalRevision.created_at:type_name -> google.protobuf.Timestamp
+	14, // 14: storagepb.ProposalRevision.changes:type_name -> storagepb.ProposalFieldChange
+	15, // 15: storagepb.ProposalRevisionsResponse.items:type_name -> storagepb.ProposalRevision
+	2,  // 16: storagepb.Proposal.GetByID:input_type -> storagepb.ProposalByIDRequest
+	6,  // 17: storagepb.Proposal.GetByFilter:input_type -> storagepb.ProposalByFilterRequest
+	9,  // 18: storagepb.Proposal.GetTally:input_type -> storagepb.ProposalTallyRequest
+	13, // 19: storagepb.Proposal.GetRevisions:input_type -> storagepb.ProposalRevisionsRequest
+	5,  // 20: storagepb.Proposal.GetByID:output_type -> storagepb.ProposalByIDResponse
+	7,  // 21: storagepb.Proposal.GetByFilter:output_type -> storagepb.ProposalByFilterResponse
+	12, // 22: storagepb.Proposal.GetTally:output_type -> storagepb.ProposalTallyResponse
+	16, // 23: storagepb.Proposal.GetRevisions:output_type -> storagepb.ProposalRevisionsResponse
+	20, // [20:24] is the sub-list for method output_type
+	16, // [16:20] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_storagepb_proposal_proto_init() }
@@ -980,13 +1586,14 @@ func file_storagepb_proposal_proto_init() {
 	}
 	file_storagepb_base_proto_init()
 	file_storagepb_proposal_proto_msgTypes[4].OneofWrappers = []any{}
+	file_storagepb_proposal_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_storagepb_proposal_proto_rawDesc), len(file_storagepb_proposal_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   7,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Proposal_GetByID_FullMethodName     = "/storagepb.Proposal/GetByID"
-	Proposal_GetByFilter_FullMethodName = "/storagepb.Proposal/GetByFilter"
+	Proposal_GetByID_FullMethodName      = "/storagepb.Proposal/GetByID"
+	Proposal_GetByFilter_FullMethodName  = "/storagepb.Proposal/GetByFilter"
+	Proposal_GetTally_FullMethodName     = "/storagepb.Proposal/GetTally"
+	Proposal_GetRevisions_FullMethodName = "/storagepb.Proposal/GetRevisions"
 )
 
 // ProposalClient is the client API for Proposal service.
@@ -29,6 +31,8 @@ const (
 type ProposalClient interface {
 	GetByID(ctx context.Context, in *ProposalByIDRequest, opts ...grpc.CallOption) (*ProposalByIDResponse, error)
 	GetByFilter(ctx context.Context, in *ProposalByFilterRequest, opts ...grpc.CallOption) (*ProposalByFilterResponse, error)
+	GetTally(ctx context.Context, in *ProposalTallyRequest, opts ...grpc.CallOption) (*ProposalTallyResponse, error)
+	GetRevisions(ctx context.Context, in *ProposalRevisionsRequest, opts ...grpc.CallOption) (*ProposalRevisionsResponse, error)
 }
 
 type proposalClient struct {
@@ -59,12 +63,34 @@ func (c *proposalClient) GetByFilter(ctx context.Context, in *ProposalByFilterRe
 	return out, nil
 }
 
+func (c *proposalClient) GetTally(ctx context.Context, in *ProposalTallyRequest, opts ...grpc.CallOption) (*ProposalTallyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProposalTallyResponse)
+	err := c.cc.Invoke(ctx, Proposal_GetTally_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposalClient) GetRevisions(ctx context.Context, in *ProposalRevisionsRequest, opts ...grpc.CallOption) (*ProposalRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProposalRevisionsResponse)
+	err := c.cc.Invoke(ctx, Proposal_GetRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProposalServer is the server API for Proposal service.
 // All implementations must embed UnimplementedProposalServer
 // for forward compatibility.
 type ProposalServer interface {
 	GetByID(context.Context, *ProposalByIDRequest) (*ProposalByIDResponse, error)
 	GetByFilter(context.Context, *ProposalByFilterRequest) (*ProposalByFilterResponse, error)
+	GetTally(context.Context, *ProposalTallyRequest) (*ProposalTallyResponse, error)
+	GetRevisions(context.Context, *ProposalRevisionsRequest) (*ProposalRevisionsResponse, error)
 	mustEmbedUnimplementedProposalServer()
 }
 
@@ -81,6 +107,12 @@ func (UnimplementedProposalServer) GetByID(context.Context, *ProposalByIDRequest
 func (UnimplementedProposalServer) GetByFilter(context.Context, *ProposalByFilterRequest) (*ProposalByFilterResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetByFilter not implemented")
 }
+func (UnimplementedProposalServer) GetTally(context.Context, *ProposalTallyRequest) (*ProposalTallyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTally not implemented")
+}
+func (UnimplementedProposalServer) GetRevisions(context.Context, *ProposalRevisionsRequest) (*ProposalRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRevisions not implemented")
+}
 func (UnimplementedProposalServer) mustEmbedUnimplementedProposalServer() {}
 func (UnimplementedProposalServer) testEmbeddedByValue()                  {}
 
@@ -138,6 +170,42 @@ func _Proposal_GetByFilter_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Proposal_GetTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposalTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalServer).GetTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proposal_GetTally_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalServer).GetTally(ctx, req.(*ProposalTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proposal_GetRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposalRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalServer).GetRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proposal_GetRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalServer).GetRevisions(ctx, req.(*ProposalRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Proposal_ServiceDesc is the grpc.ServiceDesc for Proposal service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +221,14 @@ var Proposal_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByFilter",
 			Handler:    _Proposal_GetByFilter_Handler,
 		},
+		{
+			MethodName: "GetTally",
+			Handler:    _Proposal_GetTally_Handler,
+		},
+		{
+			MethodName: "GetRevisions",
+			Handler:    _Proposal_GetRevisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "storagepb/proposal.proto",
@@ -24,16 +24,20 @@ const (
 )
 
 type VotesFilterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProposalIds   []string               `protobuf:"bytes,1,rep,name=proposal_ids,json=proposalIds,proto3" json:"proposal_ids,omitempty"`
-	Voter         *string                `protobuf:"bytes,2,opt,name=voter,proto3,oneof" json:"voter,omitempty"`
-	OrderByVoter  *string                `protobuf:"bytes,3,opt,name=order_by_voter,json=orderByVoter,proto3,oneof" json:"order_by_voter,omitempty"`
-	Query         *string                `protobuf:"bytes,4,opt,name=query,proto3,oneof" json:"query,omitempty"`
-	Limit         *uint64                `protobuf:"varint,5,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset        *uint64                `protobuf:"varint,6,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
-	DaoId         *string                `protobuf:"bytes,7,opt,name=dao_id,json=daoId,proto3,oneof" json:"dao_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ProposalIds  []string               `protobuf:"bytes,1,rep,name=proposal_ids,json=proposalIds,proto3" json:"proposal_ids,omitempty"`
+	Voter        *string                `protobuf:"bytes,2,opt,name=voter,proto3,oneof" json:"voter,omitempty"`
+	OrderByVoter *string                `protobuf:"bytes,3,opt,name=order_by_voter,json=orderByVoter,proto3,oneof" json:"order_by_voter,omitempty"`
+	Query        *string                `protobuf:"bytes,4,opt,name=query,proto3,oneof" json:"query,omitempty"`
+	Limit        *uint64                `protobuf:"varint,5,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset       *uint64                `protobuf:"varint,6,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	DaoId        *string                `protobuf:"bytes,7,opt,name=dao_id,json=daoId,proto3,oneof" json:"dao_id,omitempty"`
+	// cursor is the next_cursor from the previous page, offset is ignored if it's set
+	Cursor *string `protobuf:"bytes,8,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// skip_total_count skips calculating total_count and total_vp
+	SkipTotalCount *bool `protobuf:"varint,9,opt,name=skip_total_count,json=skipTotalCount,proto3,oneof" json:"skip_total_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *VotesFilterRequest) Reset() {
@@ -115,6 +119,20 @@ func (x *VotesFilterRequest) GetDaoId() string {
 	return ""
 }
 
+func (x *VotesFilterRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *VotesFilterRequest) GetSkipTotalCount() bool {
+	if x != nil && x.SkipTotalCount != nil {
+		return *x.SkipTotalCount
+	}
+	return false
+}
+
 type VoteInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -256,10 +274,12 @@ func (x *VoteInfo) GetEnsName() string {
 }
 
 type VotesFilterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Votes         []*VoteInfo            `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
-	TotalCount    uint64                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	TotalVp       float32                `protobuf:"fixed32,3,opt,name=total_vp,json=totalVp,proto3" json:"total_vp,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Votes      []*VoteInfo            `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
+	TotalCount uint64                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	TotalVp    float32                `protobuf:"fixed32,3,opt,name=total_vp,json=totalVp,proto3" json:"total_vp,omitempty"`
+	// next_cursor is empty for the last page
+	NextCursor    string `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -315,6 +335,13 @@ func (x *VotesFilterResponse) GetTotalVp() float32 {
 	return 0
 }
 
+func (x *VotesFilterResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type ValidateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Voter         string                 `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
@@ -975,7 +1002,7 @@ var File_storagepb_vote_proto protoreflect.FileDescriptor
 
 const file_storagepb_vote_proto_rawDesc = "" +
 	"\n" +
-	"\x14storagepb/vote.proto\x12\tstoragepb\x1a\x19google/protobuf/any.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb3\x02\n" +
+	"\x14storagepb/vote.proto\x12\tstoragepb\x1a\x19google/protobuf/any.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9f\x03\n" +
 	"\x12VotesFilterRequest\x12!\n" +
 	"\fproposal_ids\x18\x01 \x03(\tR\vproposalIds\x12\x19\n" +
 	"\x05voter\x18\x02 \x01(\tH\x00R\x05voter\x88\x01\x01\x12)\n" +
@@ -983,13 +1010,17 @@ const file_storagepb_vote_proto_rawDesc = "" +
 	"\x05query\x18\x04 \x01(\tH\x02R\x05query\x88\x01\x01\x12\x19\n" +
 	"\x05limit\x18\x05 \x01(\x04H\x03R\x05limit\x88\x01\x01\x12\x1b\n" +
 	"\x06offset\x18\x06 \x01(\x04H\x04R\x06offset\x88\x01\x01\x12\x1a\n" +
-	"\x06dao_id\x18\a \x01(\tH\x05R\x05daoId\x88\x01\x01B\b\n" +
+	"\x06dao_id\x18\a \x01(\tH\x05R\x05daoId\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\b \x01(\tH\x06R\x06cursor\x88\x01\x01\x12-\n" +
+	"\x10skip_total_count\x18\t \x01(\bH\aR\x0eskipTotalCount\x88\x01\x01B\b\n" +
 	"\x06_voterB\x11\n" +
 	"\x0f_order_by_voterB\b\n" +
 	"\x06_queryB\b\n" +
 	"\x06_limitB\t\n" +
 	"\a_offsetB\t\n" +
-	"\a_dao_id\"\xda\x02\n" +
+	"\a_dao_idB\t\n" +
+	"\a_cursorB\x13\n" +
+	"\x11_skip_total_count\"\xda\x02\n" +
 	"\bVoteInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04ipfs\x18\x02 \x01(\tR\x04ipfs\x12\x14\n" +
@@ -1005,12 +1036,14 @@ const file_storagepb_vote_proto_rawDesc = "" +
 	" \x01(\x02R\x02vp\x12$\n" +
 	"\x0evp_by_strategy\x18\v \x03(\x02R\fvpByStrategy\x12\x19\n" +
 	"\bvp_state\x18\f \x01(\tR\avpState\x12\x19\n" +
-	"\bens_name\x18\r \x01(\tR\aensName\"|\n" +
+	"\bens_name\x18\r \x01(\tR\aensName\"\x9d\x01\n" +
 	"\x13VotesFilterResponse\x12)\n" +
 	"\x05votes\x18\x01 \x03(\v2\x13.storagepb.VoteInfoR\x05votes\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x04R\n" +
 	"totalCount\x12\x19\n" +
-	"\btotal_vp\x18\x03 \x01(\x02R\atotalVp\"C\n" +
+	"\btotal_vp\x18\x03 \x01(\x02R\atotalVp\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\tR\n" +
+	"nextCursor\"C\n" +
 	"\x0fValidateRequest\x12\x14\n" +
 	"\x05voter\x18\x01 \x01(\tR\x05voter\x12\x1a\n" +
 	"\bproposal\x18\x02 \x01(\tR\bproposal\"\xde\x01\n" +
@@ -0,0 +1,14 @@
+create table if not exists outbox_messages
+(
+    id              bigserial primary key,
+    created_at      timestamp with time zone not null default now(),
+    entity_key      text                     not null,
+    subject         text                     not null,
+    payload         jsonb                    not null,
+    attempts        integer                  not null default 0,
+    next_attempt_at timestamp with time zone not null default now(),
+    last_error      text                     not null default ''
+);
+
+create index if not exists outbox_messages_entity_key_id_idx
+    on outbox_messages (entity_key, id);
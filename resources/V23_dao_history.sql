@@ -0,0 +1,14 @@
+create table if not exists dao_history
+(
+    id         bigserial primary key,
+    created_at timestamp with time zone not null default now(),
+    dao_id     uuid                     not null,
+    source     text                     not null,
+    changes    jsonb                    not null
+);
+
+create index if not exists dao_history_dao_id_id_idx
+    on dao_history (dao_id, id desc);
+
+create index if not exists dao_history_changes_idx
+    on dao_history using gin (changes jsonb_path_ops);
@@ -0,0 +1,17 @@
+create table if not exists dao_category_rules
+(
+    category    text primary key,
+    created_at  timestamp with time zone not null default now(),
+    updated_at  timestamp with time zone not null default now(),
+    enabled     boolean                  not null default true,
+    conditions  jsonb                    not null default '{}',
+    order_by    text                     not null default '',
+    window_days integer                  not null default 0,
+    "limit"     integer                  not null default 0
+);
+
+-- rules replace hardcoded new and popular category workers
+insert into dao_category_rules (category, conditions, order_by, "limit")
+values ('new_daos', '{"activity_since_days": 90}', '', 0),
+       ('popular_daos', '{}', 'popularity_index', 100)
+on conflict (category) do nothing;
@@ -0,0 +1,5 @@
+alter table daos
+    add column if not exists version integer not null default 0;
+
+alter table proposals
+    add column if not exists version integer not null default 0;
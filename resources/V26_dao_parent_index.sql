@@ -0,0 +1 @@
+create index if not exists daos_parent_id_idx on daos (parent_id) where parent_id is not null;
@@ -0,0 +1,12 @@
+create table if not exists dao_treasury_snapshots
+(
+    id         bigserial primary key,
+    created_at timestamp with time zone not null default now(),
+    dao_id     uuid                     not null,
+    address    text                     not null,
+    value      double precision         not null default 0,
+    positions  jsonb                    not null default '[]'
+);
+
+create index if not exists dao_treasury_snapshots_dao_address_idx
+    on dao_treasury_snapshots (dao_id, address, created_at desc);
@@ -0,0 +1,7 @@
+create table if not exists token_prices
+(
+    fungible_id text                     not null,
+    time        timestamp with time zone not null,
+    price       double precision         not null,
+    primary key (fungible_id, time)
+);
@@ -0,0 +1,19 @@
+create table if not exists dao_verification_decisions
+(
+    id                  bigserial primary key,
+    created_at          timestamp with time zone not null default now(),
+    dao_id              uuid                     not null,
+    moderator           text                     not null,
+    client              text                     not null default '',
+    status              text                     not null,
+    previous_status     text                     not null default '',
+    comment             text                     not null default '',
+    fungible_id         text                     not null default '',
+    fungible_id_cleared boolean                  not null default false
+);
+
+create index if not exists dao_verification_decisions_dao_id_id_idx
+    on dao_verification_decisions (dao_id, id desc);
+
+create index if not exists daos_verification_status_idx
+    on daos (verification_status) where verification_status = 'pending';
@@ -0,0 +1,12 @@
+create table if not exists dao_similarities
+(
+    dao_id         uuid                     not null,
+    similar_dao_id uuid                     not null,
+    score          double precision         not null,
+    shared_voters  integer                  not null,
+    updated_at     timestamp with time zone not null default now(),
+    primary key (dao_id, similar_dao_id)
+);
+
+create index if not exists dao_voter_voter_idx
+    on dao_voter (voter);
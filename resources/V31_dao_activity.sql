@@ -0,0 +1,18 @@
+create table if not exists dao_activity
+(
+    dao_id     uuid                     not null,
+    day        date                     not null,
+    proposals  integer                  not null default 0,
+    votes      integer                  not null default 0,
+    voters     integer                  not null default 0,
+    new_voters integer                  not null default 0,
+    vp         double precision         not null default 0,
+    updated_at timestamp with time zone not null default now(),
+    primary key (dao_id, day)
+);
+
+create index concurrently if not exists votes_dao_created_idx
+    on votes (dao_id, created);
+
+create index concurrently if not exists proposals_dao_created_idx
+    on proposals (dao_id, created);
@@ -0,0 +1,6 @@
+alter table daos
+    add column if not exists external_popularity_index double precision not null default 0,
+    add column if not exists local_popularity_index    double precision not null default 0;
+
+update daos
+set external_popularity_index = coalesce(popularity_index, 0);
@@ -0,0 +1,20 @@
+alter table dao_ids
+    add column if not exists source      text    not null default 'snapshot',
+    add column if not exists external_id text,
+    add column if not exists is_primary  boolean not null default true;
+
+update dao_ids
+set external_id = original_id
+where external_id is null;
+
+alter table dao_ids
+    alter column external_id set not null;
+
+create unique index if not exists dao_ids_source_external_id_idx
+    on dao_ids (source, external_id);
+
+create index if not exists dao_ids_internal_id_idx
+    on dao_ids (internal_id);
+
+alter table daos
+    add column if not exists source text not null default 'snapshot';
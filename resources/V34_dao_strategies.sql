@@ -0,0 +1,58 @@
+create table if not exists dao_strategies
+(
+    dao_id     uuid    not null,
+    idx        integer not null,
+    name       text    not null,
+    network    text    not null default '',
+    address    text    not null default '',
+    symbol     text    not null default '',
+    decimals   integer not null default 0,
+    multichain boolean not null default false,
+    primary key (dao_id, idx)
+);
+
+create index if not exists dao_strategies_network_address_idx
+    on dao_strategies (network, lower(address));
+
+create index if not exists dao_strategies_name_idx
+    on dao_strategies (name);
+
+insert into dao_strategies (dao_id, idx, name, network, address, symbol, decimals, multichain)
+select dao_id,
+       row_number() over (partition by dao_id order by ord, sub_ord) - 1,
+       coalesce(name, ''),
+       network,
+       coalesce(params ->> 'address', ''),
+       coalesce(params ->> 'symbol', ''),
+       case when params ->> 'decimals' ~ '^[0-9]+$' then (params ->> 'decimals')::integer else 0 end,
+       multichain
+from (select d.id                                                        dao_id,
+             st.ord,
+             0                                                           sub_ord,
+             st.value ->> 'Name'                                         name,
+             coalesce(nullif(st.value ->> 'Network', ''), d.network, '') network,
+             st.value -> 'Params'                                        params,
+             false                                                       multichain
+      from daos d,
+           jsonb_array_elements(case jsonb_typeof(d.strategies) when 'array' then d.strategies else '[]' end)
+               with ordinality st(value, ord)
+      where st.value ->> 'Name' is distinct from 'multichain'
+
+      union all
+
+      select d.id,
+             st.ord,
+             sub.ord,
+             sub.value ->> 'name',
+             coalesce(nullif(sub.value ->> 'network', ''), nullif(st.value ->> 'Network', ''), d.network, ''),
+             sub.value -> 'params',
+             true
+      from daos d,
+           jsonb_array_elements(case jsonb_typeof(d.strategies) when 'array' then d.strategies else '[]' end)
+               with ordinality st(value, ord),
+           jsonb_array_elements(case jsonb_typeof(st.value -> 'Params' -> 'strategies')
+                                    when 'array' then st.value -> 'Params' -> 'strategies'
+                                    else '[]' end)
+               with ordinality sub(value, ord)
+      where st.value ->> 'Name' = 'multichain') data
+on conflict do nothing;
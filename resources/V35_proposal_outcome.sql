@@ -0,0 +1,2 @@
+alter table proposals
+    add column if not exists winning_choices jsonb;
@@ -0,0 +1,16 @@
+create table if not exists proposal_tallies
+(
+    proposal_id    text                     not null primary key,
+    created_at     timestamp with time zone not null default now(),
+    updated_at     timestamp with time zone not null default now(),
+    scores         jsonb,
+    scores_total   double precision         not null default 0,
+    votes          integer                  not null default 0,
+    invalid_votes  integer                  not null default 0,
+    rounds         jsonb,
+    diff           jsonb,
+    scores_updated bigint                   not null default 0
+);
+
+alter table proposals
+    add column if not exists scores_mismatch boolean not null default false;
@@ -0,0 +1,10 @@
+create table if not exists proposal_revisions
+(
+    id          bigserial primary key,
+    created_at  timestamp with time zone not null default now(),
+    proposal_id text                     not null,
+    changes     jsonb                    not null
+);
+
+create index if not exists proposal_revisions_proposal_id_id_idx
+    on proposal_revisions (proposal_id, id desc);
@@ -0,0 +1,15 @@
+-- balances are looked up by the lowercase address, legacy mixed-case rows are merged into lowercase ones
+with legacy as (
+    delete
+    from erc20_balances
+    where address <> lower(address)
+    returning lower(address) as address, token, chain_id, value
+)
+insert
+into erc20_balances (address, token, chain_id, value)
+select address, token, chain_id, sum(value)
+from legacy
+group by address, token, chain_id
+on conflict (address, token, chain_id) do update
+    set value      = erc20_balances.value + excluded.value,
+        updated_at = now();
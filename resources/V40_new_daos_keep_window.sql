@@ -0,0 +1,5 @@
+-- new daos keep the category one day longer than they get it as the removed worker did
+update dao_category_rules
+set conditions = conditions || '{"keep_activity_since_days": 91}'
+where category = 'new_daos'
+  and conditions = '{"activity_since_days": 90}';
@@ -0,0 +1,5 @@
+create table if not exists token_price_backfills
+(
+    fungible_id text                     not null primary key,
+    created_at  timestamp with time zone not null default now()
+);
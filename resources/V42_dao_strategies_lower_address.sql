@@ -0,0 +1,3 @@
+-- token filter without the network looks up strategies by the lowercase address only
+create index concurrently if not exists dao_strategies_lower_address_idx
+    on dao_strategies (lower(address));
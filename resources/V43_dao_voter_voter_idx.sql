@@ -0,0 +1,3 @@
+-- similarities look up daos of voters, the table is large, so the index is built without locking writes
+create index concurrently if not exists dao_voter_voter_idx
+    on dao_voter (voter);
@@ -0,0 +1,7 @@
+create table if not exists proposal_tally_attempts
+(
+    proposal_id     text                     not null primary key,
+    attempts        integer                  not null default 0,
+    next_attempt_at timestamp with time zone not null default now(),
+    last_error      text                     not null default ''
+);
@@ -0,0 +1,8 @@
+package resources
+
+import "embed"
+
+// Migrations holds the base schema and the versioned V{n}_*.sql files
+//
+//go:embed *.sql
+var Migrations embed.FS